@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package metastorev1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MetastoreServiceClient is the client API for MetastoreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetastoreServiceClient interface {
+	// GetLocationByKey returns the location identified by the given key.
+	GetLocationByKey(ctx context.Context, in *GetLocationByKeyRequest, opts ...grpc.CallOption) (*GetLocationByKeyResponse, error)
+	// GetLocationsByIDs returns the locations with the given IDs.
+	GetLocationsByIDs(ctx context.Context, in *GetLocationsByIDsRequest, opts ...grpc.CallOption) (*GetLocationsByIDsResponse, error)
+	// CreateLocation persists a location and returns its ID.
+	CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error)
+	// Symbolize persists the lines of an already existing location.
+	Symbolize(ctx context.Context, in *SymbolizeRequest, opts ...grpc.CallOption) (*SymbolizeResponse, error)
+	// GetSymbolizableLocations returns the locations that have a mapping but no lines yet.
+	GetSymbolizableLocations(ctx context.Context, in *GetSymbolizableLocationsRequest, opts ...grpc.CallOption) (*GetSymbolizableLocationsResponse, error)
+	// GetFunctionByKey returns the function identified by the given key.
+	GetFunctionByKey(ctx context.Context, in *GetFunctionByKeyRequest, opts ...grpc.CallOption) (*GetFunctionByKeyResponse, error)
+	// CreateFunction persists a function and returns its ID.
+	CreateFunction(ctx context.Context, in *CreateFunctionRequest, opts ...grpc.CallOption) (*CreateFunctionResponse, error)
+	// GetMappingByKey returns the mapping identified by the given key.
+	GetMappingByKey(ctx context.Context, in *GetMappingByKeyRequest, opts ...grpc.CallOption) (*GetMappingByKeyResponse, error)
+	// CreateMapping persists a mapping and returns its ID.
+	CreateMapping(ctx context.Context, in *CreateMappingRequest, opts ...grpc.CallOption) (*CreateMappingResponse, error)
+}
+
+type metastoreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetastoreServiceClient(cc grpc.ClientConnInterface) MetastoreServiceClient {
+	return &metastoreServiceClient{cc}
+}
+
+func (c *metastoreServiceClient) GetLocationByKey(ctx context.Context, in *GetLocationByKeyRequest, opts ...grpc.CallOption) (*GetLocationByKeyResponse, error) {
+	out := new(GetLocationByKeyResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/GetLocationByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) GetLocationsByIDs(ctx context.Context, in *GetLocationsByIDsRequest, opts ...grpc.CallOption) (*GetLocationsByIDsResponse, error) {
+	out := new(GetLocationsByIDsResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/GetLocationsByIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) CreateLocation(ctx context.Context, in *CreateLocationRequest, opts ...grpc.CallOption) (*CreateLocationResponse, error) {
+	out := new(CreateLocationResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/CreateLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) Symbolize(ctx context.Context, in *SymbolizeRequest, opts ...grpc.CallOption) (*SymbolizeResponse, error) {
+	out := new(SymbolizeResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/Symbolize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) GetSymbolizableLocations(ctx context.Context, in *GetSymbolizableLocationsRequest, opts ...grpc.CallOption) (*GetSymbolizableLocationsResponse, error) {
+	out := new(GetSymbolizableLocationsResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/GetSymbolizableLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) GetFunctionByKey(ctx context.Context, in *GetFunctionByKeyRequest, opts ...grpc.CallOption) (*GetFunctionByKeyResponse, error) {
+	out := new(GetFunctionByKeyResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/GetFunctionByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) CreateFunction(ctx context.Context, in *CreateFunctionRequest, opts ...grpc.CallOption) (*CreateFunctionResponse, error) {
+	out := new(CreateFunctionResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/CreateFunction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) GetMappingByKey(ctx context.Context, in *GetMappingByKeyRequest, opts ...grpc.CallOption) (*GetMappingByKeyResponse, error) {
+	out := new(GetMappingByKeyResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/GetMappingByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metastoreServiceClient) CreateMapping(ctx context.Context, in *CreateMappingRequest, opts ...grpc.CallOption) (*CreateMappingResponse, error) {
+	out := new(CreateMappingResponse)
+	err := c.cc.Invoke(ctx, "/parca.metastore.v1alpha1.MetastoreService/CreateMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetastoreServiceServer is the server API for MetastoreService service.
+// All implementations should embed UnimplementedMetastoreServiceServer
+// for forward compatibility
+type MetastoreServiceServer interface {
+	// GetLocationByKey returns the location identified by the given key.
+	GetLocationByKey(context.Context, *GetLocationByKeyRequest) (*GetLocationByKeyResponse, error)
+	// GetLocationsByIDs returns the locations with the given IDs.
+	GetLocationsByIDs(context.Context, *GetLocationsByIDsRequest) (*GetLocationsByIDsResponse, error)
+	// CreateLocation persists a location and returns its ID.
+	CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error)
+	// Symbolize persists the lines of an already existing location.
+	Symbolize(context.Context, *SymbolizeRequest) (*SymbolizeResponse, error)
+	// GetSymbolizableLocations returns the locations that have a mapping but no lines yet.
+	GetSymbolizableLocations(context.Context, *GetSymbolizableLocationsRequest) (*GetSymbolizableLocationsResponse, error)
+	// GetFunctionByKey returns the function identified by the given key.
+	GetFunctionByKey(context.Context, *GetFunctionByKeyRequest) (*GetFunctionByKeyResponse, error)
+	// CreateFunction persists a function and returns its ID.
+	CreateFunction(context.Context, *CreateFunctionRequest) (*CreateFunctionResponse, error)
+	// GetMappingByKey returns the mapping identified by the given key.
+	GetMappingByKey(context.Context, *GetMappingByKeyRequest) (*GetMappingByKeyResponse, error)
+	// CreateMapping persists a mapping and returns its ID.
+	CreateMapping(context.Context, *CreateMappingRequest) (*CreateMappingResponse, error)
+}
+
+// UnimplementedMetastoreServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedMetastoreServiceServer struct {
+}
+
+func (UnimplementedMetastoreServiceServer) GetLocationByKey(context.Context, *GetLocationByKeyRequest) (*GetLocationByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationByKey not implemented")
+}
+func (UnimplementedMetastoreServiceServer) GetLocationsByIDs(context.Context, *GetLocationsByIDsRequest) (*GetLocationsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationsByIDs not implemented")
+}
+func (UnimplementedMetastoreServiceServer) CreateLocation(context.Context, *CreateLocationRequest) (*CreateLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLocation not implemented")
+}
+func (UnimplementedMetastoreServiceServer) Symbolize(context.Context, *SymbolizeRequest) (*SymbolizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Symbolize not implemented")
+}
+func (UnimplementedMetastoreServiceServer) GetSymbolizableLocations(context.Context, *GetSymbolizableLocationsRequest) (*GetSymbolizableLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSymbolizableLocations not implemented")
+}
+func (UnimplementedMetastoreServiceServer) GetFunctionByKey(context.Context, *GetFunctionByKeyRequest) (*GetFunctionByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunctionByKey not implemented")
+}
+func (UnimplementedMetastoreServiceServer) CreateFunction(context.Context, *CreateFunctionRequest) (*CreateFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFunction not implemented")
+}
+func (UnimplementedMetastoreServiceServer) GetMappingByKey(context.Context, *GetMappingByKeyRequest) (*GetMappingByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMappingByKey not implemented")
+}
+func (UnimplementedMetastoreServiceServer) CreateMapping(context.Context, *CreateMappingRequest) (*CreateMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMapping not implemented")
+}
+
+// UnsafeMetastoreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetastoreServiceServer will
+// result in compilation errors.
+type UnsafeMetastoreServiceServer interface {
+	mustEmbedUnimplementedMetastoreServiceServer()
+}
+
+func RegisterMetastoreServiceServer(s grpc.ServiceRegistrar, srv MetastoreServiceServer) {
+	s.RegisterService(&MetastoreService_ServiceDesc, srv)
+}
+
+func _MetastoreService_GetLocationByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).GetLocationByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/GetLocationByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).GetLocationByKey(ctx, req.(*GetLocationByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_GetLocationsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).GetLocationsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/GetLocationsByIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).GetLocationsByIDs(ctx, req.(*GetLocationsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_CreateLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).CreateLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/CreateLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).CreateLocation(ctx, req.(*CreateLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_Symbolize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SymbolizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).Symbolize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/Symbolize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).Symbolize(ctx, req.(*SymbolizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_GetSymbolizableLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSymbolizableLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).GetSymbolizableLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/GetSymbolizableLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).GetSymbolizableLocations(ctx, req.(*GetSymbolizableLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_GetFunctionByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctionByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).GetFunctionByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/GetFunctionByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).GetFunctionByKey(ctx, req.(*GetFunctionByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_CreateFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).CreateFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/CreateFunction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).CreateFunction(ctx, req.(*CreateFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_GetMappingByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMappingByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).GetMappingByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/GetMappingByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).GetMappingByKey(ctx, req.(*GetMappingByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetastoreService_CreateMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetastoreServiceServer).CreateMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/parca.metastore.v1alpha1.MetastoreService/CreateMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetastoreServiceServer).CreateMapping(ctx, req.(*CreateMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetastoreService_ServiceDesc is the grpc.ServiceDesc for MetastoreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetastoreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "parca.metastore.v1alpha1.MetastoreService",
+	HandlerType: (*MetastoreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLocationByKey",
+			Handler:    _MetastoreService_GetLocationByKey_Handler,
+		},
+		{
+			MethodName: "GetLocationsByIDs",
+			Handler:    _MetastoreService_GetLocationsByIDs_Handler,
+		},
+		{
+			MethodName: "CreateLocation",
+			Handler:    _MetastoreService_CreateLocation_Handler,
+		},
+		{
+			MethodName: "Symbolize",
+			Handler:    _MetastoreService_Symbolize_Handler,
+		},
+		{
+			MethodName: "GetSymbolizableLocations",
+			Handler:    _MetastoreService_GetSymbolizableLocations_Handler,
+		},
+		{
+			MethodName: "GetFunctionByKey",
+			Handler:    _MetastoreService_GetFunctionByKey_Handler,
+		},
+		{
+			MethodName: "CreateFunction",
+			Handler:    _MetastoreService_CreateFunction_Handler,
+		},
+		{
+			MethodName: "GetMappingByKey",
+			Handler:    _MetastoreService_GetMappingByKey_Handler,
+		},
+		{
+			MethodName: "CreateMapping",
+			Handler:    _MetastoreService_CreateMapping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "parca/metastore/v1alpha1/metastore.proto",
+}
@@ -22,7 +22,7 @@ type QueryServiceClient interface {
 	QueryRange(ctx context.Context, in *QueryRangeRequest, opts ...grpc.CallOption) (*QueryRangeResponse, error)
 	// Query performs a profile query
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
-	// Series is unimplemented
+	// Series returns the label sets and metadata of the series matching the given matchers and time frame
 	Series(ctx context.Context, in *SeriesRequest, opts ...grpc.CallOption) (*SeriesResponse, error)
 	// Labels returns the set of label names against a given matching string and time frame
 	Labels(ctx context.Context, in *LabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
@@ -91,7 +91,7 @@ type QueryServiceServer interface {
 	QueryRange(context.Context, *QueryRangeRequest) (*QueryRangeResponse, error)
 	// Query performs a profile query
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
-	// Series is unimplemented
+	// Series returns the label sets and metadata of the series matching the given matchers and time frame
 	Series(context.Context, *SeriesRequest) (*SeriesResponse, error)
 	// Labels returns the set of label names against a given matching string and time frame
 	Labels(context.Context, *LabelsRequest) (*LabelsResponse, error)
@@ -15,18 +15,58 @@ package queryv1alpha1
 
 import (
 	"fmt"
+	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// MaxRangeSteps is the max number of steps a range query is resampled to.
+const MaxRangeSteps = 11000
+
 // Validate the QueryRangeRequest
 func (r *QueryRangeRequest) Validate() error {
-	return validation.ValidateStruct(r,
+	err := validation.ValidateStruct(r,
 		validation.Field(&r.Start, validation.Required),
 		validation.Field(&r.End, validation.Required, isAfter(r.Start)),
 		validation.Field(&r.Query, validation.Required),
+		validation.Field(&r.Function, isRegexp()),
 	)
+	if err != nil {
+		return err
+	}
+
+	if r.Step != nil {
+		step := r.Step.AsDuration()
+		if step <= 0 {
+			return fmt.Errorf("step must be positive")
+		}
+		if r.End.AsTime().Sub(r.Start.AsTime())/step >= MaxRangeSteps {
+			return fmt.Errorf("exceeded the maximum of %d steps, decrease the time window or increase the step", MaxRangeSteps)
+		}
+	}
+
+	return validateAggregation(r.Aggregation, r.Step != nil)
+}
+
+func validateAggregation(agg *Aggregation, hasStep bool) error {
+	if agg == nil || agg.Function == Aggregation_FUNCTION_UNSPECIFIED {
+		return nil
+	}
+
+	if _, ok := Aggregation_Function_name[int32(agg.Function)]; !ok {
+		return fmt.Errorf("invalid aggregation function")
+	}
+
+	if !hasStep {
+		return fmt.Errorf("aggregation requires a step")
+	}
+
+	if agg.Function == Aggregation_FUNCTION_TOPK && agg.K == 0 {
+		return fmt.Errorf("topk requires k to be positive")
+	}
+
+	return nil
 }
 
 // Validate the QueryRequest
@@ -50,6 +90,34 @@ func (r *QueryRequest) Validate() error {
 		return err
 	}
 
+	if err := validateTopOptions(r.TopOptions); err != nil {
+		return err
+	}
+
+	if err := validateCallgraphOptions(r.CallgraphOptions); err != nil {
+		return err
+	}
+
+	if err := validateFlamegraphOptions(r.FlamegraphOptions); err != nil {
+		return err
+	}
+
+	if err := validateProfileFilter(r.Filter); err != nil {
+		return err
+	}
+
+	if r.ReportType == QueryRequest_REPORT_TYPE_SOURCE {
+		if err := validateSourceOptions(r.SourceOptions); err != nil {
+			return err
+		}
+	}
+
+	if r.ReportType == QueryRequest_REPORT_TYPE_DISASSEMBLY {
+		if err := validateDisassemblyOptions(r.DisassemblyOptions); err != nil {
+			return err
+		}
+	}
+
 	switch r.Mode {
 	case QueryRequest_MODE_SINGLE_UNSPECIFIED:
 		err := validateSingle(r.GetSingle())
@@ -73,6 +141,76 @@ func (r *QueryRequest) Validate() error {
 	return nil
 }
 
+func validateTopOptions(opts *TopOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	if _, ok := TopOptions_SortBy_name[int32(opts.SortBy)]; !ok {
+		return fmt.Errorf("invalid top sort by")
+	}
+
+	return nil
+}
+
+func validateCallgraphOptions(opts *CallgraphOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(opts,
+		validation.Field(&opts.NodeFraction, validation.Min(0.0), validation.Max(1.0)),
+		validation.Field(&opts.EdgeFraction, validation.Min(0.0), validation.Max(1.0)),
+	)
+}
+
+func validateFlamegraphOptions(opts *FlamegraphOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	if _, ok := FlamegraphOptions_AggregateBy_name[int32(opts.AggregateBy)]; !ok {
+		return fmt.Errorf("invalid flamegraph aggregate by")
+	}
+
+	return validation.ValidateStruct(opts,
+		validation.Field(&opts.MinFraction, validation.Min(0.0), validation.Max(1.0)),
+	)
+}
+
+func validateProfileFilter(f *ProfileFilter) error {
+	if f == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(f,
+		validation.Field(&f.Focus, isRegexp()),
+		validation.Field(&f.Ignore, isRegexp()),
+		validation.Field(&f.Hide, isRegexp()),
+		validation.Field(&f.Show, isRegexp()),
+	)
+}
+
+func validateSourceOptions(opts *SourceOptions) error {
+	if opts == nil {
+		return fmt.Errorf("source report requires source options")
+	}
+
+	return validation.ValidateStruct(opts,
+		validation.Field(&opts.Function, validation.Required),
+	)
+}
+
+func validateDisassemblyOptions(opts *DisassemblyOptions) error {
+	if opts == nil {
+		return fmt.Errorf("disassembly report requires disassembly options")
+	}
+
+	return validation.ValidateStruct(opts,
+		validation.Field(&opts.Function, validation.Required),
+	)
+}
+
 func validateSingle(single *SingleProfile) error {
 	if single == nil {
 		return fmt.Errorf("single must not be unset")
@@ -307,3 +445,20 @@ func (a AfterRule) Validate(t interface{}) error {
 
 	return nil
 }
+
+type RegexpRule struct{}
+
+func isRegexp() RegexpRule { return RegexpRule{} }
+
+func (r RegexpRule) Validate(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("regexp is not a string")
+	}
+
+	if _, err := regexp.Compile(s); err != nil {
+		return fmt.Errorf("invalid regexp: %w", err)
+	}
+
+	return nil
+}
@@ -42,6 +42,11 @@ func trueValue() *bool {
 	return &a
 }
 
+func falseValue() *bool {
+	a := false
+	return &a
+}
+
 func DefaultScrapeConfig() ScrapeConfig {
 	return ScrapeConfig{
 		ScrapeInterval: model.Duration(time.Second * 10),
@@ -74,6 +79,10 @@ func DefaultScrapeConfig() ScrapeConfig {
 					Enabled: trueValue(),
 					Path:    "/debug/pprof/threadcreate",
 				},
+				"trace": &PprofProfilingConfig{
+					Enabled: falseValue(),
+					Path:    "/debug/pprof/trace",
+				},
 			},
 		},
 	}
@@ -135,11 +144,18 @@ type ScrapeConfig struct {
 
 	ProfilingConfig *ProfilingConfig `yaml:"profiling_config,omitempty"`
 
+	// The pprof sample labels promoted to series labels.
+	PromotePprofLabels *PromotePprofLabelsConfig `yaml:"promote_pprof_labels,omitempty"`
+
 	RelabelConfigs []*relabel.Config `yaml:"relabel_configs,omitempty"`
 	// We cannot do proper Go type embedding below as the parser will then parse
 	// values arbitrarily into the overflow maps of further-down types.
 	ServiceDiscoveryConfigs discovery.Configs             `yaml:"-"`
 	HTTPClientConfig        commonconfig.HTTPClientConfig `yaml:",inline"`
+
+	// scrapeTimeoutSet is whether the scrape timeout was configured rather
+	// than defaulted.
+	scrapeTimeoutSet bool
 }
 
 // SetDirectory joins any relative file paths with dir.
@@ -160,18 +176,65 @@ type ProfilingConfig struct {
 
 type PprofConfig map[string]*PprofProfilingConfig
 
+// DefaultPromotedMaxSeries is the default max number of series a job's
+// promoted pprof labels can create.
+const DefaultPromotedMaxSeries = 100
+
+// PromotePprofLabelsConfig configures the pprof sample labels whose values
+// split the profiles of a job into one series per combination of values.
+type PromotePprofLabelsConfig struct {
+	// Keys of the pprof labels promoted to series labels.
+	Keys []string `yaml:"keys,omitempty"`
+	// The max number of distinct combinations of values promoted per job,
+	// the samples of any further combination stay in the series without
+	// promoted labels.
+	MaxSeries int `yaml:"max_series,omitempty"`
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (c *PromotePprofLabelsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain PromotePprofLabelsConfig
+	if err := unmarshal((*plain)(c)); err != nil {
+		return err
+	}
+
+	if c.MaxSeries == 0 {
+		c.MaxSeries = DefaultPromotedMaxSeries
+	}
+	if c.MaxSeries < 0 {
+		return fmt.Errorf("max_series %d must not be negative", c.MaxSeries)
+	}
+
+	seen := make(map[string]bool, len(c.Keys))
+	for _, key := range c.Keys {
+		if !model.LabelName(key).IsValid() || strings.HasPrefix(key, model.ReservedLabelPrefix) {
+			return fmt.Errorf("%q is not a valid label name to promote", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("label %q is promoted more than once", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
 // UnmarshalYAML implements the yaml.Unmarshaler interface.
 func (c *ScrapeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	defaults := DefaultScrapeConfig()
 	unmarshalled := ScrapeConfig{
 		ScrapeInterval: defaults.ScrapeInterval,
-		ScrapeTimeout:  defaults.ScrapeTimeout,
 		Scheme:         defaults.Scheme,
 	}
 	if err := discovery.UnmarshalYAMLWithInlineConfigs(&unmarshalled, unmarshal); err != nil {
 		return err
 	}
 
+	if unmarshalled.ScrapeTimeout == 0 {
+		unmarshalled.ScrapeTimeout = defaults.ScrapeTimeout
+	} else {
+		unmarshalled.scrapeTimeoutSet = true
+	}
+
 	if unmarshalled.ProfilingConfig == nil {
 		unmarshalled.ProfilingConfig = defaults.ProfilingConfig
 	} else {
@@ -197,6 +260,14 @@ func (c *ScrapeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return errors.New("job_name is empty")
 	}
 
+	if c.ScrapeInterval <= 0 {
+		return fmt.Errorf("scrape interval of job %q must be positive", c.JobName)
+	}
+
+	if c.scrapeTimeoutSet && c.ScrapeTimeout > c.ScrapeInterval {
+		return fmt.Errorf("scrape timeout %s greater than scrape interval %s for job %q", c.ScrapeTimeout, c.ScrapeInterval, c.JobName)
+	}
+
 	// The UnmarshalYAML method of HTTPClientConfig is not being called because it's not a pointer.
 	// We cannot make it a pointer as the parser panics for inlined pointer structs.
 	// Thus we just do its validation here.
@@ -217,6 +288,76 @@ func (c *ScrapeConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 	}
 
+	for pt, pc := range c.ProfilingConfig.PprofConfig {
+		if pc == nil {
+			return fmt.Errorf("empty or null %s profile config", pt)
+		}
+		// Profiles without a default are enabled unless disabled explicitly too.
+		if pc.Enabled == nil {
+			pc.Enabled = trueValue()
+		}
+		if err := c.validateProfile(pt, pc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IntervalAndTimeout returns the scrape interval and timeout of the profile
+// type. Profile types are scraped at the interval and with the timeout of
+// the job unless they have their own. Unless configured, the timeout leaves
+// the targets a second to respond to delta profiles of the whole interval.
+func (c *ScrapeConfig) IntervalAndTimeout(profileType string) (time.Duration, time.Duration) {
+	interval, timeout := time.Duration(c.ScrapeInterval), time.Duration(c.ScrapeTimeout)
+	timeoutSet := c.scrapeTimeoutSet
+	if c.ProfilingConfig != nil {
+		if pc := c.ProfilingConfig.PprofConfig[profileType]; pc != nil {
+			if pc.ScrapeInterval > 0 {
+				interval = time.Duration(pc.ScrapeInterval)
+			}
+			if pc.ScrapeTimeout > 0 {
+				timeout, timeoutSet = time.Duration(pc.ScrapeTimeout), true
+			}
+		}
+	}
+	if !timeoutSet && timeout <= interval {
+		timeout = interval + time.Second
+	}
+	return interval, timeout
+}
+
+// validateProfile checks that a configured scrape timeout of the profile
+// type, its own or the job's, doesn't exceed its interval, that its delta
+// options are consistent and that its delta profiles can be requested within
+// the scrape timeout.
+func (c *ScrapeConfig) validateProfile(profileType string, pc *PprofProfilingConfig) error {
+	interval, timeout := c.IntervalAndTimeout(profileType)
+	if (c.scrapeTimeoutSet || pc.ScrapeTimeout > 0) && timeout > interval {
+		return fmt.Errorf("scrape timeout %s greater than scrape interval %s for %s profile", timeout, interval, profileType)
+	}
+	return pc.validate(profileType, interval, timeout)
+}
+
+// validate checks that the delta options of the profile type are consistent
+// and that its delta profiles can be requested within the scrape timeout.
+func (c *PprofProfilingConfig) validate(profileType string, interval, timeout time.Duration) error {
+	if c.Seconds < 0 {
+		return fmt.Errorf("seconds %d of %s profile must not be negative", c.Seconds, profileType)
+	}
+	if c.Seconds > 0 && !c.Delta {
+		return fmt.Errorf("seconds of %s profile require delta to be enabled", profileType)
+	}
+	if c.Delta && c.ServerSideDelta {
+		return fmt.Errorf("%s profile can't have both delta and server_side_delta enabled", profileType)
+	}
+	if !*c.Enabled || !c.Delta {
+		return nil
+	}
+
+	if seconds := time.Duration(c.DeltaSeconds(interval)) * time.Second; seconds > timeout {
+		return fmt.Errorf("%s delta profile of %s must not exceed the scrape timeout %s", profileType, seconds, timeout)
+	}
 	return nil
 }
 
@@ -241,6 +382,31 @@ type PprofProfilingConfig struct {
 	Enabled *bool  `yaml:"enabled,omitempty"`
 	Path    string `yaml:"path,omitempty"`
 	Delta   bool   `yaml:"delta,omitempty"`
+	// Seconds is the duration of the delta profiles requested from the
+	// targets, by default the scrape interval.
+	Seconds int `yaml:"seconds,omitempty"`
+	// ServerSideDelta stores the difference of the cumulative profiles of
+	// the targets to their previous profile instead of the profiles.
+	ServerSideDelta bool `yaml:"server_side_delta,omitempty"`
+	// How frequently to scrape the profile, by default the scrape interval
+	// of the job.
+	ScrapeInterval model.Duration `yaml:"scrape_interval,omitempty"`
+	// The timeout for scraping the profile, by default the scrape timeout of
+	// the job.
+	ScrapeTimeout model.Duration `yaml:"scrape_timeout,omitempty"`
+}
+
+// DeltaSeconds returns the duration in seconds of the delta profiles
+// requested at the profile's scrape interval.
+func (c *PprofProfilingConfig) DeltaSeconds(interval time.Duration) int {
+	if c.Seconds > 0 {
+		return c.Seconds
+	}
+	// Profiling for the whole interval leaves no gaps between the profiles.
+	if s := int(interval / time.Second); s > 0 {
+		return s
+	}
+	return 1
 }
 
 // CheckTargetAddress checks if target address is valid.
@@ -83,6 +83,10 @@ scrape_configs:
 						Enabled: trueValue(),
 						Path:    "/debug/pprof/threadcreate",
 					},
+					"trace": &PprofProfilingConfig{
+						Enabled: falseValue(),
+						Path:    "/debug/pprof/trace",
+					},
 					"fgprof": &PprofProfilingConfig{
 						Enabled: trueValue(),
 						Path:    "/debug/fgprof",
@@ -102,3 +106,155 @@ scrape_configs:
 	require.Len(t, c.ScrapeConfigs, 1)
 	require.Equal(t, expected, c)
 }
+
+func TestLoadPromotePprofLabels(t *testing.T) {
+	c, err := Load(`scrape_configs:
+- job_name: 'test'
+  promote_pprof_labels:
+    keys: [endpoint, worker_pool]
+  static_configs:
+  - targets: ['localhost:8080']`)
+	require.NoError(t, err)
+	require.Equal(t, &PromotePprofLabelsConfig{
+		Keys:      []string{"endpoint", "worker_pool"},
+		MaxSeries: DefaultPromotedMaxSeries,
+	}, c.ScrapeConfigs[0].PromotePprofLabels)
+
+	for _, promote := range []string{
+		`{keys: [__name__]}`,
+		`{keys: [not-a-label]}`,
+		`{keys: [endpoint, endpoint]}`,
+		`{keys: [endpoint], max_series: -1}`,
+	} {
+		_, err := Load(`scrape_configs:
+- job_name: 'test'
+  promote_pprof_labels: ` + promote)
+		require.Error(t, err, promote)
+	}
+}
+
+func TestLoadDeltaSeconds(t *testing.T) {
+	c, err := Load(`scrape_configs:
+- job_name: 'test'
+  scrape_interval: 30s
+  scrape_timeout: 30s
+  profiling_config:
+    pprof_config:
+      memory_delta:
+        path: /debug/pprof/allocs
+        delta: true
+        seconds: 20`)
+	require.NoError(t, err)
+	pprof := c.ScrapeConfigs[0].ProfilingConfig.PprofConfig
+	require.Equal(t, 30, pprof["process_cpu"].DeltaSeconds(30*time.Second))
+	require.Equal(t, 20, pprof["memory_delta"].DeltaSeconds(30*time.Second))
+
+	// The default CPU profiles fit the default timeout at any interval.
+	for _, tc := range []struct {
+		interval string
+		timeout  time.Duration
+		seconds  int
+	}{
+		{"1s", 11 * time.Second, 1},
+		{"10s", 11 * time.Second, 10},
+		{"15s", 16 * time.Second, 15},
+		{"1m", 61 * time.Second, 60},
+	} {
+		c, err := Load(`scrape_configs:
+- job_name: 'test'
+  scrape_interval: ` + tc.interval)
+		require.NoError(t, err, tc.interval)
+		interval, timeout := c.ScrapeConfigs[0].IntervalAndTimeout("process_cpu")
+		require.Equal(t, tc.timeout, timeout, tc.interval)
+		require.Equal(t, tc.seconds, c.ScrapeConfigs[0].ProfilingConfig.PprofConfig["process_cpu"].DeltaSeconds(interval), tc.interval)
+	}
+
+	for _, cfg := range []string{
+		`{scrape_interval: 30s, scrape_timeout: 20s}`,
+		`{scrape_interval: 30s, scrape_timeout: 20s, profiling_config: {pprof_config: {process_cpu: {delta: true, seconds: 25}}}}`,
+		`{profiling_config: {pprof_config: {memory_delta: {delta: true, seconds: 12}}}}`,
+		`{profiling_config: {pprof_config: {memory_delta: {delta: true, seconds: -1}}}}`,
+		`{profiling_config: {pprof_config: {memory_total: {seconds: 5}}}}`,
+		`{profiling_config: {pprof_config: {memory_delta: null}}}`,
+		`{profiling_config: {pprof_config: {memory_total: {delta: true, server_side_delta: true}}}}`,
+	} {
+		_, err := Load(`scrape_configs:
+- job_name: 'test'
+  <<: ` + cfg)
+		require.Error(t, err, cfg)
+	}
+
+	// Disabled profiles aren't checked against the timeout.
+	_, err = Load(`scrape_configs:
+- job_name: 'test'
+  scrape_interval: 30s
+  scrape_timeout: 20s
+  profiling_config:
+    pprof_config:
+      process_cpu:
+        enabled: false
+        delta: true
+        seconds: 25`)
+	require.NoError(t, err)
+}
+
+func TestLoadProfileScrapeIntervals(t *testing.T) {
+	c, err := Load(`scrape_configs:
+- job_name: 'test'
+  scrape_interval: 1m
+  scrape_timeout: 30s
+  profiling_config:
+    pprof_config:
+      process_cpu:
+        delta: true
+        scrape_interval: 10s
+        scrape_timeout: 10s
+      goroutine_total:
+        scrape_interval: 5m
+        scrape_timeout: 1m`)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		profileType       string
+		interval, timeout time.Duration
+	}{
+		{"process_cpu", 10 * time.Second, 10 * time.Second},
+		{"memory_total", time.Minute, 30 * time.Second},
+		{"goroutine_total", 5 * time.Minute, time.Minute},
+	} {
+		interval, timeout := c.ScrapeConfigs[0].IntervalAndTimeout(tc.profileType)
+		require.Equal(t, tc.interval, interval, tc.profileType)
+		require.Equal(t, tc.timeout, timeout, tc.profileType)
+	}
+
+	// The default timeout is a second longer than the profiles' interval,
+	// but at least the one of the job.
+	c, err = Load(`scrape_configs:
+- job_name: 'test'
+  scrape_interval: 5s
+  profiling_config:
+    pprof_config:
+      memory_total:
+        scrape_interval: 1m`)
+	require.NoError(t, err)
+	require.Equal(t, model.Duration(11*time.Second), c.ScrapeConfigs[0].ScrapeTimeout)
+	interval, timeout := c.ScrapeConfigs[0].IntervalAndTimeout("block_total")
+	require.Equal(t, 5*time.Second, interval)
+	require.Equal(t, 11*time.Second, timeout)
+	interval, timeout = c.ScrapeConfigs[0].IntervalAndTimeout("memory_total")
+	require.Equal(t, time.Minute, interval)
+	require.Equal(t, 61*time.Second, timeout)
+
+	// Configured timeouts, the job's or the profile's, must not exceed the
+	// interval of the profile.
+	for _, cfg := range []string{
+		`{scrape_interval: 10s, scrape_timeout: 11s}`,
+		`{scrape_interval: 1m, scrape_timeout: 30s, profiling_config: {pprof_config: {memory_total: {scrape_interval: 10s}}}}`,
+		`{profiling_config: {pprof_config: {memory_total: {scrape_interval: 10s, scrape_timeout: 11s}}}}`,
+	} {
+		_, err := Load(`scrape_configs:
+- job_name: 'test'
+  <<: ` + cfg)
+		require.Error(t, err, cfg)
+	}
+}
@@ -45,6 +45,21 @@ func (c *DebugInfoClient) Exists(ctx context.Context, buildId string) (bool, err
 }
 
 func (c *DebugInfoClient) Upload(ctx context.Context, buildId string, r io.Reader) (uint64, error) {
+	return c.upload(ctx, &debuginfopb.UploadInfo{
+		BuildId: buildId,
+	}, r)
+}
+
+// UploadSources uploads a gzipped tar archive of the source files the binary
+// with the given build ID was built from.
+func (c *DebugInfoClient) UploadSources(ctx context.Context, buildId string, r io.Reader) (uint64, error) {
+	return c.upload(ctx, &debuginfopb.UploadInfo{
+		BuildId: buildId,
+		Type:    debuginfopb.UploadInfo_TYPE_SOURCES,
+	}, r)
+}
+
+func (c *DebugInfoClient) upload(ctx context.Context, info *debuginfopb.UploadInfo, r io.Reader) (uint64, error) {
 	stream, err := c.c.Upload(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("initiate upload: %w", err)
@@ -52,9 +67,7 @@ func (c *DebugInfoClient) Upload(ctx context.Context, buildId string, r io.Reade
 
 	err = stream.Send(&debuginfopb.UploadRequest{
 		Data: &debuginfopb.UploadRequest_Info{
-			Info: &debuginfopb.UploadInfo{
-				BuildId: buildId,
-			},
+			Info: info,
 		},
 	})
 	if err != nil {
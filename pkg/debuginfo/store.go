@@ -14,6 +14,8 @@
 package debuginfo
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"encoding/hex"
 	"errors"
@@ -22,7 +24,9 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -35,9 +39,18 @@ import (
 
 	debuginfopb "github.com/parca-dev/parca/gen/proto/go/parca/debuginfo/v1alpha1"
 	"github.com/parca-dev/parca/internal/pprof/binutils"
+	"github.com/parca-dev/parca/internal/pprof/plugin"
 )
 
-var ErrDebugInfoNotFound = errors.New("debug info not found")
+var (
+	ErrDebugInfoNotFound = errors.New("debug info not found")
+	ErrSourceNotFound    = errors.New("source not found")
+)
+
+const (
+	debugInfoObject = "debuginfo"
+	sourcesObject   = "sources"
+)
 
 type CacheProvider string
 
@@ -60,43 +73,109 @@ type CacheConfig struct {
 }
 
 type Store struct {
-	bucket objstore.Bucket
 	logger log.Logger
 
-	cacheDir   string
+	// mtx protects the bucket and cache, which are replaced when a
+	// different config is applied.
+	mtx      sync.RWMutex
+	config   *Config
+	bucket   *storeBucket
+	cacheDir string
+
 	symbolizer *symbolizer
 }
 
 func NewStore(logger log.Logger, config *Config) (*Store, error) {
-	cfg, err := yaml.Marshal(config.Bucket)
+	bucket, cache, err := newBucketAndCache(logger, config)
 	if err != nil {
-		return nil, fmt.Errorf("marshal content of object storage configuration: %w", err)
+		return nil, err
 	}
 
-	bucket, err := client.NewBucket(logger, cfg, nil, "parca")
+	return &Store{
+		logger:   log.With(logger, "component", "debuginfo"),
+		config:   config,
+		bucket:   &storeBucket{Bucket: bucket},
+		cacheDir: cache.Directory,
+		symbolizer: &symbolizer{
+			logger: log.With(logger, "component", "debuginfo/symbolizer"),
+			bu:     &binutils.Binutils{},
+		},
+	}, nil
+}
+
+// ApplyConfig replaces the object storage and cache if the config changed.
+func (s *Store) ApplyConfig(config *Config) error {
+	s.mtx.RLock()
+	unchanged := reflect.DeepEqual(s.config, config)
+	s.mtx.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	bucket, cache, err := newBucketAndCache(s.logger, config)
 	if err != nil {
-		return nil, fmt.Errorf("instantiate object storage: %w", err)
+		return err
+	}
+
+	s.mtx.Lock()
+	old := s.bucket
+	s.config = config
+	s.bucket = &storeBucket{Bucket: bucket}
+	s.cacheDir = cache.Directory
+	s.mtx.Unlock()
+
+	// Requests that started before the swap may still use the previous object storage.
+	go func() {
+		old.users.Wait()
+		if err := old.Close(); err != nil {
+			level.Warn(s.logger).Log("msg", "failed to close previous object storage", "err", err)
+		}
+	}()
+	return nil
+}
+
+// storeBucket is an object storage that is closed once it has been replaced
+// and all requests using it are done.
+type storeBucket struct {
+	objstore.Bucket
+	users sync.WaitGroup
+}
+
+// bucketAndCache returns the object storage and cache directory currently in use.
+// The object storage isn't closed before release is called.
+func (s *Store) bucketAndCache() (bucket objstore.Bucket, cacheDir string, release func()) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	s.bucket.users.Add(1)
+	return s.bucket, s.cacheDir, s.bucket.users.Done
+}
+
+func newBucketAndCache(logger log.Logger, config *Config) (objstore.Bucket, *FilesystemCacheConfig, error) {
+	if config == nil {
+		return nil, nil, errors.New("missing debug info configuration")
 	}
 
 	cacheCfg, err := yaml.Marshal(config.Cache)
 	if err != nil {
-		return nil, fmt.Errorf("marshal content of cache configuration: %w", err)
+		return nil, nil, fmt.Errorf("marshal content of cache configuration: %w", err)
 	}
 
 	cache, err := newCache(cacheCfg)
 	if err != nil {
-		return nil, fmt.Errorf("instantiate cache: %w", err)
+		return nil, nil, fmt.Errorf("instantiate cache: %w", err)
 	}
 
-	return &Store{
-		logger:   log.With(logger, "component", "debuginfo"),
-		bucket:   bucket,
-		cacheDir: cache.Directory,
-		symbolizer: &symbolizer{
-			logger: log.With(logger, "component", "debuginfo/symbolizer"),
-			bu:     &binutils.Binutils{},
-		},
-	}, nil
+	cfg, err := yaml.Marshal(config.Bucket)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal content of object storage configuration: %w", err)
+	}
+
+	bucket, err := client.NewBucket(logger, cfg, nil, "parca")
+	if err != nil {
+		return nil, nil, fmt.Errorf("instantiate object storage: %w", err)
+	}
+
+	return bucket, cache, nil
 }
 
 func newCache(cacheCfg []byte) (*FilesystemCacheConfig, error) {
@@ -138,14 +217,10 @@ func (s *Store) Exists(ctx context.Context, req *debuginfopb.ExistsRequest) (*de
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	path := req.BuildId
-
-	found := false
-	err = s.bucket.Iter(ctx, path, func(_ string) error {
-		// We just need any debug files to be present.
-		found = true
-		return nil
-	})
+	// Only the debug info counts, uploaded sources alone can't be used for symbolization.
+	bucket, _, release := s.bucketAndCache()
+	defer release()
+	found, err := bucket.Exists(ctx, path.Join(req.BuildId, debugInfoObject))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -168,10 +243,21 @@ func (s *Store) Upload(stream debuginfopb.DebugInfoService_UploadServer) error {
 	if err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
-	path := buildId + "/debuginfo"
+
+	var object string
+	switch req.GetInfo().Type {
+	case debuginfopb.UploadInfo_TYPE_DEBUGINFO_UNSPECIFIED:
+		object = debugInfoObject
+	case debuginfopb.UploadInfo_TYPE_SOURCES:
+		object = sourcesObject
+	default:
+		return status.Error(codes.InvalidArgument, "unknown upload type")
+	}
 
 	r := &UploadReader{stream: stream}
-	err = s.bucket.Upload(stream.Context(), path, r)
+	bucket, _, release := s.bucketAndCache()
+	defer release()
+	err = bucket.Upload(stream.Context(), path.Join(buildId, object), r)
 	if err != nil {
 		msg := "failed to upload"
 		level.Error(s.logger).Log("msg", msg, "err", err)
@@ -224,18 +310,115 @@ func (s *Store) Symbolize(ctx context.Context, m *profile.Mapping, locations ...
 	return locationLines, nil
 }
 
+// ObjectFile opens the debug info uploaded for the build ID of the mapping, to
+// look up its symbols and translate the addresses of the mapping.
+func (s *Store) ObjectFile(ctx context.Context, m *profile.Mapping) (plugin.ObjFile, error) {
+	localObjPath, err := s.fetchObjectFile(ctx, m.BuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := s.symbolizer.bu.Open(localObjPath, m.Start, m.Limit, m.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("open object file: %w", err)
+	}
+	return f, nil
+}
+
+// Disasm disassembles an object file opened by ObjectFile, from the start to the end address.
+func (s *Store) Disasm(file string, start, end uint64, intelSyntax bool) ([]plugin.Inst, error) {
+	return s.symbolizer.bu.Disasm(file, start, end, intelSyntax)
+}
+
+// SourceFile returns the content of a source file from the source archive
+// uploaded for the build ID. The paths recorded in binaries rarely match the
+// paths in the archive, so the longest archive entry that is a path suffix of
+// the filename is used.
+func (s *Store) SourceFile(ctx context.Context, buildID, filename string) ([]byte, error) {
+	if err := validateId(buildID); err != nil {
+		return nil, err
+	}
+
+	archivePath, err := s.fetchObject(ctx, buildID, sourcesObject, ErrSourceNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("open source archive: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("read gzip of source archive: %w", err)
+	}
+	defer gz.Close()
+
+	var (
+		tr       = tar.NewReader(gz)
+		content  []byte
+		matchLen = -1
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read source archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "/"))
+		if !isPathSuffix(filename, name) || len(name) <= matchLen {
+			continue
+		}
+
+		content, err = io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("read %s from source archive: %w", hdr.Name, err)
+		}
+		matchLen = len(name)
+	}
+	if matchLen < 0 {
+		return nil, ErrSourceNotFound
+	}
+
+	return content, nil
+}
+
+// isPathSuffix returns whether the trailing path elements of filename are suffix.
+func isPathSuffix(filename, suffix string) bool {
+	filename = path.Clean(filename)
+	return filename == suffix || strings.HasSuffix(filename, "/"+suffix)
+}
+
 func (s *Store) fetchObjectFile(ctx context.Context, buildID string) (string, error) {
-	mappingPath := path.Join(s.cacheDir, buildID, "debuginfo")
+	return s.fetchObject(ctx, buildID, debugInfoObject, ErrDebugInfoNotFound)
+}
+
+// fetchObject returns the path of the locally cached copy of an object
+// uploaded for the build ID, downloading it first if needed.
+func (s *Store) fetchObject(ctx context.Context, buildID, object string, errNotFound error) (string, error) {
+	bucket, cacheDir, release := s.bucketAndCache()
+	defer release()
+	objectPath := path.Join(cacheDir, buildID, object)
 	// Check if it's already cached locally; if not download.
-	if _, err := os.Stat(mappingPath); os.IsNotExist(err) {
-		r, err := s.bucket.Get(ctx, path.Join(buildID, "debuginfo"))
-		if s.bucket.IsObjNotFoundErr(err) {
-			level.Debug(s.logger).Log("msg", "object not found", "object", buildID, "err", err)
-			return "", ErrDebugInfoNotFound
+	if _, err := os.Stat(objectPath); os.IsNotExist(err) {
+		r, err := bucket.Get(ctx, path.Join(buildID, object))
+		if bucket.IsObjNotFoundErr(err) {
+			level.Debug(s.logger).Log("msg", "object not found", "object", buildID, "type", object, "err", err)
+			return "", errNotFound
 		}
 		if err != nil {
 			return "", fmt.Errorf("get object from object storage: %w", err)
 		}
+		defer r.Close()
+
 		tmpfile, err := ioutil.TempFile("", "symbol-download")
 		if err != nil {
 			return "", fmt.Errorf("create temp file: %w", err)
@@ -251,14 +434,14 @@ func (s *Store) fetchObjectFile(ctx context.Context, buildID string) (string, er
 			return "", fmt.Errorf("close tempfile to write object file: %w", err)
 		}
 
-		err = os.MkdirAll(path.Join(s.cacheDir, buildID), 0700)
+		err = os.MkdirAll(path.Join(cacheDir, buildID), 0700)
 		if err != nil {
 			return "", fmt.Errorf("create object file directory: %w", err)
 		}
 		// Need to use rename to make the "creation" atomic.
-		if err := os.Rename(tmpfile.Name(), mappingPath); err != nil {
+		if err := os.Rename(tmpfile.Name(), objectPath); err != nil {
 			return "", fmt.Errorf("atomically move downloaded object file: %w", err)
 		}
 	}
-	return mappingPath, nil
+	return objectPath, nil
 }
@@ -14,18 +14,24 @@
 package debuginfo
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"io"
 	"io/ioutil"
 	stdlog "log"
 	"net"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/go-kit/log"
+	"github.com/google/pprof/profile"
 	debuginfopb "github.com/parca-dev/parca/gen/proto/go/parca/debuginfo/v1alpha1"
 	"github.com/stretchr/testify/require"
+	"github.com/thanos-io/thanos/pkg/objstore"
 	"github.com/thanos-io/thanos/pkg/objstore/client"
 	"github.com/thanos-io/thanos/pkg/objstore/filesystem"
 	"google.golang.org/grpc"
@@ -108,4 +114,175 @@ func TestStore(t *testing.T) {
 	exists, err := c.Exists(context.Background(), "abcd")
 	require.NoError(t, err)
 	require.True(t, exists)
+
+	archive := bytes.NewBuffer(nil)
+	gz := gzip.NewWriter(archive)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string]string{
+		"./main.go":          "package main\n",
+		"pkg/foo.go":         "package other\n",
+		"project/pkg/foo.go": "package pkg\n",
+	} {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	_, err = c.UploadSources(context.Background(), "ef01", archive)
+	require.NoError(t, err)
+
+	// Sources alone are not debug info.
+	exists, err = c.Exists(context.Background(), "ef01")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	content, err = s.SourceFile(context.Background(), "ef01", "/build/main.go")
+	require.NoError(t, err)
+	require.Equal(t, "package main\n", string(content))
+
+	// The longest matching entry wins.
+	content, err = s.SourceFile(context.Background(), "ef01", "/build/project/pkg/foo.go")
+	require.NoError(t, err)
+	require.Equal(t, "package pkg\n", string(content))
+
+	_, err = s.SourceFile(context.Background(), "ef01", "/build/foo.go")
+	require.ErrorIs(t, err, ErrSourceNotFound)
+
+	_, err = s.SourceFile(context.Background(), "abcd", "/build/main.go")
+	require.ErrorIs(t, err, ErrSourceNotFound)
+}
+
+func TestStoreObjectFile(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "parca-test-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	s, err := NewStore(log.NewNopLogger(), &Config{
+		Bucket: &client.BucketConfig{
+			Type: client.FILESYSTEM,
+			Config: filesystem.Config{
+				Directory: "../symbol/testdata/",
+			},
+		},
+		Cache: &CacheConfig{
+			Type: FILESYSTEM,
+			Config: &FilesystemCacheConfig{
+				Directory: cacheDir,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	m := &profile.Mapping{
+		Start:   4194304,
+		Limit:   4603904,
+		BuildID: "2d6912fd3dd64542f6f6294f4bf9cb6c265b3085",
+	}
+	obj, err := s.ObjectFile(context.Background(), m)
+	require.NoError(t, err)
+	defer obj.Close()
+
+	syms, err := obj.Symbols(regexp.MustCompile(`^main\.main$`), 0)
+	require.NoError(t, err)
+	require.Len(t, syms, 1)
+
+	addr, err := obj.ObjAddr(0x463781)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, addr, syms[0].Start)
+	require.LessOrEqual(t, addr, syms[0].End)
+
+	insts, err := s.Disasm(obj.Name(), syms[0].Start, syms[0].End, false)
+	require.NoError(t, err)
+	require.NotEmpty(t, insts)
+	require.Equal(t, syms[0].Start, insts[0].Addr)
+
+	_, err = s.ObjectFile(context.Background(), &profile.Mapping{BuildID: "abcd"})
+	require.ErrorIs(t, err, ErrDebugInfoNotFound)
+}
+
+func TestStoreApplyConfig(t *testing.T) {
+	newConfig := func(dir string) *Config {
+		return &Config{
+			Bucket: &client.BucketConfig{
+				Type: client.FILESYSTEM,
+				Config: filesystem.Config{
+					Directory: dir,
+				},
+			},
+			Cache: &CacheConfig{
+				Type: FILESYSTEM,
+				Config: &FilesystemCacheConfig{
+					Directory: t.TempDir(),
+				},
+			},
+		}
+	}
+
+	ctx := context.Background()
+	dirA, dirB := t.TempDir(), t.TempDir()
+	require.NoError(t, os.MkdirAll(dirB+"/abcd", 0o700))
+	require.NoError(t, ioutil.WriteFile(dirB+"/abcd/debuginfo", []byte("debuginfo"), 0o600))
+
+	s, err := NewStore(log.NewNopLogger(), newConfig(dirA))
+	require.NoError(t, err)
+
+	res, err := s.Exists(ctx, &debuginfopb.ExistsRequest{BuildId: "abcd"})
+	require.NoError(t, err)
+	require.False(t, res.Exists)
+
+	closed := make(chan struct{})
+	s.bucket = &storeBucket{Bucket: &closeBucket{Bucket: s.bucket.Bucket, closed: closed}}
+	_, _, release := s.bucketAndCache()
+
+	cfg := newConfig(dirB)
+	require.NoError(t, s.ApplyConfig(cfg))
+	bucket, _, _ := s.bucketAndCache()
+
+	// The previous object storage is closed once the requests using it are done.
+	select {
+	case <-closed:
+		t.Fatal("object storage closed while in use")
+	case <-time.After(100 * time.Millisecond):
+	}
+	release()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("object storage not closed")
+	}
+
+	res, err = s.Exists(ctx, &debuginfopb.ExistsRequest{BuildId: "abcd"})
+	require.NoError(t, err)
+	require.True(t, res.Exists)
+
+	// Applying the same config keeps the object storage.
+	require.NoError(t, s.ApplyConfig(cfg))
+	current, _, _ := s.bucketAndCache()
+	require.Equal(t, bucket, current)
+
+	require.Error(t, s.ApplyConfig(&Config{
+		Bucket: cfg.Bucket,
+		Cache:  &CacheConfig{Type: "MEMCACHED"},
+	}))
+	res, err = s.Exists(ctx, &debuginfopb.ExistsRequest{BuildId: "abcd"})
+	require.NoError(t, err)
+	require.True(t, res.Exists)
+}
+
+// closeBucket closes the channel when the bucket is closed.
+type closeBucket struct {
+	objstore.Bucket
+	closed chan struct{}
+}
+
+func (b *closeBucket) Close() error {
+	close(b.closed)
+	return b.Bucket.Close()
 }
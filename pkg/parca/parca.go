@@ -17,8 +17,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
+	"net/http"
 	"os"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -29,6 +30,8 @@ import (
 	"github.com/parca-dev/parca/pkg/storage/metastore"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/discovery"
+	"github.com/thanos-io/thanos/pkg/objstore"
+	"github.com/thanos-io/thanos/pkg/objstore/filesystem"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/propagation"
@@ -37,12 +40,13 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
-	"gopkg.in/yaml.v2"
 
 	debuginfopb "github.com/parca-dev/parca/gen/proto/go/parca/debuginfo/v1alpha1"
+	metastorepb "github.com/parca-dev/parca/gen/proto/go/parca/metastore/v1alpha1"
 	profilestorepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
 	querypb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
 	scrapepb "github.com/parca-dev/parca/gen/proto/go/parca/scrape/v1alpha1"
+	tracepb "github.com/parca-dev/parca/gen/proto/go/parca/trace/v1alpha1"
 	"github.com/parca-dev/parca/pkg/config"
 	"github.com/parca-dev/parca/pkg/debuginfo"
 	"github.com/parca-dev/parca/pkg/profilestore"
@@ -51,6 +55,7 @@ import (
 	"github.com/parca-dev/parca/pkg/server"
 	"github.com/parca-dev/parca/pkg/storage"
 	"github.com/parca-dev/parca/pkg/symbol"
+	"github.com/parca-dev/parca/pkg/tracestore"
 )
 
 type Flags struct {
@@ -59,8 +64,17 @@ type Flags struct {
 	Port               string   `default:":7070" help:"Port string for server"`
 	CORSAllowedOrigins []string `help:"Allowed CORS origins."`
 	OTLPAddress        string   `help:"OpenTelemetry collector address to send traces to."`
+	EnableLifecycle    bool     `default:"false" help:"Enable reloading the config via HTTP POST requests to /-/reload. The endpoint is unauthenticated, the config is always reloaded on SIGHUP."`
 
+	Metastore           string        `default:"sqliteinmemory" enum:"sqliteinmemory,sqlite,remote" help:"Which metastore implementation to use. The sqlite metastore persists to the metastore path, the remote metastore uses the metastore served by another Parca instance with --metastore-serve. The sqliteinmemory metastore can't be used with a storage path, as the persisted profiles would reference its lost metadata after a restart."`
+	MetastorePath       string        `help:"Path of the SQLite database file of the sqlite metastore. Defaults to metastore.sqlite in the storage path."`
+	MetastoreAddress    string        `help:"gRPC address of the Parca instance serving the remote metastore."`
+	MetastoreServe      bool          `default:"false" help:"Serve the metastore over gRPC to Parca instances using it as their remote metastore. The service is unauthenticated and allows writing to the metastore, so only enable it on trusted networks."`
+	MetastoreGCInterval time.Duration `default:"0s" help:"Interval at which locations, functions and mappings that no stored profile references anymore are deleted from the metastore. 0 disables the garbage collection. Can't be enabled together with --metastore-serve, as the profiles of other Parca instances reference the metastore too."`
+
+	StoragePath                 string        `help:"Directory to persist profile data and execution traces to. If empty, data is only kept in memory. Requires the sqlite or remote metastore."`
 	StorageTSDBRetentionTime    time.Duration `default:"6h" help:"How long to retain samples in storage."`
+	StorageTSDBBlockDuration    time.Duration `default:"2h" help:"Time range covered by the blocks persisted to the storage path." hidden:"true"`
 	StorageTSDBExpensiveMetrics bool          `default:"false" help:"Enable really heavy metrics. Only do this for debugging as the metrics are slowing Parca down by a lot." hidden:"true"`
 }
 
@@ -78,15 +92,9 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 		defer closer()
 	}
 
-	cfgContent, err := ioutil.ReadFile(flags.ConfigPath)
+	cfg, err := loadConfig(flags.ConfigPath)
 	if err != nil {
-		level.Error(logger).Log("msg", "failed to read config", "path", flags.ConfigPath)
-		return err
-	}
-
-	cfg := config.Config{}
-	if err := yaml.Unmarshal(cfgContent, &cfg); err != nil {
-		level.Error(logger).Log("msg", "failed to parse config", "err", err, "path", flags.ConfigPath)
+		level.Error(logger).Log("msg", "failed to load config", "err", err, "path", flags.ConfigPath)
 		return err
 	}
 
@@ -96,37 +104,54 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 		return err
 	}
 
-	mStr, err := metastore.NewInMemorySQLiteProfileMetaStore(
-		reg,
-		// Produces high cardinality traces - uncomment locally if needed.
-		//tracerProvider.Tracer("inmemory-sqlite"),
-		trace.NewNoopTracerProvider().Tracer("inmemory-sqlite"),
-	)
+	mStr, err := openMetaStore(reg, flags)
 	if err != nil {
-		level.Error(logger).Log("msg", "failed to initialize metadata store", "err", err)
+		level.Error(logger).Log("msg", "failed to initialize metadata store", "err", err, "metastore", flags.Metastore)
 		return err
 	}
 	defer mStr.Close()
 
-	db := storage.OpenDB(
+	db, err := storage.OpenDB(
+		logger,
 		reg,
 		tracerProvider.Tracer("db"),
 		&storage.DBOptions{
+			Path:                 flags.StoragePath,
+			BlockDuration:        flags.StorageTSDBBlockDuration,
 			Retention:            flags.StorageTSDBRetentionTime,
 			HeadExpensiveMetrics: flags.StorageTSDBExpensiveMetrics,
 		},
 	)
+	if err != nil {
+		level.Error(logger).Log("msg", "failed to open storage", "err", err, "path", flags.StoragePath)
+		return err
+	}
+	defer db.Close()
+
 	s := profilestore.NewProfileStore(
 		logger,
 		tracerProvider.Tracer("profilestore"),
 		db,
 		mStr,
 	)
+	if err := s.ApplyConfig(cfg.ScrapeConfigs); err != nil {
+		level.Error(logger).Log("msg", "failed to apply promoted pprof labels", "err", err)
+		return err
+	}
+	traceBucket, err := openTraceBucket(flags)
+	if err != nil {
+		level.Error(logger).Log("msg", "failed to open trace storage", "err", err, "path", flags.StoragePath)
+		return err
+	}
+	defer traceBucket.Close()
+
+	traces := tracestore.NewStore(logger, traceBucket)
 	q := query.New(
 		logger,
 		tracerProvider.Tracer("query-service"),
 		db,
 		mStr,
+		dbgInfo,
 	)
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -137,12 +162,27 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 		return err
 	}
 
-	m := scrape.NewManager(logger, reg, s, cfg.ScrapeConfigs)
+	m := scrape.NewManager(logger, reg, s, traces, cfg.ScrapeConfigs)
 	if err := m.ApplyConfig(cfg.ScrapeConfigs); err != nil {
 		level.Error(logger).Log("msg", "failed to apply scrape configs", "err", err)
 		return err
 	}
 
+	reloader := newConfigReloader(logger, reg, flags.ConfigPath,
+		func(cfg *config.Config) error {
+			return dbgInfo.ApplyConfig(cfg.DebugInfo)
+		},
+		func(cfg *config.Config) error {
+			return s.ApplyConfig(cfg.ScrapeConfigs)
+		},
+		func(cfg *config.Config) error {
+			return m.ApplyConfig(cfg.ScrapeConfigs)
+		},
+		func(cfg *config.Config) error {
+			return discoveryManager.ApplyConfig(getDiscoveryConfigs(cfg.ScrapeConfigs))
+		},
+	)
+
 	parcaserver := server.NewServer(reg)
 
 	var gr run.Group
@@ -156,6 +196,24 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 			cancel()
 		})
 	}
+	{
+		ctx, cancel := context.WithCancel(ctx)
+		gr.Add(func() error {
+			return traces.Run(ctx, time.Minute, flags.StorageTSDBRetentionTime)
+		}, func(_ error) {
+			level.Debug(logger).Log("msg", "trace store exiting")
+			cancel()
+		})
+	}
+	{
+		ctx, cancel := context.WithCancel(ctx)
+		gr.Add(func() error {
+			return reloader.run(ctx)
+		}, func(_ error) {
+			level.Debug(logger).Log("msg", "reloader exiting")
+			cancel()
+		})
+	}
 	gr.Add(
 		func() error {
 			return discoveryManager.Run()
@@ -183,9 +241,13 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 				flags.CORSAllowedOrigins,
 				server.RegisterableFunc(func(ctx context.Context, srv *grpc.Server, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
 					debuginfopb.RegisterDebugInfoServiceServer(srv, dbgInfo)
+					if flags.MetastoreServe {
+						metastorepb.RegisterMetastoreServiceServer(srv, metastore.NewMetastoreService(mStr))
+					}
 					profilestorepb.RegisterProfileStoreServiceServer(srv, s)
 					querypb.RegisterQueryServiceServer(srv, q)
 					scrapepb.RegisterScrapeServiceServer(srv, m)
+					tracepb.RegisterTraceServiceServer(srv, traces)
 
 					if err := debuginfopb.RegisterDebugInfoServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 						return err
@@ -199,10 +261,28 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 						return err
 					}
 
+					if err := mux.HandlePath(http.MethodGet, "/profiles/download", q.DownloadPprof); err != nil {
+						return err
+					}
+
+					if flags.EnableLifecycle {
+						if err := mux.HandlePath(http.MethodPost, "/-/reload", reloader.handleReload); err != nil {
+							return err
+						}
+					}
+
 					if err := scrapepb.RegisterScrapeServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 						return err
 					}
 
+					if err := tracepb.RegisterTraceServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+						return err
+					}
+
+					if err := mux.HandlePath(http.MethodGet, "/traces/download", traces.DownloadTrace); err != nil {
+						return err
+					}
+
 					return nil
 				}),
 			)
@@ -230,6 +310,29 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 				cancel()
 			})
 	}
+	if flags.MetastoreGCInterval > 0 {
+		if flags.MetastoreServe {
+			level.Error(logger).Log("msg", "metastore garbage collection can't be enabled while the metastore is served to other instances")
+			return errors.New("metastore garbage collection can't be enabled together with --metastore-serve")
+		}
+
+		gc, ok := mStr.(metastore.GarbageCollector)
+		if !ok {
+			level.Error(logger).Log("msg", "metastore does not support garbage collection", "metastore", flags.Metastore)
+			return errors.New("metastore does not support garbage collection")
+		}
+
+		metaStoreGC := storage.NewMetaStoreGC(logger, db, gc)
+		ctx, cancel := context.WithCancel(ctx)
+		gr.Add(
+			func() error {
+				return metaStoreGC.Run(ctx, flags.MetastoreGCInterval)
+			},
+			func(_ error) {
+				level.Debug(logger).Log("msg", "metastore garbage collection shutting down")
+				cancel()
+			})
+	}
 	if err := gr.Run(); err != nil {
 		if _, ok := err.(run.SignalError); ok {
 			return nil
@@ -240,6 +343,48 @@ func Run(ctx context.Context, logger log.Logger, reg *prometheus.Registry, flags
 	return nil
 }
 
+// openMetaStore opens the metastore implementation selected by the flags.
+func openMetaStore(reg prometheus.Registerer, flags *Flags) (metastore.ProfileMetaStore, error) {
+	// Produces high cardinality traces - use the tracer provider locally if needed.
+	tracer := trace.NewNoopTracerProvider().Tracer(flags.Metastore)
+
+	switch flags.Metastore {
+	case "sqliteinmemory":
+		if flags.StoragePath != "" {
+			return nil, errors.New("the sqliteinmemory metastore can't be used with --storage-path, use the sqlite or remote metastore")
+		}
+		return metastore.NewInMemorySQLiteProfileMetaStore(reg, tracer)
+	case "sqlite":
+		path := flags.MetastorePath
+		if path == "" && flags.StoragePath != "" {
+			path = filepath.Join(flags.StoragePath, "metastore.sqlite")
+		}
+		if path == "" {
+			return nil, errors.New("the sqlite metastore requires --metastore-path or --storage-path to be set")
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+			return nil, fmt.Errorf("create metastore directory: %w", err)
+		}
+		return metastore.NewDiskProfileMetaStore(reg, tracer, path)
+	case "remote":
+		if flags.MetastoreAddress == "" {
+			return nil, errors.New("the remote metastore requires --metastore-address to be set")
+		}
+		return metastore.NewRemoteProfileMetaStore(flags.MetastoreAddress, grpc.WithInsecure())
+	default:
+		return nil, fmt.Errorf("unknown metastore implementation: %s", flags.Metastore)
+	}
+}
+
+// openTraceBucket opens the bucket of the trace store, a directory in the
+// storage path if set and memory otherwise.
+func openTraceBucket(flags *Flags) (objstore.Bucket, error) {
+	if flags.StoragePath == "" {
+		return objstore.NewInMemBucket(), nil
+	}
+	return filesystem.NewBucket(filepath.Join(flags.StoragePath, "traces"))
+}
+
 func getDiscoveryConfigs(cfgs []*config.ScrapeConfig) map[string]discovery.Configs {
 	c := make(map[string]discovery.Configs)
 	for _, v := range cfgs {
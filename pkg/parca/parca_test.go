@@ -17,6 +17,8 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -28,6 +30,23 @@ import (
 	"google.golang.org/grpc"
 )
 
+func TestOpenMetaStore(t *testing.T) {
+	dir := t.TempDir()
+
+	// Profiles persisted to the storage path need their metadata persisted too.
+	_, err := openMetaStore(prometheus.NewRegistry(), &Flags{Metastore: "sqliteinmemory", StoragePath: dir})
+	require.Error(t, err)
+	_, err = openMetaStore(prometheus.NewRegistry(), &Flags{Metastore: "sqlite"})
+	require.Error(t, err)
+
+	// The sqlite metastore defaults to a file in the storage path.
+	m, err := openMetaStore(prometheus.NewRegistry(), &Flags{Metastore: "sqlite", StoragePath: dir})
+	require.NoError(t, err)
+	require.NoError(t, m.Close())
+	_, err = os.Stat(filepath.Join(dir, "metastore.sqlite"))
+	require.NoError(t, err)
+}
+
 func benchmarkSetup(ctx context.Context, b *testing.B) (pb.ProfileStoreServiceClient, <-chan struct{}) {
 	logger := log.NewNopLogger()
 	reg := prometheus.NewRegistry()
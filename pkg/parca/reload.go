@@ -0,0 +1,147 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parca
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/parca-dev/parca/pkg/config"
+)
+
+// loadConfig reads and parses the config file.
+func loadConfig(path string) (*config.Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	cfg := &config.Config{}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+	return cfg, nil
+}
+
+// configReloader re-reads the config file and applies it to the components
+// on SIGHUP and on requests to the reload endpoint.
+type configReloader struct {
+	logger    log.Logger
+	path      string
+	reloaders []func(cfg *config.Config) error
+
+	// mtx makes sure only one reload happens at a time.
+	mtx sync.Mutex
+
+	success     prometheus.Gauge
+	successTime prometheus.Gauge
+}
+
+// newConfigReloader returns a reloader applying the config with the
+// reloaders. The config the components were created with counts as the
+// first successful reload.
+func newConfigReloader(logger log.Logger, reg prometheus.Registerer, path string, reloaders ...func(cfg *config.Config) error) *configReloader {
+	r := &configReloader{
+		logger:    log.With(logger, "component", "reloader"),
+		path:      path,
+		reloaders: reloaders,
+		success: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "parca_config_last_reload_successful",
+			Help: "Whether the last configuration reload attempt was successful.",
+		}),
+		successTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "parca_config_last_reload_success_timestamp_seconds",
+			Help: "Timestamp of the last successful configuration reload.",
+		}),
+	}
+	if reg != nil {
+		reg.MustRegister(r.success, r.successTime)
+	}
+
+	r.success.Set(1)
+	r.successTime.SetToCurrentTime()
+	return r
+}
+
+// reload reads the config file and applies it with all reloaders, even if
+// one of them fails.
+func (r *configReloader) reload() (err error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	defer func() {
+		if err != nil {
+			r.success.Set(0)
+			return
+		}
+		r.success.Set(1)
+		r.successTime.SetToCurrentTime()
+	}()
+
+	level.Info(r.logger).Log("msg", "loading configuration file", "path", r.path)
+	cfg, err := loadConfig(r.path)
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, reload := range r.reloaders {
+		if err := reload(cfg); err != nil {
+			level.Error(r.logger).Log("msg", "failed to apply configuration", "err", err)
+			failed = true
+		}
+	}
+	if failed {
+		return fmt.Errorf("one or more errors occurred while applying the new configuration (--config-path=%q)", r.path)
+	}
+
+	level.Info(r.logger).Log("msg", "completed loading of configuration file", "path", r.path)
+	return nil
+}
+
+// run reloads the config on every SIGHUP until the context is done.
+func (r *configReloader) run(ctx context.Context) error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-hup:
+			if err := r.reload(); err != nil {
+				level.Error(r.logger).Log("msg", "error reloading config", "err", err)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleReload reloads the config on a request to the reload endpoint.
+func (r *configReloader) handleReload(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	if err := r.reload(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to reload config: %s", err), http.StatusInternalServerError)
+	}
+}
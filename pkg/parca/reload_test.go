@@ -0,0 +1,83 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parca
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parca-dev/parca/pkg/config"
+)
+
+func TestConfigReloader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parca.yaml")
+	writeConfig := func(content string) {
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0o600))
+	}
+
+	var (
+		applied  *config.Config
+		applyErr error
+	)
+	r := newConfigReloader(log.NewNopLogger(), prometheus.NewRegistry(), path, func(cfg *config.Config) error {
+		applied = cfg
+		return applyErr
+	})
+	require.Equal(t, 1.0, testutil.ToFloat64(r.success))
+	require.NotZero(t, testutil.ToFloat64(r.successTime))
+
+	mux := runtime.NewServeMux()
+	require.NoError(t, mux.HandlePath(http.MethodPost, "/-/reload", r.handleReload))
+	reload := func() int {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/-/reload", nil))
+		return w.Code
+	}
+
+	writeConfig(`scrape_configs:
+- job_name: 'test'
+  static_configs:
+  - targets: ['localhost:8080']`)
+	require.Equal(t, http.StatusOK, reload())
+	require.Len(t, applied.ScrapeConfigs, 1)
+	require.Equal(t, "test", applied.ScrapeConfigs[0].JobName)
+	require.Equal(t, 1.0, testutil.ToFloat64(r.success))
+
+	// A config that can't be parsed isn't applied.
+	applied = nil
+	writeConfig(`scrape_configs: [`)
+	require.Equal(t, http.StatusInternalServerError, reload())
+	require.Nil(t, applied)
+	require.Equal(t, 0.0, testutil.ToFloat64(r.success))
+
+	writeConfig(`scrape_configs: []`)
+	applyErr = errors.New("apply failed")
+	require.Error(t, r.reload())
+	require.NotNil(t, applied)
+	require.Equal(t, 0.0, testutil.ToFloat64(r.success))
+
+	applyErr = nil
+	require.NoError(t, r.reload())
+	require.Equal(t, 1.0, testutil.ToFloat64(r.success))
+}
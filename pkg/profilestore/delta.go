@@ -0,0 +1,129 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profilestore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kit/log/level"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// gaugeSampleTypes are the sample types of the cumulative Go profiles that
+// are gauges, they are stored as they are instead of as differences.
+var gaugeSampleTypes = map[string]bool{
+	"inuse_objects": true,
+	"inuse_space":   true,
+}
+
+// staleCumulativeAfter is how long the last cumulative profile of a series is
+// kept without a newer one, e.g. once its target is gone.
+const staleCumulativeAfter = time.Hour
+
+// cumulativeProfile is the last cumulative profile of a series.
+type cumulativeProfile struct {
+	job, profileType string
+	profile          *profile.Profile
+	seen             time.Time
+}
+
+// deltaProfile returns the difference of the profile to the last profile of
+// its series if the series' profile type is cumulative, and the profile as it
+// is otherwise. It returns nil for the first profile of a cumulative series,
+// as without a previous profile the difference is unknown.
+func (s *ProfileStore) deltaProfile(ls labels.Labels, p *profile.Profile) (*profile.Profile, error) {
+	job, profileType := ls.Get("job"), ls.Get(labels.MetricName)
+	now := time.Now()
+
+	s.mtx.Lock()
+	if _, ok := s.serverSideDelta[job][profileType]; !ok {
+		s.mtx.Unlock()
+		return p, nil
+	}
+	h := ls.Hash()
+	prev := s.cumulative[h]
+	s.cumulative[h] = &cumulativeProfile{job: job, profileType: profileType, profile: p, seen: now}
+	if now.Sub(s.lastSweep) > staleCumulativeAfter {
+		for h, c := range s.cumulative {
+			if now.Sub(c.seen) > staleCumulativeAfter {
+				delete(s.cumulative, h)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.mtx.Unlock()
+
+	if prev == nil {
+		return nil, nil
+	}
+
+	d, reset, err := subtractProfile(prev.profile, p)
+	if err != nil {
+		return nil, err
+	}
+	if reset {
+		level.Debug(s.logger).Log("msg", "cumulative profile was reset", "labels", ls.String())
+	}
+	return d, nil
+}
+
+// subtractProfile returns the difference of the cumulative profile to the
+// previous one. Like a Prometheus counter, a profile with values smaller
+// than the previous ones is reset, e.g. by a restart of its process, so the
+// difference is the profile itself. Incompatible profiles are taken as a
+// reset too.
+func subtractProfile(prev, p *profile.Profile) (*profile.Profile, bool, error) {
+	if len(prev.SampleType) != len(p.SampleType) {
+		return p, true, nil
+	}
+
+	// The previous values of the gauges are dropped, so only the current ones remain.
+	ratios := make([]float64, len(prev.SampleType))
+	for i, st := range prev.SampleType {
+		if st.Type != p.SampleType[i].Type || st.Unit != p.SampleType[i].Unit {
+			return p, true, nil
+		}
+		if !gaugeSampleTypes[st.Type] {
+			ratios[i] = -1
+		}
+	}
+
+	base := prev.Copy()
+	if err := base.ScaleN(ratios); err != nil {
+		return nil, false, fmt.Errorf("negate previous profile: %w", err)
+	}
+	d, err := profile.Merge([]*profile.Profile{base, p})
+	if err != nil {
+		// Merging only fails for incompatible period types.
+		return p, true, nil
+	}
+
+	for _, s := range d.Sample {
+		for i, v := range s.Value {
+			if ratios[i] != 0 && v < 0 {
+				return p, true, nil
+			}
+		}
+	}
+
+	// The difference covers the time between the two profiles.
+	d.TimeNanos = p.TimeNanos
+	d.DurationNanos = p.DurationNanos
+	if prev.TimeNanos > 0 && p.TimeNanos > prev.TimeNanos {
+		d.DurationNanos = p.TimeNanos - prev.TimeNanos
+	}
+	return d, false, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profilestore
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	profilestorepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
+	"github.com/parca-dev/parca/pkg/config"
+	"github.com/parca-dev/parca/pkg/storage"
+	"github.com/parca-dev/parca/pkg/storage/metastore"
+)
+
+// allocsProfile returns an allocs profile at the time with the allocated
+// objects and objects in use of the functions a and b.
+func allocsProfile(t time.Time, allocsA, inuseA, allocsB, inuseB int64) *profile.Profile {
+	fa := &profile.Function{ID: 1, Name: "a"}
+	fb := &profile.Function{ID: 2, Name: "b"}
+	la := &profile.Location{ID: 1, Address: 0x1, Line: []profile.Line{{Function: fa}}}
+	lb := &profile.Location{ID: 2, Address: 0x2, Line: []profile.Line{{Function: fb}}}
+
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: "alloc_objects", Unit: "count"},
+			{Type: "inuse_objects", Unit: "count"},
+		},
+		PeriodType: &profile.ValueType{Type: "space", Unit: "bytes"},
+		TimeNanos:  t.UnixNano(),
+		Function:   []*profile.Function{fa, fb},
+		Location:   []*profile.Location{la, lb},
+	}
+	if allocsA != 0 || inuseA != 0 {
+		p.Sample = append(p.Sample, &profile.Sample{Location: []*profile.Location{la}, Value: []int64{allocsA, inuseA}})
+	}
+	if allocsB != 0 || inuseB != 0 {
+		p.Sample = append(p.Sample, &profile.Sample{Location: []*profile.Location{lb, la}, Value: []int64{allocsB, inuseB}})
+	}
+	return p
+}
+
+// sampleValues returns the values of the samples by their leaf function.
+func sampleValues(p *profile.Profile) map[string][]int64 {
+	res := map[string][]int64{}
+	for _, s := range p.Sample {
+		res[s.Location[0].Line[0].Function.Name] = s.Value
+	}
+	return res
+}
+
+func TestSubtractProfile(t *testing.T) {
+	t0 := time.Unix(100, 0)
+
+	d, reset, err := subtractProfile(allocsProfile(t0, 10, 5, 20, 2), allocsProfile(t0.Add(time.Minute), 15, 1, 20, 3))
+	require.NoError(t, err)
+	require.False(t, reset)
+	// Unchanged allocations are gone, the gauges keep their current value.
+	require.Equal(t, map[string][]int64{
+		"a": {5, 1},
+		"b": {0, 3},
+	}, sampleValues(d))
+	require.Equal(t, t0.Add(time.Minute).UnixNano(), d.TimeNanos)
+	require.Equal(t, time.Minute.Nanoseconds(), d.DurationNanos)
+
+	// Fewer allocations than before mean the process restarted.
+	p := allocsProfile(t0.Add(time.Minute), 3, 1, 30, 3)
+	d, reset, err = subtractProfile(allocsProfile(t0, 10, 5, 20, 2), p)
+	require.NoError(t, err)
+	require.True(t, reset)
+	require.Equal(t, p, d)
+
+	other := allocsProfile(t0.Add(time.Minute), 15, 1, 20, 3)
+	other.SampleType[0].Type = "alloc_space"
+	d, reset, err = subtractProfile(allocsProfile(t0, 10, 5, 20, 2), other)
+	require.NoError(t, err)
+	require.True(t, reset)
+	require.Equal(t, other, d)
+}
+
+func TestWriteRawServerSideDelta(t *testing.T) {
+	ctx := context.Background()
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), tracer, nil)
+	require.NoError(t, err)
+	mStr, err := metastore.NewInMemorySQLiteProfileMetaStore(prometheus.NewRegistry(), tracer, "serversidedelta")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mStr.Close()
+	})
+
+	s := NewProfileStore(log.NewNopLogger(), tracer, db, mStr)
+	require.NoError(t, s.ApplyConfig([]*config.ScrapeConfig{{
+		JobName: "api",
+		ProfilingConfig: &config.ProfilingConfig{PprofConfig: config.PprofConfig{
+			"allocs": {ServerSideDelta: true},
+		}},
+	}}))
+
+	write := func(profileType string, p *profile.Profile) {
+		var buf bytes.Buffer
+		require.NoError(t, p.Write(&buf))
+		_, err := s.WriteRaw(ctx, &profilestorepb.WriteRawRequest{
+			Series: []*profilestorepb.RawProfileSeries{{
+				Labels: &profilestorepb.LabelSet{Labels: []*profilestorepb.Label{
+					{Name: "__name__", Value: profileType},
+					{Name: "job", Value: "api"},
+				}},
+				Samples: []*profilestorepb.RawSample{{RawProfile: buf.Bytes()}},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	totals := func(name string) []int64 {
+		q := db.Querier(ctx, math.MinInt64, math.MaxInt64)
+		set := q.Select(nil, labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, name))
+		require.True(t, set.Next())
+
+		var res []int64
+		it := set.At().Iterator()
+		for it.Next() {
+			p, err := storage.GeneratePprof(ctx, mStr, it.At())
+			require.NoError(t, err)
+
+			total := int64(0)
+			for _, s := range p.Sample {
+				total += s.Value[0]
+			}
+			res = append(res, total)
+		}
+		require.NoError(t, it.Err())
+		return res
+	}
+
+	t0 := time.Unix(100, 0)
+	for i, p := range []*profile.Profile{
+		allocsProfile(t0, 10, 5, 20, 2),
+		allocsProfile(t0.Add(time.Minute), 15, 1, 20, 3),
+		// The process restarted.
+		allocsProfile(t0.Add(2*time.Minute), 3, 1, 4, 3),
+		allocsProfile(t0.Add(3*time.Minute), 10, 1, 4, 3),
+	} {
+		write("allocs", p)
+		write("allocs_total", allocsProfile(t0.Add(time.Duration(i)*time.Minute), 10, 5, 20, 2))
+	}
+
+	// The first cumulative profile is only the base of the first difference.
+	require.Equal(t, []int64{5, 7, 7}, totals("allocs_alloc_objects_count"))
+	require.Equal(t, []int64{4, 4, 4}, totals("allocs_inuse_objects_count"))
+	require.Equal(t, []int64{30, 30, 30, 30}, totals("allocs_total_alloc_objects_count"))
+}
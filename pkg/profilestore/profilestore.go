@@ -17,6 +17,8 @@ import (
 	"bytes"
 	"context"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
@@ -28,6 +30,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	profilestorepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
+	"github.com/parca-dev/parca/pkg/config"
 	"github.com/parca-dev/parca/pkg/storage"
 )
 
@@ -36,6 +39,17 @@ type ProfileStore struct {
 	tracer    trace.Tracer
 	app       storage.Appendable
 	metaStore metastore.ProfileMetaStore
+
+	mtx sync.Mutex
+	// promote are the pprof labels promoted to series labels by job.
+	promote map[string]*config.PromotePprofLabelsConfig
+	// promoted are the combinations of promoted values seen by job.
+	promoted map[string]map[string]struct{}
+	// serverSideDelta are the cumulative profile types by job.
+	serverSideDelta map[string]map[string]struct{}
+	// cumulative are the last cumulative profiles by the hash of their series.
+	cumulative map[uint64]*cumulativeProfile
+	lastSweep  time.Time
 }
 
 var _ profilestorepb.ProfileStoreServiceServer = &ProfileStore{}
@@ -51,7 +65,75 @@ func NewProfileStore(
 		tracer:    tracer,
 		app:       app,
 		metaStore: metaStore,
+		promote:   map[string]*config.PromotePprofLabelsConfig{},
+		promoted:  map[string]map[string]struct{}{},
+
+		serverSideDelta: map[string]map[string]struct{}{},
+		cumulative:      map[uint64]*cumulativeProfile{},
+	}
+}
+
+// ApplyConfig updates the pprof labels promoted to series labels and the
+// profile types stored as deltas by scrape job. The combinations of values
+// seen by a job are kept unless its labels change, the last cumulative
+// profiles are kept unless their profile type isn't cumulative anymore.
+func (s *ProfileStore) ApplyConfig(cfgs []*config.ScrapeConfig) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	promote := make(map[string]*config.PromotePprofLabelsConfig, len(cfgs))
+	serverSideDelta := make(map[string]map[string]struct{}, len(cfgs))
+	for _, cfg := range cfgs {
+		if cfg.ProfilingConfig != nil {
+			for pt, pc := range cfg.ProfilingConfig.PprofConfig {
+				if !pc.ServerSideDelta {
+					continue
+				}
+				if serverSideDelta[cfg.JobName] == nil {
+					serverSideDelta[cfg.JobName] = map[string]struct{}{}
+				}
+				serverSideDelta[cfg.JobName][pt] = struct{}{}
+			}
+		}
+
+		if cfg.PromotePprofLabels == nil || len(cfg.PromotePprofLabels.Keys) == 0 {
+			continue
+		}
+		promote[cfg.JobName] = cfg.PromotePprofLabels
+	}
+
+	for h, c := range s.cumulative {
+		if _, ok := serverSideDelta[c.job][c.profileType]; !ok {
+			delete(s.cumulative, h)
+		}
+	}
+	s.serverSideDelta = serverSideDelta
+
+	for job := range s.promoted {
+		if cfg, ok := promote[job]; !ok || !equalKeys(cfg.Keys, s.promote[job].Keys) {
+			delete(s.promoted, job)
+		}
+	}
+	for job := range promote {
+		if _, ok := s.promoted[job]; !ok {
+			s.promoted[job] = map[string]struct{}{}
+		}
+	}
+	s.promote = promote
+
+	return nil
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
 	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func (s *ProfileStore) WriteRaw(ctx context.Context, r *profilestorepb.WriteRawRequest) (*profilestorepb.WriteRawResponse, error) {
@@ -77,48 +159,73 @@ func (s *ProfileStore) WriteRaw(ctx context.Context, r *profilestorepb.WriteRawR
 				return nil, status.Errorf(codes.InvalidArgument, "invalid profile: %v", err)
 			}
 
-			convertCtx, convertSpan := s.tracer.Start(ctx, "profile-from-pprof")
-			profiles, err := storage.ProfilesFromPprof(convertCtx, s.logger, s.metaStore, p)
+			if p.DurationNanos == 0 && sample.Duration != nil {
+				p.DurationNanos = sample.Duration.AsDuration().Nanoseconds()
+			}
+
+			p, err = s.deltaProfile(ls, p)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to normalize pprof: %v", err)
+				return nil, status.Errorf(codes.Internal, "failed to compute delta profile: %v", err)
+			}
+			if p == nil {
+				// The first cumulative profile of a series is only the base of the next one.
+				continue
 			}
 
-			convertSpan.End()
-			appendCtx, appendSpan := s.tracer.Start(ctx, "append-profiles")
-			for _, prof := range profiles {
-				profLabelset := ls.Copy()
-				found := false
-				for i, label := range profLabelset {
-					if label.Name == "__name__" {
-						found = true
-						profLabelset[i] = labels.Label{
-							Name:  "__name__",
-							Value: label.Value + "_" + prof.Meta.SampleType.Type + "_" + prof.Meta.SampleType.Unit,
-						}
-					}
-				}
-				if !found {
-					profLabelset = append(profLabelset, labels.Label{
-						Name:  "__name__",
-						Value: prof.Meta.SampleType.Type + "_" + prof.Meta.SampleType.Unit,
-					})
+			for _, part := range s.splitProfile(ls, p) {
+				if err := s.appendProfile(ctx, part.labels, part.profile); err != nil {
+					return nil, err
 				}
-				sort.Sort(profLabelset)
+			}
+		}
+	}
 
-				level.Debug(s.logger).Log("msg", "writing sample", "label_set", profLabelset.String(), "timestamp", prof.Meta.Timestamp)
+	return &profilestorepb.WriteRawResponse{}, nil
+}
 
-				app, err := s.app.Appender(appendCtx, profLabelset)
-				if err != nil {
-					return nil, err
-				}
+// appendProfile appends the profiles of each sample type of the pprof profile
+// to their series.
+func (s *ProfileStore) appendProfile(ctx context.Context, ls labels.Labels, p *profile.Profile) error {
+	convertCtx, convertSpan := s.tracer.Start(ctx, "profile-from-pprof")
+	profiles, err := storage.ProfilesFromPprof(convertCtx, s.logger, s.metaStore, p)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to normalize pprof: %v", err)
+	}
 
-				if err := app.Append(appendCtx, prof); err != nil {
-					return nil, status.Errorf(codes.Internal, "failed to append sample: %v", err)
+	convertSpan.End()
+	appendCtx, appendSpan := s.tracer.Start(ctx, "append-profiles")
+	defer appendSpan.End()
+	for _, prof := range profiles {
+		profLabelset := ls.Copy()
+		found := false
+		for i, label := range profLabelset {
+			if label.Name == "__name__" {
+				found = true
+				profLabelset[i] = labels.Label{
+					Name:  "__name__",
+					Value: label.Value + "_" + prof.Meta.SampleType.Type + "_" + prof.Meta.SampleType.Unit,
 				}
 			}
-			appendSpan.End()
+		}
+		if !found {
+			profLabelset = append(profLabelset, labels.Label{
+				Name:  "__name__",
+				Value: prof.Meta.SampleType.Type + "_" + prof.Meta.SampleType.Unit,
+			})
+		}
+		sort.Sort(profLabelset)
+
+		level.Debug(s.logger).Log("msg", "writing sample", "label_set", profLabelset.String(), "timestamp", prof.Meta.Timestamp)
+
+		app, err := s.app.Appender(appendCtx, profLabelset)
+		if err != nil {
+			return err
+		}
+
+		if err := app.Append(appendCtx, prof); err != nil {
+			return status.Errorf(codes.Internal, "failed to append sample: %v", err)
 		}
 	}
 
-	return &profilestorepb.WriteRawResponse{}, nil
+	return nil
 }
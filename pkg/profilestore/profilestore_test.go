@@ -0,0 +1,159 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profilestore
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	profilestorepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
+	"github.com/parca-dev/parca/pkg/config"
+	"github.com/parca-dev/parca/pkg/storage"
+	"github.com/parca-dev/parca/pkg/storage/metastore"
+)
+
+func TestWriteRawPromotePprofLabels(t *testing.T) {
+	ctx := context.Background()
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), tracer, nil)
+	require.NoError(t, err)
+	mStr, err := metastore.NewInMemorySQLiteProfileMetaStore(prometheus.NewRegistry(), tracer, "promotepproflabels")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mStr.Close()
+	})
+
+	s := NewProfileStore(log.NewNopLogger(), tracer, db, mStr)
+	require.NoError(t, s.ApplyConfig([]*config.ScrapeConfig{{
+		JobName: "api",
+		PromotePprofLabels: &config.PromotePprofLabelsConfig{
+			Keys:      []string{"endpoint", "instance"},
+			MaxSeries: 2,
+		},
+	}}))
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// The third endpoint is beyond the max series, the instance label is
+	// already a label of the series.
+	endpoints := []string{"/users", "/orders", "/carts"}
+	for i, sample := range p.Sample {
+		sample.Label = map[string][]string{
+			"endpoint": {endpoints[i%len(endpoints)]},
+			"instance": {"other"},
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, p.Write(&buf))
+
+	_, err = s.WriteRaw(ctx, &profilestorepb.WriteRawRequest{
+		Series: []*profilestorepb.RawProfileSeries{{
+			Labels: &profilestorepb.LabelSet{Labels: []*profilestorepb.Label{
+				{Name: "__name__", Value: "allocs"},
+				{Name: "instance", Value: "localhost:8080"},
+				{Name: "job", Value: "api"},
+			}},
+			Samples: []*profilestorepb.RawSample{{RawProfile: buf.Bytes()}},
+		}},
+	})
+	require.NoError(t, err)
+
+	q := db.Querier(ctx, math.MinInt64, math.MaxInt64)
+	set := q.Select(nil, labels.MustNewMatcher(labels.MatchEqual, "job", "api"))
+	var endpointLabels []string
+	for set.Next() {
+		lset := set.At().Labels()
+		require.Equal(t, "localhost:8080", lset.Get("instance"))
+		endpointLabels = append(endpointLabels, lset.Get("endpoint"))
+	}
+	require.NoError(t, set.Err())
+
+	// Each of the two sample types has a series per promoted endpoint and one
+	// for the remaining samples.
+	require.ElementsMatch(t, []string{
+		"", "/orders", "/users",
+		"", "/orders", "/users",
+	}, endpointLabels)
+}
+
+func TestWriteRawDuration(t *testing.T) {
+	ctx := context.Background()
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), tracer, nil)
+	require.NoError(t, err)
+	mStr, err := metastore.NewInMemorySQLiteProfileMetaStore(prometheus.NewRegistry(), tracer, "writerawduration")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mStr.Close()
+	})
+	s := NewProfileStore(log.NewNopLogger(), tracer, db, mStr)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	write := func(job string, profileDuration time.Duration) {
+		p.DurationNanos = profileDuration.Nanoseconds()
+		var buf bytes.Buffer
+		require.NoError(t, p.Write(&buf))
+
+		_, err = s.WriteRaw(ctx, &profilestorepb.WriteRawRequest{
+			Series: []*profilestorepb.RawProfileSeries{{
+				Labels: &profilestorepb.LabelSet{Labels: []*profilestorepb.Label{
+					{Name: "__name__", Value: "allocs"},
+					{Name: "job", Value: job},
+				}},
+				Samples: []*profilestorepb.RawSample{{
+					RawProfile: buf.Bytes(),
+					Duration:   durationpb.New(9 * time.Second),
+				}},
+			}},
+		})
+		require.NoError(t, err)
+	}
+	duration := func(job string) time.Duration {
+		q := db.Querier(ctx, math.MinInt64, math.MaxInt64)
+		set := q.Select(nil, labels.MustNewMatcher(labels.MatchEqual, "job", job))
+		require.True(t, set.Next())
+		it := set.At().Iterator()
+		require.True(t, it.Next())
+		return time.Duration(it.At().ProfileMeta().Duration)
+	}
+
+	// The requested duration is only used if the profile has none.
+	write("requested", 0)
+	require.Equal(t, 9*time.Second, duration("requested"))
+	write("recorded", 10*time.Second)
+	require.Equal(t, 10*time.Second, duration("recorded"))
+}
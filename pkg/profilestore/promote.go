@@ -0,0 +1,130 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profilestore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-kit/log/level"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// profilePart is the part of a pprof profile with the same values of the
+// promoted pprof labels, stored in the series with these labels.
+type profilePart struct {
+	labels  labels.Labels
+	profile *profile.Profile
+}
+
+// splitProfile splits the samples of the profile by the values of the pprof
+// labels promoted for the series' job. Labels the series already has aren't
+// promoted, and once the job reached its max series the samples of new
+// combinations of values stay in the part without promoted labels.
+func (s *ProfileStore) splitProfile(ls labels.Labels, p *profile.Profile) []*profilePart {
+	job := ls.Get("job")
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	cfg, ok := s.promote[job]
+	if !ok {
+		return []*profilePart{{labels: ls, profile: p}}
+	}
+	seen := s.promoted[job]
+
+	keys := make([]string, 0, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		if !ls.Has(key) {
+			keys = append(keys, key)
+		}
+	}
+
+	var (
+		ids     []string
+		parts   = map[string]*profilePart{}
+		dropped int
+	)
+	for _, sample := range p.Sample {
+		promoted := promotedLabels(sample, keys)
+		id := promoted.String()
+		if len(promoted) > 0 {
+			if _, found := seen[id]; !found {
+				if len(seen) >= cfg.MaxSeries {
+					dropped++
+					promoted, id = nil, labels.Labels(nil).String()
+				} else {
+					seen[id] = struct{}{}
+				}
+			}
+		}
+
+		part, found := parts[id]
+		if !found {
+			b := labels.NewBuilder(ls)
+			for _, l := range promoted {
+				b.Set(l.Name, l.Value)
+			}
+			part = &profilePart{labels: b.Labels(), profile: profileWithoutSamples(p)}
+			parts[id] = part
+			ids = append(ids, id)
+		}
+		part.profile.Sample = append(part.profile.Sample, sample)
+	}
+	if dropped > 0 {
+		level.Warn(s.logger).Log("msg", "max series of promoted pprof labels reached, samples are not promoted", "job", job, "samples", dropped)
+	}
+	if len(parts) == 0 {
+		return []*profilePart{{labels: ls, profile: p}}
+	}
+
+	sort.Strings(ids)
+	res := make([]*profilePart, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, parts[id])
+	}
+	return res
+}
+
+// promotedLabels returns the values of the sample's pprof labels with the
+// keys, multiple values of a label are joined by commas.
+func promotedLabels(sample *profile.Sample, keys []string) labels.Labels {
+	var res labels.Labels
+	for _, key := range keys {
+		if values := sample.Label[key]; len(values) > 0 {
+			res = append(res, labels.Label{Name: key, Value: strings.Join(values, ",")})
+		}
+	}
+	sort.Sort(res)
+	return res
+}
+
+// profileWithoutSamples returns a profile sharing everything but the samples.
+func profileWithoutSamples(p *profile.Profile) *profile.Profile {
+	return &profile.Profile{
+		SampleType:        p.SampleType,
+		DefaultSampleType: p.DefaultSampleType,
+		Mapping:           p.Mapping,
+		Location:          p.Location,
+		Function:          p.Function,
+		Comments:          p.Comments,
+		DropFrames:        p.DropFrames,
+		KeepFrames:        p.KeepFrames,
+		TimeNanos:         p.TimeNanos,
+		DurationNanos:     p.DurationNanos,
+		PeriodType:        p.PeriodType,
+		Period:            p.Period,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"net/http"
+
+	"github.com/go-kit/log/level"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// downloadFilter ignores the report type query parameter, as downloads are always pprof reports.
+var downloadFilter = utilities.NewDoubleArray([][]string{{"report_type"}})
+
+// DownloadPprof serves the profile selected by the request's query parameters as a gzipped pprof protobuf file.
+// It accepts the same query parameters as the gateway's /profiles/query endpoint.
+func (q *Query) DownloadPprof(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	req := &pb.QueryRequest{}
+	if err := runtime.PopulateQueryParameters(req, r.Form, downloadFilter); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.ReportType = pb.QueryRequest_REPORT_TYPE_PPROF
+
+	resp, err := q.Query(r.Context(), req)
+	if err != nil {
+		s := status.Convert(err)
+		http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", `attachment; filename="profile.pb.gz"`)
+	if _, err := w.Write(resp.GetPprof()); err != nil {
+		level.Warn(q.logger).Log("msg", "failed to write pprof download", "err", err)
+	}
+}
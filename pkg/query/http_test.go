@@ -0,0 +1,105 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/parca-dev/parca/pkg/storage"
+	"github.com/parca-dev/parca/pkg/storage/metastore"
+)
+
+func TestDownloadPprof(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"downloadpprof",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	download := func(values url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/profiles/download?"+values.Encode(), nil)
+		w := httptest.NewRecorder()
+		q.DownloadPprof(w, r, nil)
+		return w
+	}
+
+	w := download(url.Values{
+		"mode":         {"MODE_SINGLE_UNSPECIFIED"},
+		"single.query": {"allocs"},
+		"single.time":  {time.Unix(1, 0).UTC().Format(time.RFC3339)},
+		// The report type is always pprof, no matter what is requested.
+		"report_type": {"REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED"},
+	})
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `attachment; filename="profile.pb.gz"`, w.Header().Get("Content-Disposition"))
+
+	res, err := profile.Parse(w.Body)
+	require.NoError(t, err)
+	require.Equal(t, sumSampleValues(p), sumSampleValues(res))
+
+	w = download(url.Values{
+		"mode":         {"MODE_SINGLE_UNSPECIFIED"},
+		"single.query": {"allocs{"},
+		"single.time":  {time.Unix(1, 0).UTC().Format(time.RFC3339)},
+	})
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = download(url.Values{
+		"mode":         {"MODE_SINGLE_UNSPECIFIED"},
+		"single.query": {"allocs"},
+		"single.time":  {time.Unix(100, 0).UTC().Format(time.RFC3339)},
+	})
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -14,10 +14,12 @@
 package query
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math"
+	"regexp"
 	"sort"
 	"time"
 
@@ -42,6 +44,12 @@ var (
 	maxTime = time.Unix(math.MaxInt64/1000-62135596801, 999999999).UTC()
 )
 
+// DebugInfo provides the debug information uploaded for the profiled binaries.
+type DebugInfo interface {
+	storage.SourceFiles
+	storage.ObjectFiles
+}
+
 // Query is the read api interface for parca
 // It implements the proto/query/query.proto APIServer interface
 type Query struct {
@@ -49,6 +57,7 @@ type Query struct {
 	tracer    trace.Tracer
 	queryable storage.Queryable
 	metaStore metastore.ProfileMetaStore
+	debugInfo DebugInfo
 }
 
 func New(
@@ -56,10 +65,12 @@ func New(
 	tracer trace.Tracer,
 	queryable storage.Queryable,
 	metaStore metastore.ProfileMetaStore,
+	debugInfo DebugInfo,
 ) *Query {
 	return &Query{
 		queryable: queryable,
 		metaStore: metaStore,
+		debugInfo: debugInfo,
 		logger:    logger,
 		tracer:    tracer,
 	}
@@ -76,9 +87,24 @@ func (q *Query) QueryRange(ctx context.Context, req *pb.QueryRangeRequest) (*pb.
 		return nil, status.Error(codes.InvalidArgument, "failed to parse query")
 	}
 
+	sampleLabels, err := parseSampleLabels(req.SampleLabels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse sample labels: %v", err)
+	}
+
 	start := req.Start.AsTime()
 	end := req.End.AsTime()
 
+	// The values of the matching functions need the whole profiles, not just their roots.
+	var matcher *storage.FunctionMatcher
+	if req.Function != "" {
+		re, err := regexp.Compile(req.Function)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid function: %v", err)
+		}
+		matcher = storage.NewFunctionMatcher(q.metaStore, re)
+	}
+
 	// Timestamps don't have to match exactly and staleness kicks in within 5
 	// minutes of no samples, so we need to search the range of -5min to +5min
 	// for possible samples.
@@ -88,39 +114,48 @@ func (q *Query) QueryRange(ctx context.Context, req *pb.QueryRangeRequest) (*pb.
 		timestamp.FromTime(end),
 	)
 	set := query.Select(&storage.SelectHints{
-		Start: timestamp.FromTime(start),
-		End:   timestamp.FromTime(end),
-		Root:  true,
+		Start:        timestamp.FromTime(start),
+		End:          timestamp.FromTime(end),
+		Root:         matcher == nil,
+		SampleLabels: sampleLabels,
 	}, sel...)
-	res := &pb.QueryRangeResponse{}
+	// The series are only limited once aggregated.
+	aggregate := req.GetAggregation().GetFunction() != pb.Aggregation_FUNCTION_UNSPECIFIED
+	var series []*rangeSeries
 
 	ctx, span := q.tracer.Start(ctx, "seriesIterate")
 	for set.Next() {
-		series := set.At()
-
-		labels := series.Labels()
-		metricsSeries := &pb.MetricsSeries{Labelset: &profilestorepb.LabelSet{Labels: make([]*profilestorepb.Label, 0, len(labels))}}
-		for _, l := range labels {
-			metricsSeries.Labelset.Labels = append(metricsSeries.Labelset.Labels, &profilestorepb.Label{
-				Name:  l.Name,
-				Value: l.Value,
-			})
-		}
+		s := set.At()
+		rs := &rangeSeries{labels: s.Labels()}
 
 		i := 0
 		_, profileSpan := q.tracer.Start(ctx, "profileIterate")
-		it := series.Iterator()
+		it := s.Iterator()
 		for it.Next() {
 			p := it.At()
+			i++
+
+			if matcher != nil {
+				flat, cum, err := matcher.Values(ctx, p)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get function values: %v", err)
+				}
+				rs.samples = append(rs.samples, &pb.MetricsSample{
+					Timestamp: timestamppb.New(timestamp.Time(p.ProfileMeta().Timestamp)),
+					Value:     cum,
+					Flat:      flat,
+				})
+				continue
+			}
+
 			pit := p.ProfileTree().Iterator()
 			if pit.NextChild() {
 				s := &pb.MetricsSample{
 					Timestamp: timestamppb.New(timestamp.Time(p.ProfileMeta().Timestamp)),
 					Value:     pit.At().CumulativeValue(),
 				}
-				metricsSeries.Samples = append(metricsSeries.Samples, s)
+				rs.samples = append(rs.samples, s)
 			}
-			i++
 		}
 		profileSpan.SetAttributes(attribute.Int("i", i))
 		profileSpan.End()
@@ -128,9 +163,12 @@ func (q *Query) QueryRange(ctx context.Context, req *pb.QueryRangeRequest) (*pb.
 			return nil, status.Error(codes.Internal, "failed to iterate")
 		}
 
-		res.Series = append(res.Series, metricsSeries)
+		if req.Step != nil {
+			rs.samples = resampleSeries(rs.samples, start, end, req.Step.AsDuration())
+		}
+		series = append(series, rs)
 
-		if req.Limit != 0 && len(res.Series) == int(req.Limit) {
+		if !aggregate && req.Limit != 0 && len(series) == int(req.Limit) {
 			break
 		}
 	}
@@ -139,6 +177,18 @@ func (q *Query) QueryRange(ctx context.Context, req *pb.QueryRangeRequest) (*pb.
 		return nil, status.Error(codes.Internal, "failed to iterate")
 	}
 
+	if aggregate {
+		series = aggregateSeries(series, req.Aggregation)
+		if req.Limit != 0 && len(series) > int(req.Limit) {
+			series = series[:req.Limit]
+		}
+	}
+
+	res := &pb.QueryRangeResponse{Series: make([]*pb.MetricsSeries, 0, len(series))}
+	for _, s := range series {
+		res.Series = append(res.Series, s.metricsSeries())
+	}
+
 	return res, nil
 }
 
@@ -148,80 +198,193 @@ func (q *Query) Query(ctx context.Context, req *pb.QueryRequest) (*pb.QueryRespo
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if req.Mode == pb.QueryRequest_MODE_MERGE && len(req.GetMerge().GetGroupBy()) > 0 {
+		return q.queryGroups(ctx, req)
+	}
+
+	var (
+		p   storage.InstantProfile
+		err error
+	)
 	switch req.Mode {
 	case pb.QueryRequest_MODE_SINGLE_UNSPECIFIED:
-		return q.singleRequest(ctx, req.GetSingle())
+		p, err = q.selectSingle(ctx, req.GetSingle())
 	case pb.QueryRequest_MODE_MERGE:
-		return q.mergeRequest(ctx, req.GetMerge())
+		p, err = q.selectMerge(ctx, req.GetMerge())
 	case pb.QueryRequest_MODE_DIFF:
-		return q.diffRequest(ctx, req.GetDiff())
+		p, err = q.selectDiff(ctx, req.GetDiff())
 	default:
 		return nil, status.Error(codes.InvalidArgument, "unknown query mode")
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	p, err = q.filterProfile(ctx, p, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	return q.renderReport(ctx, p, req)
 }
 
-func (q *Query) selectSingle(ctx context.Context, s *pb.SingleProfile) (storage.InstantProfile, error) {
-	sel, err := parser.ParseMetricSelector(s.Query)
+// queryGroups merges the profiles of each group of a merge grouped by labels
+// and renders a report per group.
+func (q *Query) queryGroups(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
+	ctx, span := q.tracer.Start(ctx, "queryGroups")
+	defer span.End()
+
+	m := req.GetMerge()
+	sel, err := parser.ParseMetricSelector(m.Query)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse query")
 	}
 
-	t := s.Time.AsTime()
-	p, err := q.findSingle(ctx, sel, t)
+	sampleLabels, err := parseSampleLabels(m.SampleLabels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse sample labels: %v", err)
+	}
+
+	startTs := timestamp.FromTime(m.Start.AsTime())
+	endTs := timestamp.FromTime(m.End.AsTime())
+	query := q.queryable.Querier(
+		ctx,
+		startTs,
+		endTs,
+	)
+	set := query.Select(&storage.SelectHints{
+		Start:        startTs,
+		End:          endTs,
+		Merge:        true,
+		SampleLabels: sampleLabels,
+	}, sel...)
+
+	groups, err := storage.MergeSeriesSetProfilesByLabels(q.tracer, ctx, set, m.GroupBy)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to search profile")
 	}
 
-	if p == nil {
-		return nil, status.Error(codes.NotFound, "could not find profile at requested time and selectors")
+	res := &pb.ReportGroups{Groups: make([]*pb.ReportGroup, 0, len(groups))}
+	for _, g := range groups {
+		p, err := q.filterProfile(ctx, g.Profile, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+
+		report, err := q.renderReport(ctx, p, req)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Groups = append(res.Groups, &pb.ReportGroup{
+			Labelset: labelsToProto(g.Labels),
+			Report:   report,
+		})
 	}
 
-	return p, nil
+	return &pb.QueryResponse{
+		Report: &pb.QueryResponse_Groups{
+			Groups: res,
+		},
+	}, nil
 }
 
-func (q *Query) singleRequest(ctx context.Context, s *pb.SingleProfile) (*pb.QueryResponse, error) {
-	p, err := q.selectSingle(ctx, s)
+func (q *Query) filterProfile(ctx context.Context, p storage.InstantProfile, f *pb.ProfileFilter) (storage.InstantProfile, error) {
+	if f == nil {
+		return p, nil
+	}
+
+	var (
+		filter = &storage.ProfileFilter{}
+		err    error
+	)
+	if filter.Focus, err = compileFilterRegexp(f.Focus); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid focus: %v", err)
+	}
+	if filter.Ignore, err = compileFilterRegexp(f.Ignore); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ignore: %v", err)
+	}
+	if filter.Hide, err = compileFilterRegexp(f.Hide); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hide: %v", err)
+	}
+	if filter.Show, err = compileFilterRegexp(f.Show); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid show: %v", err)
+	}
+
+	p, err = storage.FilterProfile(ctx, q.tracer, q.metaStore, p, filter)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "failed to filter profile: %v", err.Error())
 	}
+	return p, nil
+}
 
-	return q.renderReport(ctx, p, pb.QueryRequest_REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED)
+// compileFilterRegexp compiles the regular expression of a filter, an empty one doesn't filter.
+func compileFilterRegexp(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(expr)
 }
 
-func (q *Query) selectMerge(ctx context.Context, m *pb.MergeProfile) (storage.InstantProfile, error) {
-	ctx, span := q.tracer.Start(ctx, "selectMerge")
-	defer span.End()
+// parseSampleLabels parses the selector on the pprof labels of samples, an empty one matches all samples.
+func parseSampleLabels(sel string) ([]*labels.Matcher, error) {
+	if sel == "" {
+		return nil, nil
+	}
+	return parser.ParseMetricSelector(sel)
+}
 
-	sel, err := parser.ParseMetricSelector(m.Query)
+func (q *Query) selectSingle(ctx context.Context, s *pb.SingleProfile) (storage.InstantProfile, error) {
+	sel, err := parser.ParseMetricSelector(s.Query)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "failed to parse query")
 	}
 
-	start := m.Start.AsTime()
-	end := m.End.AsTime()
+	sampleLabels, err := parseSampleLabels(s.SampleLabels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse sample labels: %v", err)
+	}
 
-	p, err := q.merge(ctx, sel, start, end)
+	t := s.Time.AsTime()
+	p, err := q.findSingle(ctx, sel, sampleLabels, t)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to search profile")
 	}
 
+	if p == nil {
+		return nil, status.Error(codes.NotFound, "could not find profile at requested time and selectors")
+	}
+
 	return p, nil
 }
 
-func (q *Query) mergeRequest(ctx context.Context, m *pb.MergeProfile) (*pb.QueryResponse, error) {
-	ctx, span := q.tracer.Start(ctx, "mergeRequest")
+func (q *Query) selectMerge(ctx context.Context, m *pb.MergeProfile) (storage.InstantProfile, error) {
+	ctx, span := q.tracer.Start(ctx, "selectMerge")
 	defer span.End()
 
-	p, err := q.selectMerge(ctx, m)
+	sel, err := parser.ParseMetricSelector(m.Query)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "failed to parse query")
 	}
 
-	return q.renderReport(ctx, p, pb.QueryRequest_REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED)
+	sampleLabels, err := parseSampleLabels(m.SampleLabels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse sample labels: %v", err)
+	}
+
+	start := m.Start.AsTime()
+	end := m.End.AsTime()
+
+	p, err := q.merge(ctx, sel, sampleLabels, start, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to search profile")
+	}
+
+	return p, nil
 }
 
-func (q *Query) diffRequest(ctx context.Context, d *pb.DiffProfile) (*pb.QueryResponse, error) {
-	ctx, span := q.tracer.Start(ctx, "diffRequest")
+func (q *Query) selectDiff(ctx context.Context, d *pb.DiffProfile) (storage.InstantProfile, error) {
+	ctx, span := q.tracer.Start(ctx, "selectDiff")
 	defer span.End()
 
 	if d == nil {
@@ -247,7 +410,7 @@ func (q *Query) diffRequest(ctx context.Context, d *pb.DiffProfile) (*pb.QueryRe
 	}
 	diffSpan.End()
 
-	return q.renderReport(ctx, p, pb.QueryRequest_REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED)
+	return p, nil
 }
 
 func (q *Query) selectProfileForDiff(ctx context.Context, s *pb.ProfileDiffSelection) (storage.InstantProfile, error) {
@@ -267,25 +430,118 @@ func (q *Query) selectProfileForDiff(ctx context.Context, s *pb.ProfileDiffSelec
 	return p, err
 }
 
-func (q *Query) renderReport(ctx context.Context, p storage.InstantProfile, typ pb.QueryRequest_ReportType) (*pb.QueryResponse, error) {
-	switch typ {
+func (q *Query) renderReport(ctx context.Context, p storage.InstantProfile, req *pb.QueryRequest) (*pb.QueryResponse, error) {
+	switch req.ReportType {
 	case pb.QueryRequest_REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED:
-		fg, err := storage.GenerateFlamegraph(ctx, q.tracer, q.metaStore, p)
+		opts := req.GetFlamegraphOptions()
+		generate := storage.GenerateFlamegraph
+		if opts.GetInverted() {
+			generate = storage.GenerateInvertedFlamegraph
+		}
+
+		fg, err := generate(ctx, q.tracer, q.metaStore, p)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to generate flamegraph: %v", err.Error())
 		}
+		fg = storage.AggregateFlamegraph(fg, opts.GetAggregateBy())
+		fg = storage.PruneFlamegraph(fg, int(opts.GetMaxNodes()), opts.GetMinFraction())
 
 		return &pb.QueryResponse{
 			Report: &pb.QueryResponse_Flamegraph{
 				Flamegraph: fg,
 			},
 		}, nil
+	case pb.QueryRequest_REPORT_TYPE_PPROF:
+		pp, err := storage.GeneratePprof(ctx, q.metaStore, p)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate pprof: %v", err.Error())
+		}
+
+		var buf bytes.Buffer
+		if err := pp.Write(&buf); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write pprof: %v", err.Error())
+		}
+
+		return &pb.QueryResponse{
+			Report: &pb.QueryResponse_Pprof{
+				Pprof: buf.Bytes(),
+			},
+		}, nil
+	case pb.QueryRequest_REPORT_TYPE_TOP:
+		top, err := storage.GenerateTopTable(ctx, q.tracer, q.metaStore, p)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate top table: %v", err.Error())
+		}
+
+		opts := req.GetTopOptions()
+		storage.SortTopTable(top, opts.GetSortBy())
+		if limit := int(opts.GetLimit()); limit > 0 && len(top.List) > limit {
+			top.List = top.List[:limit]
+		}
+
+		return &pb.QueryResponse{
+			Report: &pb.QueryResponse_Top{
+				Top: top,
+			},
+		}, nil
+	case pb.QueryRequest_REPORT_TYPE_CALLGRAPH:
+		nodeFraction := req.GetCallgraphOptions().GetNodeFraction()
+		if nodeFraction == 0 {
+			nodeFraction = storage.DefaultCallgraphNodeFraction
+		}
+		edgeFraction := req.GetCallgraphOptions().GetEdgeFraction()
+		if edgeFraction == 0 {
+			edgeFraction = storage.DefaultCallgraphEdgeFraction
+		}
+
+		cg, err := storage.GenerateCallgraph(ctx, q.tracer, q.metaStore, p, nodeFraction, edgeFraction)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate callgraph: %v", err.Error())
+		}
+
+		return &pb.QueryResponse{
+			Report: &pb.QueryResponse_Callgraph{
+				Callgraph: cg,
+			},
+		}, nil
+	case pb.QueryRequest_REPORT_TYPE_SOURCE:
+		src, err := storage.GenerateSource(ctx, q.tracer, q.metaStore, q.debugInfo, p, req.GetSourceOptions().GetFunction())
+		if errors.Is(err, storage.ErrFunctionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate source: %v", err.Error())
+		}
+
+		return &pb.QueryResponse{
+			Report: &pb.QueryResponse_Source{
+				Source: src,
+			},
+		}, nil
+	case pb.QueryRequest_REPORT_TYPE_DISASSEMBLY:
+		if q.debugInfo == nil {
+			return nil, status.Error(codes.Unavailable, "no debug info available to disassemble")
+		}
+
+		d, err := storage.GenerateDisassembly(ctx, q.tracer, q.metaStore, q.debugInfo, p, req.GetDisassemblyOptions().GetFunction())
+		if errors.Is(err, storage.ErrFunctionNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate disassembly: %v", err.Error())
+		}
+
+		return &pb.QueryResponse{
+			Report: &pb.QueryResponse_Disassembly{
+				Disassembly: d,
+			},
+		}, nil
 	default:
 		return nil, status.Error(codes.InvalidArgument, "requested report type does not exist")
 	}
 }
 
-func (q *Query) findSingle(ctx context.Context, sel []*labels.Matcher, t time.Time) (storage.InstantProfile, error) {
+func (q *Query) findSingle(ctx context.Context, sel, sampleLabels []*labels.Matcher, t time.Time) (storage.InstantProfile, error) {
 	requestedTime := timestamp.FromTime(t)
 
 	ctx, span := q.tracer.Start(ctx, "findSingle")
@@ -298,12 +554,19 @@ func (q *Query) findSingle(ctx context.Context, sel []*labels.Matcher, t time.Ti
 	// Timestamps don't have to match exactly and staleness kicks in within 5
 	// minutes of no samples, so we need to search the range of -5min to +5min
 	// for possible samples.
-	query := q.queryable.Querier(
-		ctx,
-		timestamp.FromTime(t.Add(-5*time.Minute)),
-		timestamp.FromTime(t.Add(5*time.Minute)),
-	)
-	set := query.Select(nil, sel...)
+	mint := timestamp.FromTime(t.Add(-5 * time.Minute))
+	maxt := timestamp.FromTime(t.Add(5 * time.Minute))
+	query := q.queryable.Querier(ctx, mint, maxt)
+
+	var hints *storage.SelectHints
+	if len(sampleLabels) > 0 {
+		hints = &storage.SelectHints{
+			Start:        mint,
+			End:          maxt,
+			SampleLabels: sampleLabels,
+		}
+	}
+	set := query.Select(hints, sel...)
 	ctx, seriesSpan := q.tracer.Start(ctx, "seriesIterate")
 	defer seriesSpan.End()
 	for set.Next() {
@@ -330,7 +593,7 @@ func (q *Query) findSingle(ctx context.Context, sel []*labels.Matcher, t time.Ti
 	return nil, nil
 }
 
-func (q *Query) merge(ctx context.Context, sel []*labels.Matcher, start, end time.Time) (storage.InstantProfile, error) {
+func (q *Query) merge(ctx context.Context, sel, sampleLabels []*labels.Matcher, start, end time.Time) (storage.InstantProfile, error) {
 	ctx, span := q.tracer.Start(ctx, "merge")
 	span.SetAttributes(attribute.Int64("start", start.Unix()))
 	span.SetAttributes(attribute.Int64("end", end.Unix()))
@@ -345,9 +608,10 @@ func (q *Query) merge(ctx context.Context, sel []*labels.Matcher, start, end tim
 	)
 
 	set := query.Select(&storage.SelectHints{
-		Start: startTs,
-		End:   endTs,
-		Merge: true,
+		Start:        startTs,
+		End:          endTs,
+		Merge:        true,
+		SampleLabels: sampleLabels,
 	}, sel...)
 
 	return storage.MergeSeriesSetProfiles(q.tracer, ctx, set)
@@ -355,7 +619,158 @@ func (q *Query) merge(ctx context.Context, sel []*labels.Matcher, start, end tim
 
 // Series issues a series request against the storage
 func (q *Query) Series(ctx context.Context, req *pb.SeriesRequest) (*pb.SeriesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "unimplemented")
+	matcherSets, err := parseMatchers(req.Match)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(matcherSets) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no match[] parameter provided")
+	}
+
+	var (
+		start = minTime
+		end   = maxTime
+	)
+
+	if req.Start != nil {
+		start = req.Start.AsTime()
+	}
+	if req.End != nil {
+		end = req.End.AsTime()
+	}
+
+	query := q.queryable.Querier(
+		ctx,
+		timestamp.FromTime(start),
+		timestamp.FromTime(end),
+	)
+
+	var (
+		warnings storage.Warnings
+		seen     = map[uint64][]labels.Labels{}
+		series   []*pb.SeriesMetadata
+	)
+	for _, matchers := range matcherSets {
+		set := query.Select(&storage.SelectHints{
+			Start:    timestamp.FromTime(start),
+			End:      timestamp.FromTime(end),
+			Metadata: true,
+		}, matchers...)
+
+		for set.Next() {
+			s := set.At()
+			lset := s.Labels()
+
+			// Multiple matcher sets may select the same series.
+			h := lset.Hash()
+			if containsLabels(seen[h], lset) {
+				continue
+			}
+			seen[h] = append(seen[h], lset)
+
+			m, err := seriesMetadata(s)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if m == nil {
+				continue
+			}
+			series = append(series, m)
+		}
+		if err := set.Err(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		warnings = append(warnings, set.Warnings()...)
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		return labels.Compare(labelsFromProto(series[i].Labelset), labelsFromProto(series[j].Labelset)) < 0
+	})
+
+	return &pb.SeriesResponse{
+		Series:   series,
+		Warnings: warnings.ToStrings(),
+	}, nil
+}
+
+// seriesMetadata reads the metadata of all samples of the series.
+// It returns nil if the series has no samples in the queried time frame.
+func seriesMetadata(s storage.Series) (*pb.SeriesMetadata, error) {
+	var (
+		minT, maxT int64
+		numSamples uint64
+		meta       storage.InstantProfileMeta
+	)
+
+	it := s.Iterator()
+	for it.Next() {
+		meta = it.At().ProfileMeta()
+		if numSamples == 0 || meta.Timestamp < minT {
+			minT = meta.Timestamp
+		}
+		if numSamples == 0 || meta.Timestamp > maxT {
+			maxT = meta.Timestamp
+		}
+		numSamples++
+	}
+	if err := it.Err(); err != nil {
+		return nil, fmt.Errorf("iterate series: %w", err)
+	}
+	if numSamples == 0 {
+		return nil, nil
+	}
+
+	lset := s.Labels()
+	m := &pb.SeriesMetadata{
+		Labelset:   &profilestorepb.LabelSet{Labels: make([]*profilestorepb.Label, 0, len(lset))},
+		MinTime:    timestamppb.New(timestamp.Time(minT)),
+		MaxTime:    timestamppb.New(timestamp.Time(maxT)),
+		NumSamples: numSamples,
+		SampleType: &pb.ValueType{
+			Type: meta.SampleType.Type,
+			Unit: meta.SampleType.Unit,
+		},
+		PeriodType: &pb.ValueType{
+			Type: meta.PeriodType.Type,
+			Unit: meta.PeriodType.Unit,
+		},
+	}
+	for _, l := range lset {
+		m.Labelset.Labels = append(m.Labelset.Labels, &profilestorepb.Label{
+			Name:  l.Name,
+			Value: l.Value,
+		})
+	}
+
+	return m, nil
+}
+
+func containsLabels(lsets []labels.Labels, lset labels.Labels) bool {
+	for _, l := range lsets {
+		if labels.Equal(l, lset) {
+			return true
+		}
+	}
+	return false
+}
+
+func labelsFromProto(ls *profilestorepb.LabelSet) labels.Labels {
+	lset := make(labels.Labels, 0, len(ls.Labels))
+	for _, l := range ls.Labels {
+		lset = append(lset, labels.Label{Name: l.Name, Value: l.Value})
+	}
+	return lset
+}
+
+func labelsToProto(lset labels.Labels) *profilestorepb.LabelSet {
+	ls := &profilestorepb.LabelSet{Labels: make([]*profilestorepb.Label, 0, len(lset))}
+	for _, l := range lset {
+		ls.Labels = append(ls.Labels, &profilestorepb.Label{
+			Name:  l.Name,
+			Value: l.Value,
+		})
+	}
+	return ls
 }
 
 // Labels issues a labels request against the storage
@@ -19,6 +19,7 @@ import (
 	"math"
 	"math/rand"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	profilestore "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
@@ -40,12 +42,14 @@ import (
 
 func Test_QueryRange_EmptyStore(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	q := New(
 		log.NewNopLogger(),
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		nil,
+		nil,
 	)
 
 	// Query last 5 minutes
@@ -64,7 +68,8 @@ func Test_QueryRange_EmptyStore(t *testing.T) {
 
 func Test_QueryRange_Valid(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
 		prometheus.NewRegistry(),
 		trace.NewNoopTracerProvider().Tracer(""),
@@ -79,6 +84,7 @@ func Test_QueryRange_Valid(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		s,
+		nil,
 	)
 
 	app, err := db.Appender(ctx, labels.Labels{
@@ -129,7 +135,8 @@ func Test_QueryRange_Valid(t *testing.T) {
 
 func Test_QueryRange_Limited(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
 		prometheus.NewRegistry(),
 		trace.NewNoopTracerProvider().Tracer(""),
@@ -144,6 +151,7 @@ func Test_QueryRange_Limited(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		s,
+		nil,
 	)
 
 	f, err := os.Open("testdata/alloc_objects.pb.gz")
@@ -193,6 +201,80 @@ func Test_QueryRange_Limited(t *testing.T) {
 	}
 }
 
+func Test_QueryRange_Aggregation(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"queryrangeaggregation",
+	)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	require.NoError(t, err)
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	f, err := os.Open("testdata/alloc_objects.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+
+	// Two pods are scraped at slightly different times.
+	for pod, offset := range map[string]time.Duration{"a": 0, "b": 100 * time.Millisecond} {
+		app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs", "pod", pod))
+		require.NoError(t, err)
+
+		for _, sec := range []int64{1, 11} {
+			p.TimeNanos = time.Unix(sec, 0).Add(offset).UnixNano()
+			prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+			require.NoError(t, err)
+			require.NoError(t, app.Append(ctx, prof))
+		}
+	}
+
+	resp, err := q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query: "allocs",
+		Start: timestamppb.New(time.Unix(0, 0)),
+		End:   timestamppb.New(time.Unix(20, 0)),
+		Step:  durationpb.New(10 * time.Second),
+		Aggregation: &pb.Aggregation{
+			Function: pb.Aggregation_FUNCTION_SUM,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Series, 1)
+	require.Empty(t, resp.Series[0].Labelset.Labels)
+	require.Len(t, resp.Series[0].Samples, 2)
+	for i, sample := range resp.Series[0].Samples {
+		require.Equal(t, time.Unix(int64(10*(i+1)), 0).UTC(), sample.Timestamp.AsTime())
+		require.Equal(t, int64(2*310797348), sample.Value)
+	}
+
+	// The limit applies to the aggregated series.
+	resp, err = q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query: "allocs",
+		Start: timestamppb.New(time.Unix(0, 0)),
+		End:   timestamppb.New(time.Unix(20, 0)),
+		Limit: 1,
+		Step:  durationpb.New(10 * time.Second),
+		Aggregation: &pb.Aggregation{
+			Function: pb.Aggregation_FUNCTION_MAX,
+			By:       []string{"pod"},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Series, 1)
+	require.Equal(t, []*profilestore.Label{{Name: "pod", Value: "a"}}, resp.Series[0].Labelset.Labels)
+}
+
 func Test_QueryRange_InputValidation(t *testing.T) {
 	ctx := context.Background()
 	end := time.Now()
@@ -229,6 +311,39 @@ func Test_QueryRange_InputValidation(t *testing.T) {
 				End:   timestamppb.New(start),
 			},
 		},
+		"Negative step": {
+			req: &pb.QueryRangeRequest{
+				Query: "allocs",
+				Start: timestamppb.New(start),
+				End:   timestamppb.New(end),
+				Step:  durationpb.New(-time.Second),
+			},
+		},
+		"Too many steps": {
+			req: &pb.QueryRangeRequest{
+				Query: "allocs",
+				Start: timestamppb.New(start),
+				End:   timestamppb.New(end),
+				Step:  durationpb.New(time.Millisecond),
+			},
+		},
+		"Aggregation without step": {
+			req: &pb.QueryRangeRequest{
+				Query:       "allocs",
+				Start:       timestamppb.New(start),
+				End:         timestamppb.New(end),
+				Aggregation: &pb.Aggregation{Function: pb.Aggregation_FUNCTION_SUM},
+			},
+		},
+		"Topk without k": {
+			req: &pb.QueryRangeRequest{
+				Query:       "allocs",
+				Start:       timestamppb.New(start),
+				End:         timestamppb.New(end),
+				Step:        durationpb.New(time.Second),
+				Aggregation: &pb.Aggregation{Function: pb.Aggregation_FUNCTION_TOPK},
+			},
+		},
 	}
 
 	q := New(
@@ -236,6 +351,7 @@ func Test_QueryRange_InputValidation(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		nil,
 		nil,
+		nil,
 	)
 
 	t.Parallel()
@@ -293,6 +409,7 @@ func Test_Query_InputValidation(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		nil,
 		nil,
+		nil,
 	)
 
 	t.Parallel()
@@ -308,7 +425,8 @@ func Test_Query_InputValidation(t *testing.T) {
 
 func Test_Query_Simple(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
 		prometheus.NewRegistry(),
 		trace.NewNoopTracerProvider().Tracer(""),
@@ -323,6 +441,7 @@ func Test_Query_Simple(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		s,
+		nil,
 	)
 
 	app, err := db.Appender(ctx, labels.Labels{
@@ -367,7 +486,8 @@ func Test_Query_Simple(t *testing.T) {
 
 func Test_Query_Diff(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
 		prometheus.NewRegistry(),
 		trace.NewNoopTracerProvider().Tracer(""),
@@ -382,6 +502,7 @@ func Test_Query_Diff(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		s,
+		nil,
 	)
 
 	app, err := db.Appender(ctx, labels.Labels{
@@ -482,12 +603,14 @@ func Benchmark_Query_Merge(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				b.StopTimer()
 				ctx := context.Background()
-				db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+				db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+				require.NoError(b, err)
 				q := New(
 					log.NewNopLogger(),
 					trace.NewNoopTracerProvider().Tracer(""),
 					db,
 					s,
+					nil,
 				)
 
 				app, err := db.Appender(ctx, labels.Labels{
@@ -545,12 +668,14 @@ func Test_Query_Merge(t *testing.T) {
 	require.NoError(t, err)
 
 	for k := 0.; k <= 10; k++ {
-		db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+		db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+		require.NoError(t, err)
 		q := New(
 			log.NewNopLogger(),
 			trace.NewNoopTracerProvider().Tracer(""),
 			db,
 			s,
+			nil,
 		)
 
 		app, err := db.Appender(ctx, labels.Labels{
@@ -587,7 +712,8 @@ func Test_Query_Merge(t *testing.T) {
 
 func Test_QueryRange_MultipleLabels_NoMatch(t *testing.T) {
 	ctx := context.Background()
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
 		prometheus.NewRegistry(),
 		trace.NewNoopTracerProvider().Tracer(""),
@@ -602,6 +728,7 @@ func Test_QueryRange_MultipleLabels_NoMatch(t *testing.T) {
 		trace.NewNoopTracerProvider().Tracer(""),
 		db,
 		s,
+		nil,
 	)
 
 	ls1 := labels.Labels{
@@ -663,3 +790,917 @@ func Test_QueryRange_MultipleLabels_NoMatch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 0, len(resp.GetSeries()))
 }
+
+func Test_Series(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"series",
+	)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	require.NoError(t, err)
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	f, err := os.Open("testdata/alloc_objects.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+
+	appendProfiles := func(lset labels.Labels, timestamps ...int64) {
+		app, err := db.Appender(ctx, lset)
+		require.NoError(t, err)
+		for _, ts := range timestamps {
+			p.TimeNanos = ts * int64(time.Millisecond)
+			prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+			require.NoError(t, err)
+			require.NoError(t, app.Append(ctx, prof))
+		}
+	}
+	appendProfiles(labels.FromStrings("__name__", "allocs", "job", "a"), 1000, 2000, 3000)
+	appendProfiles(labels.FromStrings("__name__", "allocs", "job", "b"), 2000)
+	appendProfiles(labels.FromStrings("__name__", "inuse", "job", "a"), 2000)
+
+	_, err = q.Series(ctx, &pb.SeriesRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The second matcher selects a series that was already selected by the first.
+	resp, err := q.Series(ctx, &pb.SeriesRequest{
+		Match: []string{`allocs`, `allocs{job="a"}`},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Series, 2)
+
+	require.Equal(t, &profilestore.LabelSet{
+		Labels: []*profilestore.Label{
+			{Name: "__name__", Value: "allocs"},
+			{Name: "job", Value: "a"},
+		},
+	}, resp.Series[0].Labelset)
+	require.Equal(t, int64(1000), resp.Series[0].MinTime.AsTime().UnixMilli())
+	require.Equal(t, int64(3000), resp.Series[0].MaxTime.AsTime().UnixMilli())
+	require.Equal(t, uint64(3), resp.Series[0].NumSamples)
+	require.Equal(t, &pb.ValueType{Type: "alloc_objects", Unit: "count"}, resp.Series[0].SampleType)
+	require.Equal(t, &pb.ValueType{Type: "space", Unit: "bytes"}, resp.Series[0].PeriodType)
+
+	require.Equal(t, "b", resp.Series[1].Labelset.Labels[1].Value)
+	require.Equal(t, uint64(1), resp.Series[1].NumSamples)
+
+	// Only the samples within the time frame are taken into account.
+	resp, err = q.Series(ctx, &pb.SeriesRequest{
+		Match: []string{`allocs{job="a"}`},
+		Start: timestamppb.New(time.UnixMilli(1500)),
+		End:   timestamppb.New(time.UnixMilli(2500)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Series, 1)
+	require.Equal(t, int64(2000), resp.Series[0].MinTime.AsTime().UnixMilli())
+	require.Equal(t, int64(2000), resp.Series[0].MaxTime.AsTime().UnixMilli())
+	require.Equal(t, uint64(1), resp.Series[0].NumSamples)
+}
+
+func Test_Query_Pprof(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querypprof",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.Labels{
+		labels.Label{
+			Name:  "__name__",
+			Value: "allocs",
+		},
+	})
+	require.NoError(t, err)
+
+	var totals []int64
+	for i, name := range []string{"profile1.pb.gz", "profile2.pb.gz"} {
+		f, err := os.Open("../storage/testdata/" + name)
+		require.NoError(t, err)
+		p, err := profile.Parse(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		p.TimeNanos = int64(i+1) * int64(time.Second)
+		totals = append(totals, sumSampleValues(p))
+
+		prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+		require.NoError(t, err)
+		require.NoError(t, app.Append(ctx, prof))
+	}
+
+	single := func(sec int64) *pb.SingleProfile {
+		return &pb.SingleProfile{
+			Query: "allocs",
+			Time:  timestamppb.New(time.Unix(sec, 0)),
+		}
+	}
+
+	testCases := map[string]struct {
+		req   *pb.QueryRequest
+		total int64
+	}{
+		"single": {
+			req: &pb.QueryRequest{
+				Mode:    pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+				Options: &pb.QueryRequest_Single{Single: single(1)},
+			},
+			total: totals[0],
+		},
+		"merge": {
+			req: &pb.QueryRequest{
+				Mode: pb.QueryRequest_MODE_MERGE,
+				Options: &pb.QueryRequest_Merge{
+					Merge: &pb.MergeProfile{
+						Query: "allocs",
+						Start: timestamppb.New(time.Unix(0, 0)),
+						End:   timestamppb.New(time.Unix(3, 0)),
+					},
+				},
+			},
+			total: totals[0] + totals[1],
+		},
+		"diff": {
+			req: &pb.QueryRequest{
+				Mode: pb.QueryRequest_MODE_DIFF,
+				Options: &pb.QueryRequest_Diff{
+					Diff: &pb.DiffProfile{
+						A: &pb.ProfileDiffSelection{
+							Options: &pb.ProfileDiffSelection_Single{Single: single(1)},
+						},
+						B: &pb.ProfileDiffSelection{
+							Options: &pb.ProfileDiffSelection_Single{Single: single(2)},
+						},
+					},
+				},
+			},
+			total: totals[1] - totals[0],
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.req.ReportType = pb.QueryRequest_REPORT_TYPE_PPROF
+			resp, err := q.Query(ctx, tc.req)
+			require.NoError(t, err)
+
+			p, err := profile.ParseData(resp.GetPprof())
+			require.NoError(t, err)
+			require.NoError(t, p.CheckValid())
+			require.Equal(t, tc.total, sumSampleValues(p))
+		})
+	}
+}
+
+func sumSampleValues(p *profile.Profile) int64 {
+	var total int64
+	for _, s := range p.Sample {
+		total += s.Value[0]
+	}
+	return total
+}
+
+func Test_Query_Top(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querytop",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	resp, err := q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+		Options: &pb.QueryRequest_Single{
+			Single: &pb.SingleProfile{
+				Query: "allocs",
+				Time:  timestamppb.New(time.Unix(1, 0)),
+			},
+		},
+		ReportType: pb.QueryRequest_REPORT_TYPE_TOP,
+		TopOptions: &pb.TopOptions{
+			SortBy: pb.TopOptions_SORT_BY_CUMULATIVE,
+			Limit:  5,
+		},
+	})
+	require.NoError(t, err)
+
+	top := resp.GetTop()
+	require.NotNil(t, top)
+	require.Len(t, top.List, 5)
+	require.Greater(t, int(top.Reported), 5)
+	require.Equal(t, sumSampleValues(p), top.Total)
+	require.LessOrEqual(t, top.List[0].Cumulative, top.Total)
+	for i := 1; i < len(top.List); i++ {
+		require.GreaterOrEqual(t, top.List[i-1].Cumulative, top.List[i].Cumulative)
+	}
+
+	_, err = q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+		Options: &pb.QueryRequest_Single{
+			Single: &pb.SingleProfile{
+				Query: "allocs",
+				Time:  timestamppb.New(time.Unix(1, 0)),
+			},
+		},
+		ReportType: pb.QueryRequest_REPORT_TYPE_TOP,
+		TopOptions: &pb.TopOptions{SortBy: 42},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_Callgraph(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querycallgraph",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	req := func(opts *pb.CallgraphOptions) *pb.QueryRequest {
+		return &pb.QueryRequest{
+			Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+			Options: &pb.QueryRequest_Single{
+				Single: &pb.SingleProfile{
+					Query: "allocs",
+					Time:  timestamppb.New(time.Unix(1, 0)),
+				},
+			},
+			ReportType:       pb.QueryRequest_REPORT_TYPE_CALLGRAPH,
+			CallgraphOptions: opts,
+		}
+	}
+
+	resp, err := q.Query(ctx, req(nil))
+	require.NoError(t, err)
+	cg := resp.GetCallgraph()
+	require.NotNil(t, cg)
+	require.NotEmpty(t, cg.Nodes)
+	require.NotEmpty(t, cg.Edges)
+	require.Equal(t, sumSampleValues(p), cg.Total)
+	require.Contains(t, cg.Dot, "digraph")
+
+	// A higher node fraction drops more nodes.
+	resp, err = q.Query(ctx, req(&pb.CallgraphOptions{NodeFraction: 0.2}))
+	require.NoError(t, err)
+	require.Less(t, len(resp.GetCallgraph().Nodes), len(cg.Nodes))
+
+	_, err = q.Query(ctx, req(&pb.CallgraphOptions{NodeFraction: 2}))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_Source(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querysource",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	req := func(opts *pb.SourceOptions) *pb.QueryRequest {
+		return &pb.QueryRequest{
+			Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+			Options: &pb.QueryRequest_Single{
+				Single: &pb.SingleProfile{
+					Query: "allocs",
+					Time:  timestamppb.New(time.Unix(1, 0)),
+				},
+			},
+			ReportType:    pb.QueryRequest_REPORT_TYPE_SOURCE,
+			SourceOptions: opts,
+		}
+	}
+
+	fn := p.Sample[0].Location[0].Line[0].Function
+	resp, err := q.Query(ctx, req(&pb.SourceOptions{Function: fn.Name}))
+	require.NoError(t, err)
+	src := resp.GetSource()
+	require.NotNil(t, src)
+	require.Equal(t, sumSampleValues(p), src.Total)
+	require.Len(t, src.Files, 1)
+	require.Equal(t, fn.Filename, src.Files[0].Filename)
+	// No source was uploaded, so only the sampled line numbers are returned.
+	require.False(t, src.Files[0].HasSource)
+	require.NotEmpty(t, src.Files[0].Lines)
+	for _, line := range src.Files[0].Lines {
+		require.Empty(t, line.Text)
+	}
+
+	_, err = q.Query(ctx, req(&pb.SourceOptions{Function: "does-not-exist"}))
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = q.Query(ctx, req(nil))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_Disassembly(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querydisassembly",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	req := func(opts *pb.DisassemblyOptions) *pb.QueryRequest {
+		return &pb.QueryRequest{
+			Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+			Options: &pb.QueryRequest_Single{
+				Single: &pb.SingleProfile{
+					Query: "allocs",
+					Time:  timestamppb.New(time.Unix(1, 0)),
+				},
+			},
+			ReportType:         pb.QueryRequest_REPORT_TYPE_DISASSEMBLY,
+			DisassemblyOptions: opts,
+		}
+	}
+
+	// Without debug info there is nothing to disassemble.
+	_, err = q.Query(ctx, req(&pb.DisassemblyOptions{Function: "main.main"}))
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = q.Query(ctx, req(nil))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_Filter(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"queryfilter",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	req := func(filter *pb.ProfileFilter) *pb.QueryRequest {
+		return &pb.QueryRequest{
+			Mode: pb.QueryRequest_MODE_MERGE,
+			Options: &pb.QueryRequest_Merge{
+				Merge: &pb.MergeProfile{
+					Query: "allocs",
+					Start: timestamppb.New(time.Unix(0, 0)),
+					End:   timestamppb.New(time.Unix(2, 0)),
+				},
+			},
+			Filter: filter,
+		}
+	}
+
+	// The expected total is the sum of the samples passing through the focused function.
+	fn := p.Sample[0].Location[0].Line[0].Function.Name
+	expected := int64(0)
+	for _, sample := range p.Sample {
+		if sampleHasFunction(sample, fn) {
+			expected += sample.Value[0]
+		}
+	}
+
+	resp, err := q.Query(ctx, req(&pb.ProfileFilter{Focus: "^" + regexp.QuoteMeta(fn) + "$"}))
+	require.NoError(t, err)
+	require.Equal(t, expected, resp.GetFlamegraph().Total)
+
+	resp, err = q.Query(ctx, req(&pb.ProfileFilter{Ignore: "^" + regexp.QuoteMeta(fn) + "$"}))
+	require.NoError(t, err)
+	require.Equal(t, sumSampleValues(p)-expected, resp.GetFlamegraph().Total)
+
+	_, err = q.Query(ctx, req(&pb.ProfileFilter{Hide: "("}))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_FlamegraphPruning(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"queryflamegraphpruning",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	req := func(opts *pb.FlamegraphOptions) *pb.QueryRequest {
+		return &pb.QueryRequest{
+			Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+			Options: &pb.QueryRequest_Single{
+				Single: &pb.SingleProfile{
+					Query: "allocs",
+					Time:  timestamppb.New(time.Unix(1, 0)),
+				},
+			},
+			FlamegraphOptions: opts,
+		}
+	}
+
+	resp, err := q.Query(ctx, req(&pb.FlamegraphOptions{MaxNodes: 10, MinFraction: 0.01}))
+	require.NoError(t, err)
+
+	fg := resp.GetFlamegraph()
+	require.Equal(t, sumSampleValues(p), fg.Total)
+	require.Equal(t, sumSampleValues(p), fg.Root.Cumulative)
+
+	nodes := 0
+	var walk func(children []*pb.FlamegraphNode)
+	walk = func(children []*pb.FlamegraphNode) {
+		for _, child := range children {
+			if !child.Other {
+				nodes++
+			}
+			walk(child.Children)
+		}
+	}
+	walk(fg.Root.Children)
+	require.LessOrEqual(t, nodes, 10)
+
+	_, err = q.Query(ctx, req(&pb.FlamegraphOptions{MinFraction: 2}))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_InvertedFlamegraph(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"queryinvertedflamegraph",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	p.TimeNanos = int64(time.Second)
+	prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+	require.NoError(t, err)
+	require.NoError(t, app.Append(ctx, prof))
+
+	resp, err := q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_MERGE,
+		Options: &pb.QueryRequest_Merge{
+			Merge: &pb.MergeProfile{
+				Query: "allocs",
+				Start: timestamppb.New(time.Unix(0, 0)),
+				End:   timestamppb.New(time.Unix(2, 0)),
+			},
+		},
+		FlamegraphOptions: &pb.FlamegraphOptions{Inverted: true},
+	})
+	require.NoError(t, err)
+
+	fg := resp.GetFlamegraph()
+	require.Equal(t, sumSampleValues(p), fg.Total)
+
+	// The roots are the leaf functions of the samples.
+	leaves := map[string]int64{}
+	for _, sample := range p.Sample {
+		lines := sample.Location[0].Line
+		leaves[lines[0].Function.Name] += sample.Value[0]
+	}
+	roots := map[string]int64{}
+	for _, child := range fg.Root.Children {
+		roots[child.Meta.Function.Name] += child.Cumulative
+	}
+	require.Equal(t, leaves, roots)
+}
+
+func Test_QueryRange_Function(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"queryrangefunction",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	for _, sec := range []int64{1, 2} {
+		p.TimeNanos = time.Unix(sec, 0).UnixNano()
+		prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+		require.NoError(t, err)
+		require.NoError(t, app.Append(ctx, prof))
+	}
+
+	fn := p.Sample[0].Location[0].Line[0].Function.Name
+	var flat, cum int64
+	for _, sample := range p.Sample {
+		if sampleHasFunction(sample, fn) {
+			cum += sample.Value[0]
+		}
+		if sample.Location[0].Line[0].Function.Name == fn {
+			flat += sample.Value[0]
+		}
+	}
+
+	resp, err := q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query:    "allocs",
+		Start:    timestamppb.New(time.Unix(0, 0)),
+		End:      timestamppb.New(time.Unix(3, 0)),
+		Function: "^" + regexp.QuoteMeta(fn) + "$",
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Series, 1)
+	require.Len(t, resp.Series[0].Samples, 2)
+	for _, sample := range resp.Series[0].Samples {
+		require.Equal(t, cum, sample.Value)
+		require.Equal(t, flat, sample.Flat)
+	}
+
+	_, err = q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query:    "allocs",
+		Start:    timestamppb.New(time.Unix(0, 0)),
+		End:      timestamppb.New(time.Unix(3, 0)),
+		Function: "(",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test_Query_MergeGroups(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querymergegroups",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	p.TimeNanos = int64(time.Second)
+
+	for _, lset := range []labels.Labels{
+		labels.FromStrings("__name__", "allocs", "pod", "a", "version", "canary"),
+		labels.FromStrings("__name__", "allocs", "pod", "b", "version", "stable"),
+		labels.FromStrings("__name__", "allocs", "pod", "c", "version", "stable"),
+	} {
+		app, err := db.Appender(ctx, lset)
+		require.NoError(t, err)
+		prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+		require.NoError(t, err)
+		require.NoError(t, app.Append(ctx, prof))
+	}
+
+	resp, err := q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_MERGE,
+		Options: &pb.QueryRequest_Merge{
+			Merge: &pb.MergeProfile{
+				Query:   "allocs",
+				Start:   timestamppb.New(time.Unix(0, 0)),
+				End:     timestamppb.New(time.Unix(2, 0)),
+				GroupBy: []string{"version"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	groups := resp.GetGroups().GetGroups()
+	require.Len(t, groups, 2)
+
+	require.Equal(t, []*profilestore.Label{{Name: "version", Value: "canary"}}, groups[0].Labelset.Labels)
+	require.Equal(t, sumSampleValues(p), groups[0].Report.GetFlamegraph().Total)
+
+	require.Equal(t, []*profilestore.Label{{Name: "version", Value: "stable"}}, groups[1].Labelset.Labels)
+	require.Equal(t, 2*sumSampleValues(p), groups[1].Report.GetFlamegraph().Total)
+}
+
+func sampleHasFunction(s *profile.Sample, name string) bool {
+	for _, l := range s.Location {
+		for _, line := range l.Line {
+			if line.Function.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func Test_Query_SampleLabels(t *testing.T) {
+	ctx := context.Background()
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
+	s, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"querysamplelabels",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+	q := New(
+		log.NewNopLogger(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		db,
+		s,
+		nil,
+	)
+
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "allocs"))
+	require.NoError(t, err)
+
+	f, err := os.Open("../storage/testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// Every other sample is handled by /users.
+	var users int64
+	for i, sample := range p.Sample {
+		handler := "/orders"
+		if i%2 == 0 {
+			handler = "/users"
+			users += sample.Value[0]
+		}
+		sample.Label = map[string][]string{"handler": {handler}}
+	}
+	require.NotZero(t, users)
+	require.NotEqual(t, sumSampleValues(p), users)
+
+	for _, sec := range []int64{1, 2} {
+		p.TimeNanos = time.Unix(sec, 0).UnixNano()
+		prof, err := storage.ProfileFromPprof(ctx, log.NewNopLogger(), s, p, 0)
+		require.NoError(t, err)
+		require.NoError(t, app.Append(ctx, prof))
+	}
+
+	resp, err := q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_SINGLE_UNSPECIFIED,
+		Options: &pb.QueryRequest_Single{
+			Single: &pb.SingleProfile{
+				Query:        "allocs",
+				Time:         timestamppb.New(time.Unix(1, 0)),
+				SampleLabels: `{handler="/users"}`,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, users, resp.GetFlamegraph().Total)
+
+	resp, err = q.Query(ctx, &pb.QueryRequest{
+		Mode: pb.QueryRequest_MODE_MERGE,
+		Options: &pb.QueryRequest_Merge{
+			Merge: &pb.MergeProfile{
+				Query:        "allocs",
+				Start:        timestamppb.New(time.Unix(0, 0)),
+				End:          timestamppb.New(time.Unix(3, 0)),
+				SampleLabels: `{handler="/users"}`,
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2*users, resp.GetFlamegraph().Total)
+
+	rangeResp, err := q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query:        "allocs",
+		Start:        timestamppb.New(time.Unix(0, 0)),
+		End:          timestamppb.New(time.Unix(3, 0)),
+		SampleLabels: `{handler!="/users"}`,
+	})
+	require.NoError(t, err)
+	require.Len(t, rangeResp.Series, 1)
+	require.Len(t, rangeResp.Series[0].Samples, 2)
+	for _, sample := range rangeResp.Series[0].Samples {
+		require.Equal(t, sumSampleValues(p)-users, sample.Value)
+	}
+
+	_, err = q.QueryRange(ctx, &pb.QueryRangeRequest{
+		Query:        "allocs",
+		Start:        timestamppb.New(time.Unix(0, 0)),
+		End:          timestamppb.New(time.Unix(3, 0)),
+		SampleLabels: `{handler=`,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
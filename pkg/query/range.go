@@ -0,0 +1,197 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// rangeSeries is a series of a range query with its labels.
+type rangeSeries struct {
+	labels  labels.Labels
+	samples []*pb.MetricsSample
+}
+
+func (s *rangeSeries) metricsSeries() *pb.MetricsSeries {
+	return &pb.MetricsSeries{
+		Labelset: labelsToProto(s.labels),
+		Samples:  s.samples,
+	}
+}
+
+// resampleSeries resamples the samples, sorted by timestamp, onto the steps
+// from start to end. The value at a step is the value of the latest sample
+// within the step before it, steps without any sample are left out.
+func resampleSeries(samples []*pb.MetricsSample, start, end time.Time, step time.Duration) []*pb.MetricsSample {
+	res := make([]*pb.MetricsSample, 0, len(samples))
+
+	i := 0
+	for t := start; !t.After(end); t = t.Add(step) {
+		var latest *pb.MetricsSample
+		for ; i < len(samples) && !samples[i].Timestamp.AsTime().After(t); i++ {
+			latest = samples[i]
+		}
+		if latest == nil || !latest.Timestamp.AsTime().After(t.Add(-step)) {
+			continue
+		}
+
+		res = append(res, &pb.MetricsSample{
+			Timestamp: timestamppb.New(t),
+			Value:     latest.Value,
+			Flat:      latest.Flat,
+		})
+	}
+
+	return res
+}
+
+// stepValues are the values of the series of a group at a step.
+type stepValues struct {
+	sum, max         int64
+	flatSum, flatMax int64
+	count            int
+}
+
+// aggregateSeries aggregates the resampled series by the labels of the
+// aggregation at each step. Like in PromQL, the series aggregated into a
+// group only keep the labels grouped by, while topk keeps their labels.
+func aggregateSeries(series []*rangeSeries, agg *pb.Aggregation) []*rangeSeries {
+	var (
+		keys   []string
+		groups = map[string][]*rangeSeries{}
+	)
+	for _, s := range series {
+		key := s.labels.WithLabels(agg.By...).String()
+		if _, found := groups[key]; !found {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], s)
+	}
+	sort.Strings(keys)
+
+	res := make([]*rangeSeries, 0, len(keys))
+	for _, key := range keys {
+		group := groups[key]
+		if agg.Function == pb.Aggregation_FUNCTION_TOPK {
+			res = append(res, topkSeries(group, int(agg.K))...)
+			continue
+		}
+
+		var (
+			steps  []int64
+			values = map[int64]*stepValues{}
+		)
+		for _, s := range group {
+			for _, sample := range s.samples {
+				t := sample.Timestamp.AsTime().UnixNano()
+				v, found := values[t]
+				if !found {
+					v = &stepValues{max: sample.Value, flatMax: sample.Flat}
+					values[t] = v
+					steps = append(steps, t)
+				}
+				v.sum += sample.Value
+				v.flatSum += sample.Flat
+				v.count++
+				if sample.Value > v.max {
+					v.max = sample.Value
+				}
+				if sample.Flat > v.flatMax {
+					v.flatMax = sample.Flat
+				}
+			}
+		}
+		sort.Slice(steps, func(i, j int) bool { return steps[i] < steps[j] })
+
+		aggregated := &rangeSeries{
+			labels:  group[0].labels.WithLabels(agg.By...),
+			samples: make([]*pb.MetricsSample, 0, len(steps)),
+		}
+		for _, t := range steps {
+			v := values[t]
+
+			var value, flat int64
+			switch agg.Function {
+			case pb.Aggregation_FUNCTION_SUM:
+				value, flat = v.sum, v.flatSum
+			case pb.Aggregation_FUNCTION_AVG:
+				value = int64(math.Round(float64(v.sum) / float64(v.count)))
+				flat = int64(math.Round(float64(v.flatSum) / float64(v.count)))
+			case pb.Aggregation_FUNCTION_MAX:
+				value, flat = v.max, v.flatMax
+			}
+
+			aggregated.samples = append(aggregated.samples, &pb.MetricsSample{
+				Timestamp: timestamppb.New(time.Unix(0, t)),
+				Value:     value,
+				Flat:      flat,
+			})
+		}
+		res = append(res, aggregated)
+	}
+
+	return res
+}
+
+// topkSeries keeps the samples of the series that are among the k largest at their step.
+// Series without any sample left are dropped.
+func topkSeries(series []*rangeSeries, k int) []*rangeSeries {
+	type stepSample struct {
+		series int
+		sample *pb.MetricsSample
+	}
+
+	var (
+		steps   []int64
+		samples = map[int64][]stepSample{}
+	)
+	for i, s := range series {
+		for _, sample := range s.samples {
+			t := sample.Timestamp.AsTime().UnixNano()
+			if _, found := samples[t]; !found {
+				steps = append(steps, t)
+			}
+			samples[t] = append(samples[t], stepSample{series: i, sample: sample})
+		}
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i] < steps[j] })
+
+	kept := make([][]*pb.MetricsSample, len(series))
+	for _, t := range steps {
+		s := samples[t]
+		sort.SliceStable(s, func(i, j int) bool { return s[i].sample.Value > s[j].sample.Value })
+		if len(s) > k {
+			s = s[:k]
+		}
+		for _, ss := range s {
+			kept[ss.series] = append(kept[ss.series], ss.sample)
+		}
+	}
+
+	res := make([]*rangeSeries, 0, k)
+	for i, s := range series {
+		if len(kept[i]) == 0 {
+			continue
+		}
+		res = append(res, &rangeSeries{labels: s.labels, samples: kept[i]})
+	}
+	return res
+}
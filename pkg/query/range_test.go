@@ -0,0 +1,139 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// samplesAt returns samples at the given seconds with the given values.
+func samplesAt(secondsAndValues ...int64) []*pb.MetricsSample {
+	samples := make([]*pb.MetricsSample, 0, len(secondsAndValues)/2)
+	for i := 0; i < len(secondsAndValues); i += 2 {
+		samples = append(samples, &pb.MetricsSample{
+			Timestamp: timestamppb.New(time.Unix(secondsAndValues[i], 0)),
+			Value:     secondsAndValues[i+1],
+		})
+	}
+	return samples
+}
+
+func TestResampleSeries(t *testing.T) {
+	samples := samplesAt(
+		1, 10,
+		9, 20,
+		11, 30,
+		12, 40,
+		41, 50,
+	)
+
+	// Step 20 has the latest sample of (10s, 20s], step 30 has none.
+	require.Equal(t, samplesAt(
+		10, 20,
+		20, 40,
+		50, 50,
+	), resampleSeries(samples, time.Unix(0, 0), time.Unix(55, 0), 10*time.Second))
+
+	require.Empty(t, resampleSeries(samples, time.Unix(60, 0), time.Unix(100, 0), 10*time.Second))
+}
+
+func TestAggregateSeries(t *testing.T) {
+	series := []*rangeSeries{{
+		labels:  labels.FromStrings("__name__", "allocs", "namespace", "a", "pod", "1"),
+		samples: samplesAt(10, 1, 20, 6),
+	}, {
+		labels:  labels.FromStrings("__name__", "allocs", "namespace", "a", "pod", "2"),
+		samples: samplesAt(10, 4, 20, 2, 30, 3),
+	}, {
+		labels:  labels.FromStrings("__name__", "allocs", "namespace", "b", "pod", "3"),
+		samples: samplesAt(10, 5),
+	}}
+
+	for _, tc := range []struct {
+		name     string
+		agg      *pb.Aggregation
+		expected []*rangeSeries
+	}{{
+		name: "sum",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_SUM},
+		expected: []*rangeSeries{{
+			labels:  labels.Labels{},
+			samples: samplesAt(10, 10, 20, 8, 30, 3),
+		}},
+	}, {
+		name: "sum by namespace",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_SUM, By: []string{"namespace"}},
+		expected: []*rangeSeries{{
+			labels:  labels.FromStrings("namespace", "a"),
+			samples: samplesAt(10, 5, 20, 8, 30, 3),
+		}, {
+			labels:  labels.FromStrings("namespace", "b"),
+			samples: samplesAt(10, 5),
+		}},
+	}, {
+		name: "avg by namespace",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_AVG, By: []string{"namespace"}},
+		expected: []*rangeSeries{{
+			labels:  labels.FromStrings("namespace", "a"),
+			samples: samplesAt(10, 3, 20, 4, 30, 3),
+		}, {
+			labels:  labels.FromStrings("namespace", "b"),
+			samples: samplesAt(10, 5),
+		}},
+	}, {
+		name: "max",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_MAX},
+		expected: []*rangeSeries{{
+			labels:  labels.Labels{},
+			samples: samplesAt(10, 5, 20, 6, 30, 3),
+		}},
+	}, {
+		name: "topk",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_TOPK, K: 1},
+		expected: []*rangeSeries{{
+			labels:  series[0].labels,
+			samples: samplesAt(20, 6),
+		}, {
+			labels:  series[1].labels,
+			samples: samplesAt(30, 3),
+		}, {
+			labels:  series[2].labels,
+			samples: samplesAt(10, 5),
+		}},
+	}, {
+		name: "topk by namespace",
+		agg:  &pb.Aggregation{Function: pb.Aggregation_FUNCTION_TOPK, K: 1, By: []string{"namespace"}},
+		expected: []*rangeSeries{{
+			labels:  series[0].labels,
+			samples: samplesAt(20, 6),
+		}, {
+			labels:  series[1].labels,
+			samples: samplesAt(10, 4, 30, 3),
+		}, {
+			labels:  series[2].labels,
+			samples: samplesAt(10, 5),
+		}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, aggregateSeries(series, tc.agg))
+		})
+	}
+}
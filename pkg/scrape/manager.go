@@ -28,13 +28,14 @@ import (
 )
 
 // NewManager is the Manager constructor
-func NewManager(logger log.Logger, reg prometheus.Registerer, store profilepb.ProfileStoreServiceServer, scrapeConfigs []*config.ScrapeConfig) *Manager {
+func NewManager(logger log.Logger, reg prometheus.Registerer, store profilepb.ProfileStoreServiceServer, traces TraceAppender, scrapeConfigs []*config.ScrapeConfig) *Manager {
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
 
 	m := &Manager{
 		store:         store,
+		traces:        traces,
 		logger:        logger,
 		scrapeConfigs: make(map[string]*config.ScrapeConfig),
 		scrapePools:   make(map[string]*scrapePool),
@@ -121,6 +122,7 @@ func NewManager(logger log.Logger, reg prometheus.Registerer, store profilepb.Pr
 type Manager struct {
 	logger    log.Logger
 	store     profilepb.ProfileStoreServiceServer
+	traces    TraceAppender
 	graceShut chan struct{}
 
 	mtxScrape     sync.Mutex // Guards the fields below.
@@ -189,9 +191,9 @@ func (m *Manager) reload() {
 			scrapeConfig, ok := m.scrapeConfigs[setName]
 			if !ok {
 				level.Error(m.logger).Log("msg", "error reloading target set", "err", "invalid config id:"+setName)
-				return
+				continue
 			}
-			sp = newScrapePool(scrapeConfig, m.store, log.With(m.logger, "scrape_pool", setName), &scrapePoolMetrics{
+			sp = newScrapePool(scrapeConfig, m.store, m.traces, log.With(m.logger, "scrape_pool", setName), &scrapePoolMetrics{
 				targetIntervalLength:          m.targetIntervalLength,
 				targetReloadIntervalLength:    m.targetReloadIntervalLength,
 				targetSyncIntervalLength:      m.targetSyncIntervalLength,
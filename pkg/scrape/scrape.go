@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -36,11 +37,18 @@ import (
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/pool"
 	"golang.org/x/net/context/ctxhttp"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
+// TraceAppender stores the execution traces scraped from targets.
+type TraceAppender interface {
+	AppendTrace(ctx context.Context, lset labels.Labels, t time.Time, trace []byte) error
+}
+
 // scrapePool manages scrapes for sets of targets.
 type scrapePool struct {
 	store   profilepb.ProfileStoreServiceServer
+	traces  TraceAppender
 	logger  log.Logger
 	metrics *scrapePoolMetrics
 
@@ -69,7 +77,7 @@ type scrapePoolMetrics struct {
 	targetScrapeSampleOutOfBounds prometheus.Counter
 }
 
-func newScrapePool(cfg *config.ScrapeConfig, store profilepb.ProfileStoreServiceServer, logger log.Logger, metrics *scrapePoolMetrics) *scrapePool {
+func newScrapePool(cfg *config.ScrapeConfig, store profilepb.ProfileStoreServiceServer, traces TraceAppender, logger log.Logger, metrics *scrapePoolMetrics) *scrapePool {
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
@@ -86,6 +94,7 @@ func newScrapePool(cfg *config.ScrapeConfig, store profilepb.ProfileStoreService
 	sp := &scrapePool{
 		cancel:        cancel,
 		store:         store,
+		traces:        traces,
 		config:        cfg,
 		client:        client,
 		activeTargets: map[uint64]*Target{},
@@ -102,6 +111,7 @@ func newScrapePool(cfg *config.ScrapeConfig, store profilepb.ProfileStoreService
 			sp.metrics.targetIntervalLength,
 			buffers,
 			store,
+			traces,
 		)
 	}
 
@@ -147,8 +157,8 @@ func (sp *scrapePool) stop() {
 	wg.Wait()
 }
 
-// reload the scrape pool with the given scrape configuration. The target state is preserved
-// but all scrape loops are restarted with the new scrape configuration.
+// reload the scrape pool with the given scrape configuration. The targets are preserved
+// with the params of the new configuration, and all scrape loops are restarted with it.
 // This method returns after all scrape loops that were stopped have stopped scraping.
 func (sp *scrapePool) reload(cfg *config.ScrapeConfig) {
 	start := time.Now()
@@ -165,16 +175,20 @@ func (sp *scrapePool) reload(cfg *config.ScrapeConfig) {
 	sp.client = client
 
 	var (
-		wg       sync.WaitGroup
-		interval = time.Duration(sp.config.ScrapeInterval)
-		timeout  = time.Duration(sp.config.ScrapeTimeout)
+		wg            sync.WaitGroup
+		loops         = make(map[uint64]loop, len(sp.loops))
+		activeTargets = make(map[uint64]*Target, len(sp.activeTargets))
 	)
 
 	for fp, oldLoop := range sp.loops {
+		// The params of a target, like the duration of delta profiles, depend
+		// on the config of its profile type, which changes its hash too.
 		var (
-			t       = sp.activeTargets[fp]
-			s       = &targetScraper{Target: t, client: sp.client, timeout: timeout}
-			newLoop = sp.newLoop(t, s)
+			old               = sp.activeTargets[fp]
+			t                 = NewTarget(old.labels, old.DiscoveredLabels(), targetParams(cfg, old.labels.Get(ProfileName)))
+			interval, timeout = sp.intervalAndTimeout(t)
+			s                 = &targetScraper{Target: t, client: sp.client, timeout: timeout, logger: sp.logger}
+			newLoop           = sp.newLoop(t, s)
 		)
 		wg.Add(1)
 
@@ -185,15 +199,23 @@ func (sp *scrapePool) reload(cfg *config.ScrapeConfig) {
 			go newLoop.run(interval, timeout, nil)
 		}(oldLoop, newLoop)
 
-		sp.loops[fp] = newLoop
+		loops[t.hash()] = newLoop
+		activeTargets[t.hash()] = t
 	}
+	sp.loops = loops
+	sp.activeTargets = activeTargets
 
 	wg.Wait()
-	sp.metrics.targetReloadIntervalLength.WithLabelValues(interval.String()).Observe(
+	sp.metrics.targetReloadIntervalLength.WithLabelValues(time.Duration(sp.config.ScrapeInterval).String()).Observe(
 		time.Since(start).Seconds(),
 	)
 }
 
+// intervalAndTimeout returns the scrape interval and timeout of the target's profile type.
+func (sp *scrapePool) intervalAndTimeout(t *Target) (time.Duration, time.Duration) {
+	return sp.config.IntervalAndTimeout(t.labels.Get(ProfileName))
+}
+
 // Sync converts target groups into actual scrape targets and synchronizes
 // the currently running scraper with the resulting set and returns all scraped and dropped targets.
 func (sp *scrapePool) Sync(tgs []*targetgroup.Group) {
@@ -233,11 +255,7 @@ func (sp *scrapePool) sync(targets []*Target) {
 	sp.mtx.Lock()
 	defer sp.mtx.Unlock()
 
-	var (
-		uniqueTargets = map[uint64]struct{}{}
-		interval      = time.Duration(sp.config.ScrapeInterval)
-		timeout       = time.Duration(sp.config.ScrapeTimeout)
-	)
+	uniqueTargets := map[uint64]struct{}{}
 
 	for _, t := range targets {
 		t := t
@@ -245,6 +263,8 @@ func (sp *scrapePool) sync(targets []*Target) {
 		uniqueTargets[hash] = struct{}{}
 
 		if _, ok := sp.activeTargets[hash]; !ok {
+			// Each target scrapes a single profile type, at the interval of that type.
+			interval, timeout := sp.intervalAndTimeout(t)
 			s := &targetScraper{Target: t, client: sp.client, timeout: timeout, logger: sp.logger}
 			l := sp.newLoop(t, s)
 
@@ -323,18 +343,13 @@ func (s *targetScraper) scrape(ctx context.Context, w io.Writer, profileType str
 		return fmt.Errorf("server returned HTTP status %s", resp.Status)
 	}
 
-	switch profileType {
-	case ProfileTraceType:
-		return fmt.Errorf("unimplemented")
-	default:
-		b, err := ioutil.ReadAll(io.TeeReader(resp.Body, w))
-		if err != nil {
-			return errors.Wrap(err, "failed to read body")
-		}
+	b, err := ioutil.ReadAll(io.TeeReader(resp.Body, w))
+	if err != nil {
+		return errors.Wrap(err, "failed to read body")
+	}
 
-		if len(b) == 0 {
-			return fmt.Errorf("empty %s profile from %s", profileType, s.req.URL.String())
-		}
+	if len(b) == 0 {
+		return fmt.Errorf("empty %s profile from %s", profileType, s.req.URL.String())
 	}
 
 	return nil
@@ -356,6 +371,7 @@ type scrapeLoop struct {
 	buffers *pool.Pool
 
 	store     profilepb.ProfileStoreServiceServer
+	traces    TraceAppender
 	ctx       context.Context
 	scrapeCtx context.Context
 	cancel    func()
@@ -369,6 +385,7 @@ func newScrapeLoop(ctx context.Context,
 	targetIntervalLength *prometheus.SummaryVec,
 	buffers *pool.Pool,
 	store profilepb.ProfileStoreServiceServer,
+	traces TraceAppender,
 ) *scrapeLoop {
 	if l == nil {
 		l = log.NewNopLogger()
@@ -381,6 +398,7 @@ func newScrapeLoop(ctx context.Context,
 		scraper:        sc,
 		buffers:        buffers,
 		store:          store,
+		traces:         traces,
 		stopped:        make(chan struct{}),
 		l:              l,
 		intervalLength: targetIntervalLength,
@@ -455,29 +473,12 @@ mainLoop:
 			sort.Sort(tl)
 			level.Debug(sl.l).Log("msg", "appending new sample", "labels", tl.String())
 
-			protolbls := &profilepb.LabelSet{
-				Labels: []*profilepb.Label{},
-			}
-			for _, l := range tl {
-				protolbls.Labels = append(protolbls.Labels, &profilepb.Label{
-					Name:  l.Name,
-					Value: l.Value,
-				})
+			var err error
+			if profileType == ProfileTraceType {
+				err = sl.traces.AppendTrace(sl.ctx, tl, start, buf.Bytes())
+			} else {
+				err = sl.writeProfile(tl, buf.Bytes())
 			}
-
-			_, err := sl.store.WriteRaw(sl.ctx, &profilepb.WriteRawRequest{
-				Tenant: "",
-				Series: []*profilepb.RawProfileSeries{
-					{
-						Labels: protolbls,
-						Samples: []*profilepb.RawSample{
-							{
-								RawProfile: buf.Bytes(),
-							},
-						},
-					},
-				},
-			})
 			if err != nil && errc != nil {
 				level.Debug(sl.l).Log("err", err)
 				errc <- err
@@ -515,6 +516,42 @@ mainLoop:
 	close(sl.stopped)
 }
 
+// writeProfile writes the raw profile to the profile store. The profiles
+// requested for a number of seconds have that duration unless they record
+// their duration themselves.
+func (sl *scrapeLoop) writeProfile(tl labels.Labels, profile []byte) error {
+	var duration *durationpb.Duration
+	if seconds, err := strconv.Atoi(sl.target.URL().Query().Get("seconds")); err == nil && seconds > 0 {
+		duration = durationpb.New(time.Duration(seconds) * time.Second)
+	}
+
+	protolbls := &profilepb.LabelSet{
+		Labels: []*profilepb.Label{},
+	}
+	for _, l := range tl {
+		protolbls.Labels = append(protolbls.Labels, &profilepb.Label{
+			Name:  l.Name,
+			Value: l.Value,
+		})
+	}
+
+	_, err := sl.store.WriteRaw(sl.ctx, &profilepb.WriteRawRequest{
+		Tenant: "",
+		Series: []*profilepb.RawProfileSeries{
+			{
+				Labels: protolbls,
+				Samples: []*profilepb.RawSample{
+					{
+						RawProfile: profile,
+						Duration:   duration,
+					},
+				},
+			},
+		},
+	})
+	return err
+}
+
 // Stop the scraping. May still write data and stale markers after it has
 // returned. Cancel the context to stop all writes.
 func (sl *scrapeLoop) stop() {
@@ -0,0 +1,171 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrape
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	profilepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
+	"github.com/parca-dev/parca/pkg/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/discovery/targetgroup"
+	"github.com/stretchr/testify/require"
+)
+
+type testLoop struct{}
+
+func (l *testLoop) run(interval, timeout time.Duration, errc chan<- error) {}
+
+func (l *testLoop) stop() {}
+
+func loadScrapeConfig(t *testing.T, s string) *config.ScrapeConfig {
+	cfg, err := config.Load(s)
+	require.NoError(t, err)
+	require.Len(t, cfg.ScrapeConfigs, 1)
+	return cfg.ScrapeConfigs[0]
+}
+
+func newTestScrapePool(cfg *config.ScrapeConfig) *scrapePool {
+	sp := newScrapePool(cfg, nil, nil, nil, &scrapePoolMetrics{
+		targetReloadIntervalLength: prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{Name: "test_reload_interval_length_seconds"},
+			[]string{"interval"},
+		),
+		targetSyncIntervalLength: prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{Name: "test_sync_interval_length_seconds"},
+			[]string{"scrape_job"},
+		),
+		targetScrapePoolSyncsCounter: prometheus.NewCounterVec(
+			prometheus.CounterOpts{Name: "test_scrape_pool_sync_total"},
+			[]string{"scrape_job"},
+		),
+	})
+	sp.newLoop = func(*Target, scraper) loop { return &testLoop{} }
+	return sp
+}
+
+// targetsByProfile returns the active targets of the pool by profile type.
+func targetsByProfile(sp *scrapePool) map[string]*Target {
+	targets := map[string]*Target{}
+	for _, t := range sp.ActiveTargets() {
+		targets[t.labels.Get(ProfileName)] = t
+	}
+	return targets
+}
+
+var testTargetGroups = []*targetgroup.Group{{
+	Targets: []model.LabelSet{{model.AddressLabel: "localhost:7070"}},
+}}
+
+func TestScrapePoolIntervalAndTimeout(t *testing.T) {
+	sp := newTestScrapePool(loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'
+  scrape_interval: 1m
+  scrape_timeout: 30s
+  profiling_config:
+    pprof_config:
+      process_cpu:
+        delta: true
+        scrape_interval: 10s
+        scrape_timeout: 10s
+      goroutine_total:
+        scrape_interval: 5m
+        scrape_timeout: 1m`))
+	defer sp.stop()
+	sp.Sync(testTargetGroups)
+
+	targets := targetsByProfile(sp)
+	for _, tc := range []struct {
+		profileType       string
+		interval, timeout time.Duration
+	}{
+		{"process_cpu", 10 * time.Second, 10 * time.Second},
+		{"memory_total", time.Minute, 30 * time.Second},
+		{"goroutine_total", 5 * time.Minute, time.Minute},
+	} {
+		require.Contains(t, targets, tc.profileType)
+		interval, timeout := sp.intervalAndTimeout(targets[tc.profileType])
+		require.Equal(t, tc.interval, interval, tc.profileType)
+		require.Equal(t, tc.timeout, timeout, tc.profileType)
+	}
+}
+
+func TestScrapePoolReloadParams(t *testing.T) {
+	sp := newTestScrapePool(loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'
+  scrape_interval: 30s
+  scrape_timeout: 30s`))
+	defer sp.stop()
+	sp.Sync(testTargetGroups)
+	require.Equal(t, "30", targetsByProfile(sp)["process_cpu"].URL().Query().Get("seconds"))
+
+	sp.reload(loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'
+  scrape_interval: 30s
+  scrape_timeout: 30s
+  profiling_config:
+    pprof_config:
+      process_cpu:
+        delta: true
+        scrape_interval: 10s
+        scrape_timeout: 10s`))
+
+	// The targets are requested with the params of the new config and
+	// are kept under their new hashes.
+	targets := targetsByProfile(sp)
+	require.Equal(t, "10", targets["process_cpu"].URL().Query().Get("seconds"))
+	require.Equal(t, len(sp.loops), len(sp.activeTargets))
+	for h, target := range sp.activeTargets {
+		require.Equal(t, h, target.hash())
+		require.Contains(t, sp.loops, h)
+	}
+}
+
+// testStore records the requests written to it.
+type testStore struct {
+	profilepb.UnimplementedProfileStoreServiceServer
+	requests []*profilepb.WriteRawRequest
+}
+
+func (s *testStore) WriteRaw(_ context.Context, r *profilepb.WriteRawRequest) (*profilepb.WriteRawResponse, error) {
+	s.requests = append(s.requests, r)
+	return &profilepb.WriteRawResponse{}, nil
+}
+
+func TestScrapeLoopWriteProfileDuration(t *testing.T) {
+	cfg := loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'
+  scrape_interval: 15s`)
+	targets, err := targetsFromGroup(testTargetGroups[0], cfg)
+	require.NoError(t, err)
+
+	store := &testStore{}
+	durations := map[string]time.Duration{}
+	for _, target := range targets {
+		sl := newScrapeLoop(context.Background(), target, nil, nil, nil, nil, store, nil)
+		require.NoError(t, sl.writeProfile(target.Labels(), []byte("profile")))
+
+		sample := store.requests[len(store.requests)-1].Series[0].Samples[0]
+		require.Equal(t, []byte("profile"), sample.RawProfile)
+		if sample.Duration != nil {
+			durations[target.labels.Get(ProfileName)] = sample.Duration.AsDuration()
+		}
+	}
+
+	// Only the delta profiles are requested for a duration.
+	require.Equal(t, map[string]time.Duration{"process_cpu": 15 * time.Second}, durations)
+}
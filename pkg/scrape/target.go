@@ -364,19 +364,26 @@ func targetsFromGroup(tg *targetgroup.Group, cfg *config.ScrapeConfig) ([]*Targe
 				return nil, fmt.Errorf("instance %d in group %s: %s", i, tg, err)
 			}
 			if lbls != nil || origLabels != nil {
-				params := cfg.Params
-				if params == nil {
-					params = url.Values{}
-				}
-
-				if pcfg, found := cfg.ProfilingConfig.PprofConfig[profType]; found && pcfg.Delta {
-					params.Add("seconds", strconv.Itoa(int(time.Duration(cfg.ScrapeInterval)/time.Second)))
-				}
-
-				targets = append(targets, NewTarget(lbls, origLabels, params))
+				targets = append(targets, NewTarget(lbls, origLabels, targetParams(cfg, profType)))
 			}
 		}
 	}
 
 	return targets, nil
 }
+
+// targetParams returns the URL params of the targets of the profile type.
+func targetParams(cfg *config.ScrapeConfig, profileType string) url.Values {
+	// The params of the config are shared by all targets, so they're
+	// copied before adding the ones of the profile type.
+	params := make(url.Values, len(cfg.Params)+1)
+	for k, v := range cfg.Params {
+		params[k] = append([]string(nil), v...)
+	}
+
+	if pcfg, found := cfg.ProfilingConfig.PprofConfig[profileType]; found && pcfg.Delta {
+		interval, _ := cfg.IntervalAndTimeout(profileType)
+		params.Set("seconds", strconv.Itoa(pcfg.DeltaSeconds(interval)))
+	}
+	return params
+}
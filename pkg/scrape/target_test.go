@@ -0,0 +1,68 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scrape
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetsFromGroupParams(t *testing.T) {
+	cfg := loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'
+  scrape_interval: 15s
+  params:
+    debug: ['1']
+  profiling_config:
+    pprof_config:
+      memory_delta:
+        path: /debug/pprof/allocs
+        delta: true
+        seconds: 5`)
+
+	targets, err := targetsFromGroup(testTargetGroups[0], cfg)
+	require.NoError(t, err)
+
+	params := map[string]url.Values{}
+	for _, t := range targets {
+		params[t.labels.Get(ProfileName)] = t.URL().Query()
+	}
+	// Delta profiles are requested for their seconds, the default ones
+	// for the scrape interval.
+	require.Equal(t, url.Values{"debug": {"1"}, "seconds": {"15"}}, params["process_cpu"])
+	require.Equal(t, url.Values{"debug": {"1"}, "seconds": {"5"}}, params["memory_delta"])
+	require.Equal(t, url.Values{"debug": {"1"}}, params["memory_total"])
+
+	// The params of the config aren't changed by the ones of the targets.
+	require.Equal(t, url.Values{"debug": {"1"}}, cfg.Params)
+}
+
+func TestTargetsFromGroupDefaultParams(t *testing.T) {
+	// The default job is scraped every 10s with a timeout of 11s.
+	cfg := loadScrapeConfig(t, `scrape_configs:
+- job_name: 'test'`)
+
+	targets, err := targetsFromGroup(testTargetGroups[0], cfg)
+	require.NoError(t, err)
+
+	for _, target := range targets {
+		if target.labels.Get(ProfileName) == "process_cpu" {
+			require.Equal(t, url.Values{"seconds": {"10"}}, target.URL().Query())
+			return
+		}
+	}
+	t.Fatal("no process_cpu target")
+}
@@ -95,7 +95,8 @@ func BenchmarkAppends(b *testing.B) {
 		l.Close()
 	})
 
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(b, err)
 
 	lset := labels.FromStrings("job", "parca", "n", strconv.Itoa(b.N))
 	app, err := db.Appender(context.Background(), lset)
@@ -133,7 +134,8 @@ func BenchmarkIterator(b *testing.B) {
 		l.Close()
 	})
 
-	db := storage.OpenDB(registry, tracer, nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), registry, tracer, nil)
+	require.NoError(b, err)
 
 	lset := labels.FromStrings("job", "parca", "n", strconv.Itoa(b.N))
 	app, err := db.Appender(context.Background(), lset)
@@ -0,0 +1,631 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/dgraph-io/sroar"
+	"github.com/oklog/ulid"
+	"github.com/parca-dev/parca/pkg/storage/index"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb/encoding"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	metaFilename   = "meta.json"
+	indexFilename  = "index"
+	chunksFilename = "chunks"
+
+	// BlockFormatV1 is the first version of the on-disk block format.
+	BlockFormatV1 = 1
+
+	// magicIndex is the first 4 bytes of the index file.
+	magicIndex = 0x50524F46
+)
+
+var castagnoliTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrBlockClosed is returned when reading a block's chunks after it has been closed.
+var ErrBlockClosed = errors.New("block closed")
+
+// BlockMeta provides meta information about a block.
+type BlockMeta struct {
+	// Unique identifier for the block and its contents. Changes on compaction.
+	ULID ulid.ULID `json:"ulid"`
+
+	// MinTime and MaxTime specify the time range all samples
+	// in the block are in.
+	MinTime int64 `json:"minTime"`
+	MaxTime int64 `json:"maxTime"`
+
+	// Stats about the contents of the block.
+	Stats BlockStats `json:"stats,omitempty"`
+
+	// Version of the index format.
+	Version int `json:"version"`
+}
+
+// BlockStats contains stats about contents of a block.
+type BlockStats struct {
+	NumSeries  uint64 `json:"numSeries,omitempty"`
+	NumSamples uint64 `json:"numSamples,omitempty"`
+	NumChunks  uint64 `json:"numChunks,omitempty"`
+}
+
+// blockSeries is the index entry of a series within a block.
+// The profile data of the series is read from the chunks file on demand.
+type blockSeries struct {
+	lset             labels.Labels
+	minTime, maxTime int64
+	offset, length   uint64
+}
+
+// Block is an immutable, persisted set of series that cover a fixed time range.
+// The index is kept in memory, while the series' samples are read from the
+// chunks file when queried.
+type Block struct {
+	dir  string
+	meta BlockMeta
+
+	// series are referenced by their ID - 1,
+	// as ID 0 is used to signal the end of postings.
+	series   []blockSeries
+	postings *index.MemPostings
+
+	// locationIDs are read from the chunks the first time they are needed.
+	locationIDsMtx sync.Mutex
+	locationIDs    map[uint64]struct{}
+
+	// The block is only closed once the pending reads of its chunks are done.
+	closingMtx     sync.RWMutex
+	closing        bool
+	pendingReaders sync.WaitGroup
+}
+
+// OpenBlock opens the block in the directory.
+func OpenBlock(dir string) (*Block, error) {
+	meta, err := readMetaFile(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := readIndexFile(filepath.Join(dir, indexFilename))
+	if err != nil {
+		return nil, fmt.Errorf("read index of block %s: %w", meta.ULID, err)
+	}
+
+	postings := index.NewMemPostings()
+	for i, s := range series {
+		postings.Add(uint64(i+1), s.lset)
+	}
+
+	return &Block{
+		dir:      dir,
+		meta:     *meta,
+		series:   series,
+		postings: postings,
+	}, nil
+}
+
+// startRead registers a read of the block's chunks, pendingReaders.Done
+// must be called once it's done.
+func (b *Block) startRead() error {
+	b.closingMtx.RLock()
+	defer b.closingMtx.RUnlock()
+
+	if b.closing {
+		return ErrBlockClosed
+	}
+	b.pendingReaders.Add(1)
+	return nil
+}
+
+// Close waits for the pending reads of the block's chunks to be done.
+// Afterwards the block can't be read anymore and its directory can be removed.
+func (b *Block) Close() error {
+	b.closingMtx.Lock()
+	b.closing = true
+	b.closingMtx.Unlock()
+
+	b.pendingReaders.Wait()
+	return nil
+}
+
+// Dir returns the directory of the block.
+func (b *Block) Dir() string { return b.dir }
+
+// Meta returns meta information about the block.
+func (b *Block) Meta() BlockMeta { return b.meta }
+
+// MinTime returns the min time of the block.
+func (b *Block) MinTime() int64 { return b.meta.MinTime }
+
+// MaxTime returns the max time of the block.
+func (b *Block) MaxTime() int64 { return b.meta.MaxTime }
+
+// OverlapsClosedInterval returns true if the block overlaps [mint, maxt].
+func (b *Block) OverlapsClosedInterval(mint, maxt int64) bool {
+	return b.meta.MinTime <= maxt && mint <= b.meta.MaxTime
+}
+
+// Index returns an IndexReader against the block.
+func (b *Block) Index() (IndexReader, error) {
+	return &blockIndexReader{b: b}, nil
+}
+
+func (b *Block) getByID(id uint64) (*blockSeries, error) {
+	if id == 0 || id > uint64(len(b.series)) {
+		return nil, ErrNotFound
+	}
+	return &b.series[id-1], nil
+}
+
+// seriesMaxTime returns the max time of the series with the given labels,
+// if the block contains it. The series are sorted by their labels.
+func (b *Block) seriesMaxTime(lset labels.Labels) (int64, bool) {
+	i := sort.Search(len(b.series), func(i int) bool {
+		return labels.Compare(b.series[i].lset, lset) >= 0
+	})
+	if i < len(b.series) && labels.Equal(b.series[i].lset, lset) {
+		return b.series[i].maxTime, true
+	}
+	return 0, false
+}
+
+// addLocationIDs adds the IDs of all locations referenced by the block's series to ids.
+func (b *Block) addLocationIDs(ids map[uint64]struct{}) error {
+	b.locationIDsMtx.Lock()
+	defer b.locationIDsMtx.Unlock()
+
+	// Blocks are immutable, so their location IDs only need to be read once.
+	if b.locationIDs == nil {
+		if err := b.startRead(); err != nil {
+			return err
+		}
+		defer b.pendingReaders.Done()
+
+		chunks, err := os.Open(filepath.Join(b.dir, chunksFilename))
+		if err != nil {
+			return fmt.Errorf("open chunks: %w", err)
+		}
+		defer chunks.Close()
+
+		locationIDs := map[uint64]struct{}{}
+		for i := range b.series {
+			s, err := b.readSeries(chunks, uint64(i+1))
+			if err != nil {
+				return err
+			}
+			s.seriesTree.addLocationIDs(locationIDs)
+		}
+		b.locationIDs = locationIDs
+	}
+
+	for id := range b.locationIDs {
+		ids[id] = struct{}{}
+	}
+	return nil
+}
+
+// readSeries reads the samples of a series from the chunks file.
+func (b *Block) readSeries(chunks io.ReaderAt, id uint64) (*MemSeries, error) {
+	bs, err := b.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, bs.length)
+	if _, err := chunks.ReadAt(buf, int64(bs.offset)); err != nil {
+		return nil, fmt.Errorf("read series %s: %w", bs.lset, err)
+	}
+	if len(buf) < 4 {
+		return nil, fmt.Errorf("series %s: %w", bs.lset, encoding.ErrInvalidSize)
+	}
+
+	data, sum := buf[:len(buf)-4], binary.BigEndian.Uint32(buf[len(buf)-4:])
+	if crc32.Checksum(data, castagnoliTable) != sum {
+		return nil, fmt.Errorf("series %s: %w", bs.lset, encoding.ErrInvalidChecksum)
+	}
+
+	dec := encoding.Decbuf{B: data}
+	return decodeSeries(&dec, id, bs.lset)
+}
+
+// Querier returns a new Querier for the block.
+func (b *Block) Querier(ctx context.Context, tracer trace.Tracer, mint, maxt int64) Querier {
+	return &blockQuerier{
+		b:      b,
+		ctx:    ctx,
+		tracer: tracer,
+		mint:   mint,
+		maxt:   maxt,
+	}
+}
+
+type blockQuerier struct {
+	b          *Block
+	ctx        context.Context
+	tracer     trace.Tracer
+	mint, maxt int64
+}
+
+func (q *blockQuerier) LabelNames(ms ...*labels.Matcher) ([]string, Warnings, error) {
+	ir, err := q.b.Index()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names, err := ir.LabelNames(ms...)
+	return names, nil, err
+}
+
+func (q *blockQuerier) LabelValues(name string, ms ...*labels.Matcher) ([]string, Warnings, error) {
+	ir, err := q.b.Index()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, err := ir.LabelValues(name, ms...)
+	return values, nil, err
+}
+
+func (q *blockQuerier) Select(hints *SelectHints, ms ...*labels.Matcher) SeriesSet {
+	ctx, span := q.tracer.Start(q.ctx, "BlockSelect")
+	defer span.End()
+
+	ir, err := q.b.Index()
+	if err != nil {
+		return &errSeriesSet{err: err}
+	}
+
+	_, postingSpan := q.tracer.Start(ctx, "PostingsForMatchers")
+	postings, err := PostingsForMatchers(ir, ms...)
+	postingSpan.End()
+	if err != nil {
+		return &errSeriesSet{err: err}
+	}
+
+	mint := q.mint
+	maxt := q.maxt
+	if hints != nil {
+		mint = hints.Start
+		maxt = hints.End
+	}
+
+	if err := q.b.startRead(); err != nil {
+		return &errSeriesSet{err: fmt.Errorf("read block %s: %w", q.b.meta.ULID, err)}
+	}
+	defer q.b.pendingReaders.Done()
+
+	chunks, err := os.Open(filepath.Join(q.b.dir, chunksFilename))
+	if err != nil {
+		return &errSeriesSet{err: fmt.Errorf("open chunks of block %s: %w", q.b.meta.ULID, err)}
+	}
+	defer chunks.Close()
+
+	ss := make([]Series, 0, postings.GetCardinality())
+	it := postings.NewIterator()
+
+	for {
+		id := it.Next()
+		if id == 0 {
+			break
+		}
+
+		bs, err := q.b.getByID(id)
+		if err != nil {
+			// The postings might contain the errPostings ID.
+			continue
+		}
+		if bs.maxTime < mint || bs.minTime > maxt {
+			continue
+		}
+
+		s, err := q.b.readSeries(chunks, id)
+		if err != nil {
+			return &errSeriesSet{err: err}
+		}
+
+		ss = append(ss, seriesForHints(s, hints, mint, maxt))
+	}
+
+	return &SliceSeriesSet{
+		series: ss,
+		i:      -1,
+	}
+}
+
+type blockIndexReader struct {
+	b *Block
+}
+
+func (r *blockIndexReader) Close() error {
+	return nil
+}
+
+// Postings returns the postings list iterator for the label pairs.
+func (r *blockIndexReader) Postings(name string, values ...string) (*sroar.Bitmap, error) {
+	if len(values) == 1 {
+		return r.b.postings.Get(name, values[0]), nil
+	}
+
+	b := sroar.NewBitmap()
+	for _, value := range values {
+		b.Or(r.b.postings.Get(name, value))
+	}
+
+	if b.GetCardinality() == 0 {
+		b.Set(math.MaxUint64) // This is an errPostings bitmap
+	}
+
+	return b, nil
+}
+
+func (r *blockIndexReader) LabelValues(name string, matchers ...*labels.Matcher) ([]string, error) {
+	if len(matchers) == 0 {
+		return r.b.postings.LabelValues(name), nil
+	}
+
+	return labelValuesWithMatchers(r, name, matchers...)
+}
+
+func (r *blockIndexReader) LabelValueFor(id uint64, label string) (string, error) {
+	bs, err := r.b.getByID(id)
+	if err != nil {
+		return "", err
+	}
+	value := bs.lset.Get(label)
+	if value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (r *blockIndexReader) LabelNamesFor(ids ...uint64) ([]string, error) {
+	namesMap := make(map[string]struct{})
+	for _, id := range ids {
+		bs, err := r.b.getByID(id)
+		if err != nil {
+			return nil, err
+		}
+		for _, lbl := range bs.lset {
+			namesMap[lbl.Name] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(namesMap))
+	for name := range namesMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *blockIndexReader) LabelNames(matchers ...*labels.Matcher) ([]string, error) {
+	if len(matchers) == 0 {
+		labelNames := r.b.postings.LabelNames()
+
+		sort.Strings(labelNames)
+		return labelNames, nil
+	}
+
+	return labelNamesWithMatchers(r, matchers...)
+}
+
+// writeBlock persists the series into a new block directory within dir.
+// The block is first written to a temporary directory which is renamed once complete.
+func writeBlock(dir string, meta *BlockMeta, series []*MemSeries) (string, error) {
+	blockDir := filepath.Join(dir, meta.ULID.String())
+	tmp := blockDir + ".tmp"
+
+	if err := os.RemoveAll(tmp); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(tmp, 0o777); err != nil {
+		return "", err
+	}
+
+	sort.Slice(series, func(i, j int) bool {
+		return labels.Compare(series[i].lset, series[j].lset) < 0
+	})
+
+	entries := make([]blockSeries, 0, len(series))
+
+	chunks, err := os.Create(filepath.Join(tmp, chunksFilename))
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		offset uint64
+		buf    encoding.Encbuf
+	)
+	for _, s := range series {
+		buf.Reset()
+		encodeSeries(&buf, s)
+		buf.PutBE32(crc32.Checksum(buf.Get(), castagnoliTable))
+
+		if _, err := chunks.Write(buf.Get()); err != nil {
+			chunks.Close()
+			return "", fmt.Errorf("write series %s: %w", s.lset, err)
+		}
+
+		entries = append(entries, blockSeries{
+			lset:    s.lset,
+			minTime: s.minTime,
+			maxTime: s.maxTime,
+			offset:  offset,
+			length:  uint64(buf.Len()),
+		})
+		offset += uint64(buf.Len())
+	}
+	if err := chunks.Sync(); err != nil {
+		chunks.Close()
+		return "", err
+	}
+	if err := chunks.Close(); err != nil {
+		return "", err
+	}
+
+	if err := writeIndexFile(filepath.Join(tmp, indexFilename), entries); err != nil {
+		return "", err
+	}
+	if err := writeMetaFile(tmp, meta); err != nil {
+		return "", err
+	}
+
+	if err := os.Rename(tmp, blockDir); err != nil {
+		return "", err
+	}
+
+	return blockDir, nil
+}
+
+func writeIndexFile(path string, entries []blockSeries) error {
+	buf := encoding.Encbuf{}
+	buf.PutBE32(magicIndex)
+	buf.PutByte(BlockFormatV1)
+
+	buf.PutUvarint(len(entries))
+	for _, e := range entries {
+		buf.PutUvarint(len(e.lset))
+		for _, l := range e.lset {
+			buf.PutUvarintStr(l.Name)
+			buf.PutUvarintStr(l.Value)
+		}
+		buf.PutVarint64(e.minTime)
+		buf.PutVarint64(e.maxTime)
+		buf.PutUvarint64(e.offset)
+		buf.PutUvarint64(e.length)
+	}
+	buf.PutBE32(crc32.Checksum(buf.Get(), castagnoliTable))
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Get()); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readIndexFile(path string) ([]blockSeries, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 9 {
+		return nil, encoding.ErrInvalidSize
+	}
+
+	data, sum := b[:len(b)-4], binary.BigEndian.Uint32(b[len(b)-4:])
+	if crc32.Checksum(data, castagnoliTable) != sum {
+		return nil, encoding.ErrInvalidChecksum
+	}
+
+	dec := encoding.Decbuf{B: data}
+	if m := dec.Be32(); m != magicIndex {
+		return nil, fmt.Errorf("invalid magic number %x", m)
+	}
+	if v := dec.Byte(); v != BlockFormatV1 {
+		return nil, fmt.Errorf("unsupported index version %d", v)
+	}
+
+	num := dec.Uvarint()
+	entries := make([]blockSeries, 0, num)
+	for i := 0; i < num && dec.Err() == nil; i++ {
+		lset := make(labels.Labels, dec.Uvarint())
+		for j := range lset {
+			lset[j].Name = dec.UvarintStr()
+			lset[j].Value = dec.UvarintStr()
+		}
+
+		entries = append(entries, blockSeries{
+			lset:    lset,
+			minTime: dec.Varint64(),
+			maxTime: dec.Varint64(),
+			offset:  dec.Uvarint64(),
+			length:  dec.Uvarint64(),
+		})
+	}
+
+	return entries, dec.Err()
+}
+
+func writeMetaFile(dir string, meta *BlockMeta) error {
+	b, err := json.MarshalIndent(meta, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, metaFilename)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func readMetaFile(dir string) (*BlockMeta, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, metaFilename))
+	if err != nil {
+		return nil, err
+	}
+
+	var m BlockMeta
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if m.Version != BlockFormatV1 {
+		return nil, fmt.Errorf("unexpected meta file version %d", m.Version)
+	}
+
+	return &m, nil
+}
+
+// isBlockDir reports whether the directory entry is a complete block.
+func isBlockDir(fi os.FileInfo) bool {
+	if !fi.IsDir() {
+		return false
+	}
+	_, err := ulid.ParseStrict(fi.Name())
+	return err == nil
+}
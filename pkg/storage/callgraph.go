@@ -0,0 +1,148 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+	"github.com/parca-dev/parca/internal/pprof/graph"
+	"github.com/parca-dev/parca/internal/pprof/measurement"
+)
+
+const (
+	// DefaultCallgraphNodeFraction is the default fraction of the total below which nodes are dropped, as in pprof.
+	DefaultCallgraphNodeFraction = 0.005
+	// DefaultCallgraphEdgeFraction is the default fraction of the total below which edges are dropped, as in pprof.
+	DefaultCallgraphEdgeFraction = 0.001
+)
+
+// GenerateCallgraph builds a pprof call graph of the profile's functions.
+// Nodes and edges whose values are below the given fractions of the total are dropped the same way pprof does.
+func GenerateCallgraph(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	p InstantProfile,
+	nodeFraction, edgeFraction float64,
+) (*pb.Callgraph, error) {
+	ctx, cgSpan := tracer.Start(ctx, "generate-callgraph")
+	defer cgSpan.End()
+
+	meta := p.ProfileMeta()
+	cg := &pb.Callgraph{
+		Unit: meta.SampleType.Unit,
+	}
+
+	pp, err := GeneratePprof(ctx, locations, p)
+	if err != nil {
+		return nil, fmt.Errorf("generate pprof: %w", err)
+	}
+	if pp == nil {
+		return cg, nil
+	}
+
+	// Aggregate by function, but keep the addresses of unsymbolized locations,
+	// as they would otherwise all be folded into a single node.
+	for _, l := range pp.Location {
+		if len(l.Line) == 0 {
+			continue
+		}
+		l.Address = 0
+		for i := range l.Line {
+			l.Line[i].Line = 0
+		}
+	}
+
+	for _, s := range pp.Sample {
+		cg.Total += abs(s.Value[0])
+	}
+
+	_, buildSpan := tracer.Start(ctx, "build-callgraph")
+	defer buildSpan.End()
+
+	o := &graph.Options{
+		SampleValue: func(v []int64) int64 { return v[0] },
+		FormatTag:   measurement.Label,
+	}
+	g := graph.New(pp, o)
+
+	nodeCutoff := abs(int64(float64(cg.Total) * nodeFraction))
+	edgeCutoff := abs(int64(float64(cg.Total) * edgeFraction))
+
+	// The graph has to be rebuilt from the samples to keep the values of the remaining nodes exact.
+	droppedNodes := 0
+	if nodeCutoff > 0 {
+		if kept := g.DiscardLowFrequencyNodes(nodeCutoff); len(kept) != len(g.Nodes) {
+			droppedNodes = len(g.Nodes) - len(kept)
+			o.KeptNodes = kept
+			g = graph.New(pp, o)
+		}
+	}
+	g.SortNodes(false, true)
+	g.TrimLowFrequencyTags(nodeCutoff)
+	droppedEdges := g.TrimLowFrequencyEdges(edgeCutoff)
+	g.RemoveRedundantEdges()
+
+	ids := make(map[*graph.Node]uint32, len(g.Nodes))
+	for i, n := range g.Nodes {
+		// The IDs match the node names ComposeDot writes.
+		ids[n] = uint32(i + 1)
+		cg.Nodes = append(cg.Nodes, &pb.CallgraphNode{
+			Id:         ids[n],
+			Name:       n.Info.PrintableName(),
+			Filename:   n.Info.File,
+			Address:    n.Info.Address,
+			Flat:       n.FlatValue(),
+			Cumulative: n.CumValue(),
+		})
+	}
+	for _, n := range g.Nodes {
+		for _, e := range n.Out.Sort() {
+			cg.Edges = append(cg.Edges, &pb.CallgraphEdge{
+				Source:   ids[e.Src],
+				Target:   ids[e.Dest],
+				Weight:   e.WeightValue(),
+				Residual: e.Residual,
+				Inline:   e.Inline,
+			})
+		}
+	}
+
+	formatValue := func(v int64) string {
+		return measurement.ScaledLabel(v, meta.SampleType.Unit, "auto")
+	}
+	labels := []string{fmt.Sprintf("Type: %s", meta.SampleType.Type)}
+	if droppedNodes > 0 {
+		labels = append(labels, fmt.Sprintf("Dropped %d nodes (cum <= %s)", droppedNodes, formatValue(nodeCutoff)))
+	}
+	if droppedEdges > 0 {
+		labels = append(labels, fmt.Sprintf("Dropped %d edges (freq <= %s)", droppedEdges, formatValue(edgeCutoff)))
+	}
+
+	var buf bytes.Buffer
+	graph.ComposeDot(&buf, g, &graph.DotAttributes{}, &graph.DotConfig{
+		Title:       meta.SampleType.Type,
+		Labels:      labels,
+		FormatValue: formatValue,
+		Total:       cg.Total,
+	})
+	cg.Dot = buf.String()
+
+	return cg, nil
+}
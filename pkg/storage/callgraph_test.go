@@ -0,0 +1,84 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+func TestGenerateCallgraph(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(1, []uint64{5, 3, 2, 1}))
+	pt.Insert(makeSample(3, []uint64{4, 3, 2, 1}))
+
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "1"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "2"}}}},
+		3: {ID: 3, Line: []profile.Line{{Function: &profile.Function{ID: 3, Name: "3"}}}},
+		4: {ID: 4, Line: []profile.Line{{Function: &profile.Function{ID: 4, Name: "4"}}}},
+		5: {ID: 5, Line: []profile.Line{{Function: &profile.Function{ID: 5, Name: "5"}}}},
+	}}
+	p := &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Type: "alloc_objects", Unit: "count"}}}
+
+	cg, err := GenerateCallgraph(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p, 0, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(6), cg.Total)
+	require.Equal(t, "count", cg.Unit)
+	// The order of the nodes is up to pprof's heuristics, but the IDs are their positions.
+	nodes := map[string]*pb.CallgraphNode{}
+	for i, n := range cg.Nodes {
+		require.Equal(t, uint32(i+1), n.Id)
+		nodes[n.Name] = &pb.CallgraphNode{Name: n.Name, Flat: n.Flat, Cumulative: n.Cumulative}
+	}
+	require.Equal(t, map[string]*pb.CallgraphNode{
+		"1": {Name: "1", Flat: 0, Cumulative: 6},
+		"2": {Name: "2", Flat: 2, Cumulative: 6},
+		"3": {Name: "3", Flat: 0, Cumulative: 4},
+		"4": {Name: "4", Flat: 3, Cumulative: 3},
+		"5": {Name: "5", Flat: 1, Cumulative: 1},
+	}, nodes)
+
+	edges := map[string]int64{}
+	for _, e := range cg.Edges {
+		edges[cg.Nodes[e.Source-1].Name+"->"+cg.Nodes[e.Target-1].Name] = e.Weight
+	}
+	require.Equal(t, map[string]int64{
+		"1->2": 6,
+		"2->3": 4,
+		"3->4": 3,
+		"3->5": 1,
+	}, edges)
+	require.Contains(t, cg.Dot, "digraph \"alloc_objects\"")
+	require.Contains(t, cg.Dot, "N1 -> N")
+
+	// Node 5 has a cumulative value below a third of the total.
+	cg, err = GenerateCallgraph(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p, 0.34, 0)
+	require.NoError(t, err)
+	require.Len(t, cg.Nodes, 4)
+	for _, n := range cg.Nodes {
+		require.NotEqual(t, "5", n.Name)
+	}
+	require.Contains(t, cg.Dot, "Dropped 1 nodes")
+}
@@ -229,6 +229,10 @@ func FromData(e Encoding, d []byte) (Chunk, error) {
 	switch e {
 	case EncXOR:
 		return &XORChunk{b: bstream{count: 0, stream: d}}, nil
+	case EncDelta:
+		return &DeltaChunk{b: bstream{count: 0, stream: d}}, nil
+	case EncRLE:
+		return &RLEChunk{b: bstream{count: 0, stream: d}}, nil
 	}
 	return nil, fmt.Errorf("invalid chunk encoding %q", e)
 }
@@ -0,0 +1,255 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"crypto/rand"
+	"math"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/parca-dev/parca/pkg/storage/chunkenc"
+)
+
+// headCut holds copies of the series' leading chunks that are about to be
+// persisted into a block and removed from the head afterwards.
+type headCut struct {
+	series []*MemSeries
+	// chunks is the number of leading chunks cut from each head series.
+	chunks map[*MemSeries]int
+
+	mint, maxt int64
+	stats      BlockStats
+}
+
+// cut collects all full chunks of all series that only contain samples before maxt.
+// Only full chunks are cut, as the head relies on the number of samples to
+// align values within the chunks.
+func (h *Head) cut(maxt int64) *headCut {
+	c := &headCut{
+		chunks: map[*MemSeries]int{},
+		mint:   math.MaxInt64,
+		maxt:   math.MinInt64,
+	}
+
+	for i := 0; i < h.series.size; i++ {
+		h.series.locks[i].RLock()
+		for _, s := range h.series.series[i] {
+			if bs := s.cut(maxt); bs != nil {
+				n := len(bs.timestamps)
+				c.series = append(c.series, bs)
+				c.chunks[s] = n
+
+				if bs.minTime < c.mint {
+					c.mint = bs.minTime
+				}
+				if bs.maxTime > c.maxt {
+					c.maxt = bs.maxTime
+				}
+				c.stats.NumSeries++
+				c.stats.NumChunks += uint64(n)
+				c.stats.NumSamples += uint64(bs.numSamples)
+			}
+		}
+		h.series.locks[i].RUnlock()
+	}
+
+	return c
+}
+
+// cut returns a new MemSeries sharing the series' leading full chunks before maxt.
+// It returns nil if there are no such chunks.
+func (s *MemSeries) cut(maxt int64) *MemSeries {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := 0
+	for _, t := range s.timestamps {
+		if t.maxTime >= maxt || t.chunk.NumSamples() < samplesPerChunk {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+
+	bs := NewMemSeries(s.id, s.lset, func(int64) {}, nil)
+	bs.periodType = s.periodType
+	bs.sampleType = s.sampleType
+	bs.minTime = s.timestamps[0].minTime
+	bs.maxTime = s.timestamps[n-1].maxTime
+	bs.numSamples = uint16(n * samplesPerChunk)
+
+	// Full chunks aren't appended to anymore, therefore they can be shared
+	// until they are removed from the head.
+	bs.timestamps = append(bs.timestamps, s.timestamps[:n]...)
+	bs.durations = append(bs.durations, s.durations[:n]...)
+	bs.periods = append(bs.periods, s.periods[:n]...)
+
+	for k, chunks := range s.flatValues {
+		bs.flatValues[k] = leadingChunks(chunks, n)
+	}
+	for k, chunks := range s.cumulativeValues {
+		bs.cumulativeValues[k] = leadingChunks(chunks, n)
+	}
+	for k, l := range s.labels {
+		bs.labels[k] = l
+	}
+	for k, l := range s.numLabels {
+		bs.numLabels[k] = l
+	}
+	for k, l := range s.numUnits {
+		bs.numUnits[k] = l
+	}
+
+	if s.seriesTree != nil && s.seriesTree.Roots != nil {
+		bs.seriesTree.Roots = copySeriesTreeNode(s.seriesTree.Roots)
+	}
+
+	return bs
+}
+
+// leadingChunks returns a copy of the first n chunks.
+// Keys that were added later on might have fewer chunks,
+// which are then padded with empty chunks.
+func leadingChunks(chunks []chunkenc.Chunk, n int) []chunkenc.Chunk {
+	res := make([]chunkenc.Chunk, 0, n)
+	for i := 0; i < n; i++ {
+		if i < len(chunks) {
+			res = append(res, chunks[i])
+			continue
+		}
+		res = append(res, chunkenc.NewXORChunk())
+	}
+	return res
+}
+
+func copySeriesTreeNode(n *MemSeriesTreeNode) *MemSeriesTreeNode {
+	c := &MemSeriesTreeNode{
+		LocationID: n.LocationID,
+		keys:       make([]ProfileTreeValueNodeKey, len(n.keys)),
+		Children:   make([]*MemSeriesTreeNode, 0, len(n.Children)),
+	}
+	copy(c.keys, n.keys)
+	for _, child := range n.Children {
+		c.Children = append(c.Children, copySeriesTreeNode(child))
+	}
+	return c
+}
+
+// removeCut removes the chunks that have been persisted from the head.
+func (h *Head) removeCut(c *headCut) {
+	for s, n := range c.chunks {
+		s.removeLeadingChunks(n)
+	}
+
+	var mint int64 = math.MaxInt64
+	for i := 0; i < h.series.size; i++ {
+		h.series.locks[i].RLock()
+		for _, s := range h.series.series[i] {
+			s.mu.RLock()
+			if len(s.timestamps) > 0 && s.minTime < mint {
+				mint = s.minTime
+			}
+			s.mu.RUnlock()
+		}
+		h.series.locks[i].RUnlock()
+	}
+	if mint == math.MaxInt64 {
+		mint = c.maxt + 1
+	}
+	h.minTime.Store(mint)
+}
+
+// removeLeadingChunks removes the first n chunks of the series.
+// The chunks are not returned to the pool, as they might still be referenced by queries.
+func (s *MemSeries) removeLeadingChunks(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n > len(s.timestamps) {
+		n = len(s.timestamps)
+	}
+
+	s.timestamps = s.timestamps[n:]
+	s.durations = s.durations[n:]
+	s.periods = s.periods[n:]
+
+	for k, chunks := range s.flatValues {
+		if len(chunks) < n {
+			s.flatValues[k] = chunks[:0]
+			continue
+		}
+		s.flatValues[k] = chunks[n:]
+	}
+	for k, chunks := range s.cumulativeValues {
+		if len(chunks) < n {
+			s.cumulativeValues[k] = chunks[:0]
+			continue
+		}
+		s.cumulativeValues[k] = chunks[n:]
+	}
+
+	s.numSamples -= uint16(n * samplesPerChunk)
+
+	if len(s.timestamps) == 0 {
+		// The maxTime is kept to still reject out of order samples.
+		s.minTime = math.MaxInt64
+		return
+	}
+	s.minTime = s.timestamps[0].minTime
+}
+
+// compactHead persists all chunks of the head with samples before maxt into a new block.
+// It returns nil if there was nothing to persist.
+func (db *DB) compactHead(maxt int64) (*Block, error) {
+	c := db.head.cut(maxt)
+	if len(c.series) == 0 {
+		return nil, nil
+	}
+
+	start := time.Now()
+	db.metrics.compactionsTriggered.Inc()
+
+	meta := &BlockMeta{
+		ULID:    ulid.MustNew(ulid.Now(), rand.Reader),
+		MinTime: c.mint,
+		MaxTime: c.maxt,
+		Stats:   c.stats,
+		Version: BlockFormatV1,
+	}
+
+	dir, err := writeBlock(db.options.Path, meta, c.series)
+	if err != nil {
+		db.metrics.compactionsFailed.Inc()
+		return nil, err
+	}
+
+	b, err := OpenBlock(dir)
+	if err != nil {
+		db.metrics.compactionsFailed.Inc()
+		return nil, err
+	}
+
+	// Only now that the block can be queried is the data removed from the head.
+	db.mtx.Lock()
+	db.blocks = append(db.blocks, b)
+	db.head.removeCut(c)
+	db.mtx.Unlock()
+
+	db.metrics.compactionDuration.Observe(time.Since(start).Seconds())
+
+	return b, nil
+}
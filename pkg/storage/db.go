@@ -15,11 +15,23 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/tsdb/wal"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -34,6 +46,8 @@ type SelectHints struct {
 	Merge    bool // Is the query result a merge of all samples?
 	Root     bool // Is the query result supposed to only contain the root's cumulative values?
 	Metadata bool // Is the database just being queried for metadata like label-names/label-values.
+
+	SampleLabels []*labels.Matcher // Matchers on the pprof labels of the samples the profiles are built from.
 }
 
 type Queryable interface {
@@ -111,43 +125,212 @@ func (s *SliceSeriesSet) At() Series         { return s.series[s.i] }
 func (s *SliceSeriesSet) Err() error         { return nil }
 func (s *SliceSeriesSet) Warnings() Warnings { return nil }
 
+// errSeriesSet is a SeriesSet that only returns an error.
+type errSeriesSet struct {
+	err error
+}
+
+func (s *errSeriesSet) Next() bool         { return false }
+func (s *errSeriesSet) At() Series         { return nil }
+func (s *errSeriesSet) Err() error         { return s.err }
+func (s *errSeriesSet) Warnings() Warnings { return nil }
+
+// walDirname is the name of the WAL's directory within the storage path.
+const walDirname = "wal"
+
 type DB struct {
+	logger  log.Logger
+	tracer  trace.Tracer
 	options *DBOptions
+	metrics *dbMetrics
 
 	head *Head
+
+	// mtx protects the blocks.
+	mtx    sync.RWMutex
+	blocks []*Block
 }
 
 type DBOptions struct {
-	Retention time.Duration
+	// Path is the directory blocks and the WAL are persisted to.
+	// If empty, all data is only kept in memory.
+	Path string
+	// BlockDuration is the time range the head covers before
+	// its data is persisted into a block.
+	BlockDuration time.Duration
+	Retention     time.Duration
 
 	HeadExpensiveMetrics bool
 }
 
-func OpenDB(r prometheus.Registerer, tracer trace.Tracer, opts *DBOptions) *DB {
+type dbMetrics struct {
+	loadedBlocks         prometheus.GaugeFunc
+	compactionsTriggered prometheus.Counter
+	compactionsFailed    prometheus.Counter
+	compactionDuration   prometheus.Histogram
+}
+
+func newDBMetrics(db *DB, r prometheus.Registerer) *dbMetrics {
+	m := &dbMetrics{
+		loadedBlocks: prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "parca_tsdb_blocks_loaded",
+			Help: "Number of currently loaded data blocks.",
+		}, func() float64 {
+			db.mtx.RLock()
+			defer db.mtx.RUnlock()
+			return float64(len(db.blocks))
+		}),
+		compactionsTriggered: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "parca_tsdb_compactions_total",
+			Help: "Total number of compactions of the head into blocks.",
+		}),
+		compactionsFailed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "parca_tsdb_compactions_failed_total",
+			Help: "Total number of compactions of the head into blocks that failed.",
+		}),
+		compactionDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "parca_tsdb_compaction_duration_seconds",
+			Help:    "Duration of compactions of the head into blocks.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+	}
+
+	r.MustRegister(
+		m.loadedBlocks,
+		m.compactionsTriggered,
+		m.compactionsFailed,
+		m.compactionDuration,
+	)
+
+	return m
+}
+
+func OpenDB(logger log.Logger, r prometheus.Registerer, tracer trace.Tracer, opts *DBOptions) (*DB, error) {
 	if opts == nil {
 		opts = &DBOptions{
 			HeadExpensiveMetrics: false,
 		}
 	}
+	if opts.BlockDuration == 0 {
+		opts.BlockDuration = 2 * time.Hour
+	}
 
-	return &DB{
+	db := &DB{
+		logger:  logger,
+		tracer:  tracer,
 		options: opts,
-		head: NewHead(r, tracer, &HeadOptions{
-			ExpensiveMetrics: opts.HeadExpensiveMetrics,
-		}),
 	}
+	db.metrics = newDBMetrics(db, r)
+
+	headOpts := &HeadOptions{
+		ExpensiveMetrics: opts.HeadExpensiveMetrics,
+	}
+
+	if opts.Path != "" {
+		if err := os.MkdirAll(opts.Path, 0o777); err != nil {
+			return nil, fmt.Errorf("create storage directory: %w", err)
+		}
+
+		blocks, err := openBlocks(logger, opts.Path)
+		if err != nil {
+			return nil, err
+		}
+		db.blocks = blocks
+
+		w, err := wal.New(logger, r, filepath.Join(opts.Path, walDirname), true)
+		if err != nil {
+			return nil, fmt.Errorf("open WAL: %w", err)
+		}
+		headOpts.WAL = w
+	}
+
+	db.head = NewHead(r, tracer, headOpts)
+
+	if err := db.head.Init(db.minValidTime); err != nil {
+		// Without a WAL there is nothing to replay, and nothing to repair.
+		if headOpts.WAL == nil {
+			return nil, fmt.Errorf("init head: %w", err)
+		}
+		level.Warn(logger).Log("msg", "encountered WAL read error, attempting repair", "err", err)
+		if err := headOpts.WAL.Repair(err); err != nil {
+			return nil, fmt.Errorf("repair corrupted WAL: %w", err)
+		}
+		// The repaired WAL ends before the corruption, so it's replayed
+		// again up to there.
+		if err := db.head.Init(db.minValidTime); err != nil {
+			return nil, fmt.Errorf("init head after WAL repair: %w", err)
+		}
+	}
+
+	return db, nil
+}
+
+// openBlocks opens all blocks in the directory sorted by their time range.
+// Left over temporary directories of failed compactions are removed.
+func openBlocks(logger log.Logger, dir string) ([]*Block, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*Block, 0, len(files))
+	for _, fi := range files {
+		if fi.IsDir() && strings.HasSuffix(fi.Name(), ".tmp") {
+			if err := os.RemoveAll(filepath.Join(dir, fi.Name())); err != nil {
+				return nil, fmt.Errorf("remove temporary block directory: %w", err)
+			}
+			continue
+		}
+		if !isBlockDir(fi) {
+			continue
+		}
+
+		b, err := OpenBlock(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("open block %s: %w", fi.Name(), err)
+		}
+		level.Debug(logger).Log("msg", "opened block", "ulid", b.Meta().ULID, "mint", b.MinTime(), "maxt", b.MaxTime())
+		blocks = append(blocks, b)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].MinTime() < blocks[j].MinTime()
+	})
+
+	return blocks, nil
 }
 
 func (db *DB) Appender(ctx context.Context, lset labels.Labels) (Appender, error) {
 	return db.head.Appender(ctx, lset)
 }
 
+// Querier returns a Querier over the blocks and the head overlapping mint and maxt.
 func (db *DB) Querier(ctx context.Context, mint, maxt int64) Querier {
-	return db.head.Querier(ctx, mint, maxt)
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	queriers := make([]Querier, 0, len(db.blocks)+1)
+	for _, b := range db.blocks {
+		if b.OverlapsClosedInterval(mint, maxt) {
+			queriers = append(queriers, b.Querier(ctx, db.tracer, mint, maxt))
+		}
+	}
+	queriers = append(queriers, db.head.Querier(ctx, mint, maxt))
+
+	return NewMergeQuerier(queriers...)
+}
+
+// Blocks returns the currently loaded blocks.
+func (db *DB) Blocks() []*Block {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	return append([]*Block(nil), db.blocks...)
 }
 
 func (db *DB) Run(ctx context.Context) error {
 	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
@@ -162,6 +345,115 @@ func (db *DB) Run(ctx context.Context) error {
 			if err := db.head.Truncate(mint); err != nil {
 				return err
 			}
+			if err := db.deleteBlocksBefore(mint); err != nil {
+				level.Error(db.logger).Log("msg", "failed to delete blocks outside of retention", "err", err)
+			}
+
+			if err := db.Compact(); err != nil {
+				level.Error(db.logger).Log("msg", "compaction failed", "err", err)
+			}
+		}
+	}
+}
+
+// Compact persists the head's data into blocks once it covers
+// more than 1.5 times the block duration.
+func (db *DB) Compact() error {
+	if db.options.Path == "" {
+		return nil
+	}
+
+	width := db.options.BlockDuration.Milliseconds()
+	mint, maxt := db.head.MinTime(), db.head.MaxTime()
+	if mint == math.MaxInt64 || maxt-mint <= width*3/2 {
+		return nil
+	}
+
+	// Align the blocks' end to the block duration and always keep
+	// at least half a block duration of data in the head.
+	for end := mint - mint%width + width; end <= maxt-width/2; end += width {
+		b, err := db.compactHead(end)
+		if err != nil {
+			return err
+		}
+		if b == nil {
+			// Nothing could be persisted, as the chunks in this range aren't full yet.
+			continue
+		}
+		level.Info(db.logger).Log("msg", "compacted head into block", "ulid", b.Meta().ULID, "mint", b.MinTime(), "maxt", b.MaxTime())
+	}
+
+	return nil
+}
+
+// deleteBlocksBefore removes all blocks that only have samples before mint.
+func (db *DB) deleteBlocksBefore(mint int64) error {
+	db.mtx.Lock()
+	keep := db.blocks[:0]
+	var deletable []*Block
+	for _, b := range db.blocks {
+		if b.MaxTime() < mint {
+			deletable = append(deletable, b)
+			continue
+		}
+		keep = append(keep, b)
+	}
+	db.blocks = keep
+	db.mtx.Unlock()
+
+	// Queries that are still reading the blocks are waited for.
+	for _, b := range deletable {
+		if err := b.Close(); err != nil {
+			return fmt.Errorf("close block %s: %w", b.Meta().ULID, err)
+		}
+		if err := os.RemoveAll(b.Dir()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LocationIDs returns the IDs of all locations referenced by the series in the
+// head and the blocks, and by the profiles in the WAL.
+func (db *DB) LocationIDs() (map[uint64]struct{}, error) {
+	ids := map[uint64]struct{}{}
+	// The WAL is read first, so profiles appended meanwhile are at least in the head.
+	if err := db.head.addWALLocationIDs(ids); err != nil {
+		return nil, fmt.Errorf("read location IDs of WAL: %w", err)
+	}
+	for _, b := range db.Blocks() {
+		if err := b.addLocationIDs(ids); errors.Is(err, ErrBlockClosed) {
+			// The block has been deleted, so its locations aren't referenced anymore.
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("read location IDs of block %s: %w", b.Meta().ULID, err)
+		}
+	}
+	db.head.addLocationIDs(ids)
+
+	return ids, nil
+}
+
+// minValidTime returns the first timestamp of the series that isn't persisted in a block yet.
+func (db *DB) minValidTime(lset labels.Labels) int64 {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	var mint int64 = math.MinInt64
+	for _, b := range db.blocks {
+		if maxt, ok := b.seriesMaxTime(lset); ok && maxt >= mint {
+			mint = maxt + 1
+		}
+	}
+	return mint
+}
+
+// Close closes the database's blocks and head.
+func (db *DB) Close() error {
+	for _, b := range db.Blocks() {
+		if err := b.Close(); err != nil {
+			return fmt.Errorf("close block %s: %w", b.Meta().ULID, err)
 		}
 	}
+	return db.head.Close()
 }
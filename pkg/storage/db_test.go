@@ -30,6 +30,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/tsdb/wal"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -44,7 +45,8 @@ func TestDB(t *testing.T) {
 		l.Close()
 	})
 	require.NoError(t, err)
-	db := OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	ctx := context.Background()
 	app1, err := db.Appender(ctx, labels.Labels{{Name: "namespace", Value: "default"}, {Name: "container", Value: "test1"}})
 	require.NoError(t, err)
@@ -107,7 +109,8 @@ func TestDBConsistency(t *testing.T) {
 		l.Close()
 	})
 	require.NoError(t, err)
-	db := OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	ctx := context.Background()
 	app, err := db.Appender(ctx, labels.FromStrings("__name__", "cpu"))
 	require.NoError(t, err)
@@ -223,3 +226,265 @@ func TestSliceSeriesSet(t *testing.T) {
 	require.NoError(t, ss.Err())
 	require.False(t, ss.Next())
 }
+
+func TestDBPersistence(t *testing.T) {
+	l, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"testdbpersistence",
+	)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "parca-db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	opts := &DBOptions{
+		Path:          dir,
+		BlockDuration: time.Minute,
+	}
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), opts)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	lset := labels.FromStrings("__name__", "heap")
+	app, err := db.Appender(ctx, lset)
+	require.NoError(t, err)
+
+	f, err := os.Open("testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// 300 samples result in two full chunks that can be persisted,
+	// while the last chunk stays in the head.
+	for i := int64(0); i < 300; i++ {
+		prof, err := ProfileFromPprof(ctx, log.NewNopLogger(), l, p, 0)
+		require.NoError(t, err)
+		prof.Meta.Timestamp = i * 1000
+		require.NoError(t, app.Append(ctx, prof))
+	}
+
+	require.NoError(t, db.Compact())
+	require.Len(t, db.Blocks(), 2)
+	require.Equal(t, int64(240_000), db.head.MinTime())
+
+	countSamples := func(db *DB, mint, maxt int64) int {
+		q := db.Querier(ctx, mint, maxt)
+		set := q.Select(nil, labels.MustNewMatcher(labels.MatchEqual, "__name__", "heap"))
+		i := 0
+		prev := int64(-1)
+		for set.Next() {
+			it := set.At().Iterator()
+			for it.Next() {
+				// Blocks and the head are returned in order, without overlap.
+				ts := it.At().ProfileMeta().Timestamp
+				require.Greater(t, ts, prev)
+				require.GreaterOrEqual(t, ts, mint)
+				require.LessOrEqual(t, ts, maxt)
+				require.NoError(t, validateProfile(CopyInstantProfile(it.At())), "ts %d", ts)
+				prev = ts
+				i++
+			}
+			require.NoError(t, it.Err())
+		}
+		require.NoError(t, set.Err())
+		return i
+	}
+
+	require.Equal(t, 300, countSamples(db, 0, 300_000))
+	require.Equal(t, 69, countSamples(db, 130_000, 199_000))
+
+	// The merge of all samples spans both blocks and the head.
+	set := db.Querier(ctx, 0, 300_000).Select(&SelectHints{
+		Start: 0,
+		End:   300_000,
+		Merge: true,
+	}, labels.MustNewMatcher(labels.MatchEqual, "__name__", "heap"))
+	require.True(t, set.Next())
+	it := set.At().Iterator()
+	require.True(t, it.Next())
+	require.Equal(t, int64(0), it.At().ProfileMeta().Timestamp)
+	require.False(t, it.Next())
+	require.False(t, set.Next())
+	require.NoError(t, set.Err())
+
+	require.NoError(t, db.Close())
+
+	// Reopening the database replays the samples that haven't been
+	// persisted into blocks from the WAL.
+	db, err = OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), opts)
+	require.NoError(t, err)
+	require.Len(t, db.Blocks(), 2)
+	require.Equal(t, int64(240_000), db.head.MinTime())
+	require.Equal(t, int64(299_000), db.head.MaxTime())
+	require.Equal(t, 300, countSamples(db, 0, 300_000))
+
+	names, _, err := db.Querier(ctx, 0, 300_000).LabelNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"__name__"}, names)
+
+	// Blocks outside of the retention are deleted once they aren't read anymore.
+	blocks := db.Blocks()
+	require.NoError(t, blocks[0].startRead())
+	deleted := make(chan error)
+	go func() {
+		deleted <- db.deleteBlocksBefore(200_000)
+	}()
+	select {
+	case err := <-deleted:
+		t.Fatalf("block deleted while read: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	_, err = os.Stat(blocks[0].Dir())
+	require.NoError(t, err)
+	blocks[0].pendingReaders.Done()
+	require.NoError(t, <-deleted)
+	_, err = os.Stat(blocks[0].Dir())
+	require.True(t, os.IsNotExist(err))
+
+	require.Len(t, db.Blocks(), 1)
+	require.Equal(t, 180, countSamples(db, 120_000, 300_000))
+	require.NoError(t, db.Close())
+
+	// Closing the database closes its blocks.
+	require.ErrorIs(t, blocks[1].startRead(), ErrBlockClosed)
+}
+
+func TestDBRepairWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	opts := &DBOptions{Path: dir}
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), opts)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	lset := labels.FromStrings("__name__", "heap")
+	app, err := db.Appender(ctx, lset)
+	require.NoError(t, err)
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+	for i := int64(1); i <= 10; i++ {
+		require.NoError(t, app.Append(ctx, &Profile{
+			Tree: pt,
+			Meta: InstantProfileMeta{Timestamp: i},
+		}))
+	}
+
+	// Each profile is logged as its own record, so cutting off the last
+	// bytes written tears the record of the last profile only.
+	_, last, err := wal.Segments(filepath.Join(dir, walDirname))
+	require.NoError(t, err)
+	segment := wal.SegmentName(filepath.Join(dir, walDirname), last)
+	fi, err := os.Stat(segment)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	require.NoError(t, os.Truncate(segment, fi.Size()-5))
+
+	// The WAL is repaired and the profiles before the damage are replayed.
+	db, err = OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	require.Equal(t, int64(1), db.head.MinTime())
+	require.Equal(t, int64(9), db.head.MaxTime())
+
+	set := db.Querier(ctx, 0, 10).Select(nil, labels.MustNewMatcher(labels.MatchEqual, "__name__", "heap"))
+	require.True(t, set.Next())
+	it := set.At().Iterator()
+	ts := int64(1)
+	for it.Next() {
+		require.Equal(t, ts, it.At().ProfileMeta().Timestamp)
+		ts++
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, int64(10), ts)
+	require.False(t, set.Next())
+}
+
+func TestDBLocationIDs(t *testing.T) {
+	l, err := metastore.NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"testdblocationids",
+	)
+	t.Cleanup(func() {
+		l.Close()
+	})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "parca-db")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &DBOptions{
+		Path:          dir,
+		BlockDuration: time.Minute,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	ctx := context.Background()
+	app, err := db.Appender(ctx, labels.FromStrings("__name__", "heap"))
+	require.NoError(t, err)
+
+	f, err := os.Open("testdata/profile1.pb.gz")
+	require.NoError(t, err)
+	p, err := profile.Parse(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	expected := map[uint64]struct{}{}
+	for i := int64(0); i < 300; i++ {
+		prof, err := ProfileFromPprof(ctx, log.NewNopLogger(), l, p, 0)
+		require.NoError(t, err)
+		prof.Meta.Timestamp = i * 1000
+		require.NoError(t, app.Append(ctx, prof))
+
+		it := NewProfileTreeIterator(prof.Tree)
+		for it.HasMore() {
+			if it.NextChild() {
+				if id := it.At().LocationID(); id != 0 {
+					expected[id] = struct{}{}
+				}
+				it.StepInto()
+				continue
+			}
+			it.StepUp()
+		}
+	}
+	require.Greater(t, len(expected), 0)
+
+	ids, err := db.LocationIDs()
+	require.NoError(t, err)
+	require.Equal(t, expected, ids)
+
+	// The blocks reference the same locations as the head.
+	require.NoError(t, db.Compact())
+	require.Len(t, db.Blocks(), 2)
+	for _, b := range db.Blocks() {
+		blockIDs := map[uint64]struct{}{}
+		require.NoError(t, b.addLocationIDs(blockIDs))
+		require.Equal(t, expected, blockIDs)
+	}
+
+	ids, err = db.LocationIDs()
+	require.NoError(t, err)
+	require.Equal(t, expected, ids)
+}
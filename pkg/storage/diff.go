@@ -286,7 +286,7 @@ func DiffInstantProfileTreeNodes(base, compare InstantProfileTreeNode) InstantPr
 		if flatValues == nil {
 			flatValues = []*ProfileTreeValueNode{{}}
 		}
-		flatValues[0].Value -= flatA[0].Value
+		flatValues[0].Value -= sumValues(flatA)
 	}
 
 	flatB := compare.FlatValues()
@@ -294,19 +294,12 @@ func DiffInstantProfileTreeNodes(base, compare InstantProfileTreeNode) InstantPr
 		if flatValues == nil {
 			flatValues = []*ProfileTreeValueNode{{}}
 		}
-		flatValues[0].Value += flatB[0].Value
+		flatValues[0].Value += sumValues(flatB)
 	}
 
-	cumulativeValues := []*ProfileTreeValueNode{{}}
-	cumulativeA := base.CumulativeValues()
-	if len(cumulativeA) > 0 {
-		cumulativeValues[0].Value -= cumulativeA[0].Value
-	}
-
-	cumulativeB := compare.CumulativeValues()
-	if len(cumulativeB) > 0 {
-		cumulativeValues[0].Value += cumulativeB[0].Value
-	}
+	cumulativeValues := []*ProfileTreeValueNode{{
+		Value: sumValues(compare.CumulativeValues()) - sumValues(base.CumulativeValues()),
+	}}
 
 	return &ProfileTreeNode{
 		locationID:           compare.LocationID(),
@@ -0,0 +1,272 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/google/pprof/profile"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+	"github.com/parca-dev/parca/internal/pprof/plugin"
+)
+
+// ObjectFiles opens the binaries uploaded for the mappings of a profile.
+type ObjectFiles interface {
+	// ObjectFile opens the binary of the mapping, its addresses are translated using the mapping.
+	ObjectFile(ctx context.Context, m *profile.Mapping) (plugin.ObjFile, error)
+	// Disasm disassembles an opened binary from the start to the end address.
+	Disasm(file string, start, end uint64, intelSyntax bool) ([]plugin.Inst, error)
+}
+
+// sampleValues are the summed up values of the samples of a location.
+type sampleValues struct {
+	flat, cum int64
+}
+
+// addressValues are the values of the samples of a single address.
+type addressValues struct {
+	address uint64
+	sampleValues
+}
+
+// GenerateDisassembly disassembles the given function from the binaries of
+// the profile and attributes the values of the profile's locations to its
+// instructions by address, the same way the pprof disassembly report does.
+func GenerateDisassembly(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	objects ObjectFiles,
+	p InstantProfile,
+	function string,
+) (*pb.Disassembly, error) {
+	ctx, span := tracer.Start(ctx, "generate-disassembly")
+	defer span.End()
+
+	pt := CopyInstantProfileTree(p.ProfileTree())
+
+	locs, err := getLocations(ctx, tracer, locations, pt)
+	if err != nil {
+		return nil, fmt.Errorf("get locations: %w", err)
+	}
+
+	values, total, err := locationValues(pt)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &pb.Disassembly{
+		Total: total,
+		Unit:  p.ProfileMeta().SampleType.Unit,
+	}
+
+	// Only the binaries containing the function, or locations that aren't
+	// symbolized and might belong to it, are disassembled.
+	mappings := map[uint64]*profile.Mapping{}
+	addresses := map[uint64][]*addressValues{}
+	for id, v := range values {
+		l, found := locs[id]
+		if !found {
+			return nil, fmt.Errorf("could not find location with ID %d", id)
+		}
+		if l.Mapping == nil || l.Mapping.BuildID == "" {
+			continue
+		}
+
+		addresses[l.Mapping.ID] = append(addresses[l.Mapping.ID], &addressValues{
+			address:      l.Address,
+			sampleValues: *v,
+		})
+		if len(l.Line) == 0 || hasFunction(l, function) {
+			mappings[l.Mapping.ID] = l.Mapping
+		}
+	}
+
+	ids := make([]uint64, 0, len(mappings))
+	for id := range mappings {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	rx := regexp.MustCompile("^" + regexp.QuoteMeta(function) + "$")
+	for _, id := range ids {
+		syms, err := disassembleMapping(ctx, objects, mappings[id], rx, addresses[id])
+		if err != nil {
+			span.RecordError(err)
+			continue
+		}
+		d.Symbols = append(d.Symbols, syms...)
+	}
+	if len(d.Symbols) == 0 {
+		return nil, ErrFunctionNotFound
+	}
+
+	sort.SliceStable(d.Symbols, func(i, j int) bool {
+		if a, b := d.Symbols[i].Names[0], d.Symbols[j].Names[0]; a != b {
+			return a < b
+		}
+		return d.Symbols[i].Start < d.Symbols[j].Start
+	})
+
+	return d, nil
+}
+
+// disassembleMapping disassembles the symbols matching rx in the binary of
+// the mapping and annotates their instructions with the values of the addresses.
+func disassembleMapping(
+	ctx context.Context,
+	objects ObjectFiles,
+	m *profile.Mapping,
+	rx *regexp.Regexp,
+	addresses []*addressValues,
+) ([]*pb.DisassemblySymbol, error) {
+	obj, err := objects.ObjectFile(ctx, m)
+	if err != nil {
+		return nil, fmt.Errorf("open object file of %s: %w", m.BuildID, err)
+	}
+	defer obj.Close()
+
+	syms, err := obj.Symbols(rx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("read symbols of %s: %w", m.BuildID, err)
+	}
+
+	// Translate the addresses into the addresses of the binary.
+	samples := make([]*addressValues, 0, len(addresses))
+	for _, a := range addresses {
+		addr, err := obj.ObjAddr(a.address)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, &addressValues{address: addr, sampleValues: a.sampleValues})
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].address < samples[j].address })
+
+	res := make([]*pb.DisassemblySymbol, 0, len(syms))
+	for _, sym := range syms {
+		insts, err := objects.Disasm(obj.Name(), sym.Start, sym.End, false)
+		if err != nil {
+			return nil, fmt.Errorf("disassemble %s: %w", sym.Name[0], err)
+		}
+
+		s := &pb.DisassemblySymbol{
+			Names:   sym.Name,
+			BuildId: m.BuildID,
+			Start:   sym.Start,
+			End:     sym.End,
+		}
+
+		// Skip to the first sample of the symbol.
+		i := sort.Search(len(samples), func(i int) bool { return samples[i].address >= sym.Start })
+		for ix, in := range insts {
+			next := sym.End + 1
+			if ix+1 < len(insts) {
+				next = insts[ix+1].Addr
+			}
+
+			inst := &pb.DisassemblyInstruction{
+				Address:  in.Addr,
+				Text:     in.Text,
+				Function: in.Function,
+				Filename: in.File,
+				Line:     int64(in.Line),
+			}
+			// Sum all the samples until the next instruction, to account
+			// for samples attributed to the middle of an instruction.
+			for ; i < len(samples) && samples[i].address < next; i++ {
+				if samples[i].address < in.Addr {
+					continue
+				}
+				inst.Flat += samples[i].flat
+				inst.Cumulative += samples[i].cum
+			}
+			s.Flat += inst.Flat
+			s.Cumulative += inst.Cumulative
+			s.Instructions = append(s.Instructions, inst)
+		}
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+func hasFunction(l *profile.Location, function string) bool {
+	for _, line := range l.Line {
+		if line.Function != nil && line.Function.Name == function {
+			return true
+		}
+	}
+	return false
+}
+
+// locationValues sums up the flat and cumulative values of each location of the tree.
+// Locations appearing multiple times on a stack contribute to their cumulative value only once.
+func locationValues(pt InstantProfileTree) (map[uint64]*sampleValues, int64, error) {
+	values := map[uint64]*sampleValues{}
+
+	it := pt.Iterator()
+	if !it.HasMore() || !it.NextChild() {
+		return values, 0, nil
+	}
+
+	n := it.At()
+	if n.LocationID() != uint64(0) {
+		return nil, 0, errors.New("expected root node to be first node returned by iterator")
+	}
+	total := n.CumulativeValue()
+
+	if !it.StepInto() {
+		return values, total, nil
+	}
+
+	var (
+		onStack = map[uint64]int{}
+		stack   []uint64
+	)
+	for it.HasMore() {
+		if it.NextChild() {
+			child := it.At()
+			id := child.LocationID()
+
+			v, found := values[id]
+			if !found {
+				v = &sampleValues{}
+				values[id] = v
+			}
+			v.flat += sumValues(child.FlatValues())
+			if onStack[id] == 0 {
+				v.cum += child.CumulativeValue()
+			}
+			onStack[id]++
+			stack = append(stack, id)
+
+			it.StepInto()
+			continue
+		}
+
+		it.StepUp()
+		if len(stack) > 0 {
+			onStack[stack[len(stack)-1]]--
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	return values, total, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+	"github.com/parca-dev/parca/internal/pprof/plugin"
+)
+
+type fakeObjFile struct {
+	base uint64
+	syms []*plugin.Sym
+}
+
+func (f *fakeObjFile) Name() string                                   { return "fake" }
+func (f *fakeObjFile) ObjAddr(addr uint64) (uint64, error)            { return addr - f.base, nil }
+func (f *fakeObjFile) BuildID() string                                { return "abcd" }
+func (f *fakeObjFile) SourceLine(addr uint64) ([]plugin.Frame, error) { return nil, nil }
+func (f *fakeObjFile) Close() error                                   { return nil }
+
+func (f *fakeObjFile) Symbols(r *regexp.Regexp, addr uint64) ([]*plugin.Sym, error) {
+	var syms []*plugin.Sym
+	for _, s := range f.syms {
+		if r.MatchString(s.Name[0]) {
+			syms = append(syms, s)
+		}
+	}
+	return syms, nil
+}
+
+type fakeObjectFiles struct {
+	obj   *fakeObjFile
+	insts []plugin.Inst
+}
+
+func (o *fakeObjectFiles) ObjectFile(ctx context.Context, m *profile.Mapping) (plugin.ObjFile, error) {
+	return o.obj, nil
+}
+
+func (o *fakeObjectFiles) Disasm(file string, start, end uint64, intelSyntax bool) ([]plugin.Inst, error) {
+	var insts []plugin.Inst
+	for _, in := range o.insts {
+		if in.Addr >= start && in.Addr <= end {
+			insts = append(insts, in)
+		}
+	}
+	return insts, nil
+}
+
+func TestGenerateDisassembly(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(3, []uint64{3, 1}))
+	pt.Insert(makeSample(1, []uint64{4, 1}))
+	pt.Insert(makeSample(5, []uint64{5}))
+
+	m := &profile.Mapping{ID: 1, Start: 0x1000, BuildID: "abcd"}
+	main := &profile.Function{ID: 1, Name: "main"}
+	f := &profile.Function{ID: 2, Name: "f"}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Mapping: m, Address: 0x1010, Line: []profile.Line{{Function: main}}},
+		2: {ID: 2, Mapping: m, Address: 0x1100, Line: []profile.Line{{Function: f}}},
+		// In the middle of the instruction at 0x14.
+		3: {ID: 3, Mapping: m, Address: 0x1016},
+		4: {ID: 4, Mapping: m, Address: 0x1018, Line: []profile.Line{{Function: main}}},
+		// Outside of main.
+		5: {ID: 5, Mapping: m, Address: 0x1104, Line: []profile.Line{{Function: f}}},
+	}}
+
+	objects := &fakeObjectFiles{
+		obj: &fakeObjFile{
+			base: 0x1000,
+			syms: []*plugin.Sym{
+				{Name: []string{"main"}, Start: 0x10, End: 0x1f},
+				{Name: []string{"f"}, Start: 0x100, End: 0x10f},
+			},
+		},
+		insts: []plugin.Inst{
+			{Addr: 0x10, Text: "call f", Function: "main", File: "main.c", Line: 3},
+			{Addr: 0x14, Text: "mov", Function: "main", File: "main.c", Line: 4},
+			{Addr: 0x18, Text: "ret", Function: "main", File: "main.c", Line: 5},
+			{Addr: 0x100, Text: "ret", Function: "f", File: "main.c", Line: 8},
+			{Addr: 0x104, Text: "nop", Function: "f", File: "main.c", Line: 9},
+		},
+	}
+	p := &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Unit: "count"}}}
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	d, err := GenerateDisassembly(ctx, tracer, l, objects, p, "main")
+	require.NoError(t, err)
+
+	require.Equal(t, &pb.Disassembly{
+		Total: 11,
+		Unit:  "count",
+		Symbols: []*pb.DisassemblySymbol{{
+			Names:      []string{"main"},
+			BuildId:    "abcd",
+			Start:      0x10,
+			End:        0x1f,
+			Flat:       4,
+			Cumulative: 10,
+			Instructions: []*pb.DisassemblyInstruction{
+				{Address: 0x10, Text: "call f", Function: "main", Filename: "main.c", Line: 3, Flat: 0, Cumulative: 6},
+				{Address: 0x14, Text: "mov", Function: "main", Filename: "main.c", Line: 4, Flat: 3, Cumulative: 3},
+				{Address: 0x18, Text: "ret", Function: "main", Filename: "main.c", Line: 5, Flat: 1, Cumulative: 1},
+			},
+		}},
+	}, d)
+
+	_, err = GenerateDisassembly(ctx, tracer, l, objects, p, "unknown")
+	require.ErrorIs(t, err, ErrFunctionNotFound)
+}
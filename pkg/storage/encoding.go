@@ -0,0 +1,331 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/parca-dev/parca/pkg/storage/chunkenc"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb/encoding"
+)
+
+// encodeSeries writes the samples of the MemSeries to the buffer.
+// The label set is not part of the encoding, as it's stored in the block's index.
+// The caller needs to hold the series' lock.
+func encodeSeries(buf *encoding.Encbuf, s *MemSeries) {
+	buf.PutUvarintStr(s.periodType.Type)
+	buf.PutUvarintStr(s.periodType.Unit)
+	buf.PutUvarintStr(s.sampleType.Type)
+	buf.PutUvarintStr(s.sampleType.Unit)
+
+	buf.PutVarint64(s.minTime)
+	buf.PutVarint64(s.maxTime)
+	buf.PutUvarint64(uint64(s.numSamples))
+
+	buf.PutUvarint(len(s.timestamps))
+	for i, tc := range s.timestamps {
+		buf.PutVarint64(tc.minTime)
+		buf.PutVarint64(tc.maxTime)
+		encodeChunk(buf, tc.chunk)
+		encodeChunk(buf, s.durations[i])
+		encodeChunk(buf, s.periods[i])
+	}
+
+	// Collect all keys and sort them to have a stable encoding.
+	// The series tree then references the keys by their index.
+	keys := make([]ProfileTreeValueNodeKey, 0, len(s.cumulativeValues))
+	for k := range s.cumulativeValues {
+		keys = append(keys, k)
+	}
+	for k := range s.flatValues {
+		if _, ok := s.cumulativeValues[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].location != keys[j].location {
+			return keys[i].location < keys[j].location
+		}
+		if keys[i].labels != keys[j].labels {
+			return keys[i].labels < keys[j].labels
+		}
+		return keys[i].numlabels < keys[j].numlabels
+	})
+
+	keyIndex := make(map[ProfileTreeValueNodeKey]int, len(keys))
+	buf.PutUvarint(len(keys))
+	for i, k := range keys {
+		keyIndex[k] = i
+
+		buf.PutUvarintStr(k.location)
+		buf.PutUvarintStr(k.labels)
+		buf.PutUvarintStr(k.numlabels)
+
+		encodeChunks(buf, s.flatValues[k])
+		encodeChunks(buf, s.cumulativeValues[k])
+
+		encodeStringsMap(buf, s.labels[k])
+		encodeInt64sMap(buf, s.numLabels[k])
+		encodeStringsMap(buf, s.numUnits[k])
+	}
+
+	if s.seriesTree == nil || s.seriesTree.Roots == nil {
+		buf.PutByte(0)
+		return
+	}
+	buf.PutByte(1)
+	encodeSeriesTreeNode(buf, s.seriesTree.Roots, keyIndex)
+}
+
+func encodeSeriesTreeNode(buf *encoding.Encbuf, n *MemSeriesTreeNode, keyIndex map[ProfileTreeValueNodeKey]int) {
+	buf.PutUvarint64(n.LocationID)
+	buf.PutUvarint(len(n.keys))
+	for _, k := range n.keys {
+		buf.PutUvarint(keyIndex[k])
+	}
+	buf.PutUvarint(len(n.Children))
+	for _, c := range n.Children {
+		encodeSeriesTreeNode(buf, c, keyIndex)
+	}
+}
+
+func encodeChunks(buf *encoding.Encbuf, chks []chunkenc.Chunk) {
+	buf.PutUvarint(len(chks))
+	for _, c := range chks {
+		encodeChunk(buf, c)
+	}
+}
+
+func encodeChunk(buf *encoding.Encbuf, c chunkenc.Chunk) {
+	b := c.Bytes()
+	buf.PutByte(byte(c.Encoding()))
+	buf.PutUvarint(len(b))
+	buf.B = append(buf.B, b...)
+}
+
+func encodeStringsMap(buf *encoding.Encbuf, m map[string][]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf.PutUvarint(len(keys))
+	for _, k := range keys {
+		buf.PutUvarintStr(k)
+		buf.PutUvarint(len(m[k]))
+		for _, v := range m[k] {
+			buf.PutUvarintStr(v)
+		}
+	}
+}
+
+func encodeInt64sMap(buf *encoding.Encbuf, m map[string][]int64) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	buf.PutUvarint(len(keys))
+	for _, k := range keys {
+		buf.PutUvarintStr(k)
+		buf.PutUvarint(len(m[k]))
+		for _, v := range m[k] {
+			buf.PutVarint64(v)
+		}
+	}
+}
+
+// decodeSeries reads a MemSeries previously written by encodeSeries.
+// The returned series is meant for reading only, it doesn't have a chunk pool to append to.
+func decodeSeries(dec *encoding.Decbuf, id uint64, lset labels.Labels) (*MemSeries, error) {
+	s := NewMemSeries(id, lset, func(int64) {}, nil)
+
+	s.periodType = ValueType{Type: dec.UvarintStr(), Unit: dec.UvarintStr()}
+	s.sampleType = ValueType{Type: dec.UvarintStr(), Unit: dec.UvarintStr()}
+
+	s.minTime = dec.Varint64()
+	s.maxTime = dec.Varint64()
+	s.numSamples = uint16(dec.Uvarint64())
+
+	numChunks := dec.Uvarint()
+	s.timestamps = make(timestampChunks, 0, numChunks)
+	s.durations = make([]chunkenc.Chunk, 0, numChunks)
+	s.periods = make([]chunkenc.Chunk, 0, numChunks)
+	for i := 0; i < numChunks && dec.Err() == nil; i++ {
+		tc := &timestampChunk{
+			minTime: dec.Varint64(),
+			maxTime: dec.Varint64(),
+		}
+
+		var err error
+		if tc.chunk, err = decodeChunk(dec); err != nil {
+			return nil, fmt.Errorf("decode timestamp chunk: %w", err)
+		}
+		s.timestamps = append(s.timestamps, tc)
+
+		c, err := decodeChunk(dec)
+		if err != nil {
+			return nil, fmt.Errorf("decode duration chunk: %w", err)
+		}
+		s.durations = append(s.durations, c)
+
+		c, err = decodeChunk(dec)
+		if err != nil {
+			return nil, fmt.Errorf("decode period chunk: %w", err)
+		}
+		s.periods = append(s.periods, c)
+	}
+
+	numKeys := dec.Uvarint()
+	keys := make([]ProfileTreeValueNodeKey, 0, numKeys)
+	for i := 0; i < numKeys && dec.Err() == nil; i++ {
+		k := ProfileTreeValueNodeKey{
+			location:  dec.UvarintStr(),
+			labels:    dec.UvarintStr(),
+			numlabels: dec.UvarintStr(),
+		}
+		keys = append(keys, k)
+
+		flat, err := decodeChunks(dec)
+		if err != nil {
+			return nil, fmt.Errorf("decode flat values: %w", err)
+		}
+		if len(flat) > 0 {
+			s.flatValues[k] = flat
+		}
+
+		cumulative, err := decodeChunks(dec)
+		if err != nil {
+			return nil, fmt.Errorf("decode cumulative values: %w", err)
+		}
+		if len(cumulative) > 0 {
+			s.cumulativeValues[k] = cumulative
+		}
+
+		if m := decodeStringsMap(dec); len(m) > 0 {
+			s.labels[k] = m
+		}
+		if m := decodeInt64sMap(dec); len(m) > 0 {
+			s.numLabels[k] = m
+		}
+		if m := decodeStringsMap(dec); len(m) > 0 {
+			s.numUnits[k] = m
+		}
+	}
+
+	if dec.Byte() == 1 {
+		root, err := decodeSeriesTreeNode(dec, keys)
+		if err != nil {
+			return nil, err
+		}
+		s.seriesTree.Roots = root
+	}
+
+	if dec.Err() != nil {
+		return nil, fmt.Errorf("decode series: %w", dec.Err())
+	}
+
+	return s, nil
+}
+
+func decodeSeriesTreeNode(dec *encoding.Decbuf, keys []ProfileTreeValueNodeKey) (*MemSeriesTreeNode, error) {
+	n := &MemSeriesTreeNode{
+		LocationID: dec.Uvarint64(),
+	}
+
+	numKeys := dec.Uvarint()
+	n.keys = make([]ProfileTreeValueNodeKey, 0, numKeys)
+	for i := 0; i < numKeys && dec.Err() == nil; i++ {
+		idx := dec.Uvarint()
+		if idx >= len(keys) {
+			return nil, fmt.Errorf("invalid key reference %d", idx)
+		}
+		n.keys = append(n.keys, keys[idx])
+	}
+
+	numChildren := dec.Uvarint()
+	n.Children = make([]*MemSeriesTreeNode, 0, numChildren)
+	for i := 0; i < numChildren && dec.Err() == nil; i++ {
+		c, err := decodeSeriesTreeNode(dec, keys)
+		if err != nil {
+			return nil, err
+		}
+		n.Children = append(n.Children, c)
+	}
+
+	return n, dec.Err()
+}
+
+func decodeChunks(dec *encoding.Decbuf) ([]chunkenc.Chunk, error) {
+	num := dec.Uvarint()
+	chks := make([]chunkenc.Chunk, 0, num)
+	for i := 0; i < num && dec.Err() == nil; i++ {
+		c, err := decodeChunk(dec)
+		if err != nil {
+			return nil, err
+		}
+		chks = append(chks, c)
+	}
+	return chks, dec.Err()
+}
+
+func decodeChunk(dec *encoding.Decbuf) (chunkenc.Chunk, error) {
+	e := chunkenc.Encoding(dec.Byte())
+	b := dec.UvarintBytes()
+	if dec.Err() != nil {
+		return nil, dec.Err()
+	}
+	// Copy the bytes, as the underlying buffer might be reused.
+	data := make([]byte, len(b))
+	copy(data, b)
+	return chunkenc.FromData(e, data)
+}
+
+func decodeStringsMap(dec *encoding.Decbuf) map[string][]string {
+	num := dec.Uvarint()
+	if num == 0 {
+		return nil
+	}
+	m := make(map[string][]string, num)
+	for i := 0; i < num && dec.Err() == nil; i++ {
+		k := dec.UvarintStr()
+		vals := make([]string, dec.Uvarint())
+		for j := range vals {
+			vals[j] = dec.UvarintStr()
+		}
+		m[k] = vals
+	}
+	return m
+}
+
+func decodeInt64sMap(dec *encoding.Decbuf) map[string][]int64 {
+	num := dec.Uvarint()
+	if num == 0 {
+		return nil
+	}
+	m := make(map[string][]int64, num)
+	for i := 0; i < num && dec.Err() == nil; i++ {
+		k := dec.UvarintStr()
+		vals := make([]int64, dec.Uvarint())
+		for j := range vals {
+			vals[j] = dec.Varint64()
+		}
+		m[k] = vals
+	}
+	return m
+}
@@ -0,0 +1,244 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"sort"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// mergeQuerier fans out queries to the queriers of all blocks and the head.
+// The same series might be present in multiple queriers, in which case they are combined.
+type mergeQuerier struct {
+	queriers []Querier
+}
+
+// NewMergeQuerier returns a Querier that merges the results of the given queriers.
+// The queriers are expected to be sorted by the time range they cover.
+func NewMergeQuerier(queriers ...Querier) Querier {
+	if len(queriers) == 1 {
+		return queriers[0]
+	}
+	return &mergeQuerier{queriers: queriers}
+}
+
+func (q *mergeQuerier) LabelNames(ms ...*labels.Matcher) ([]string, Warnings, error) {
+	var ws Warnings
+	seen := map[string]struct{}{}
+	for _, querier := range q.queriers {
+		names, w, err := querier.LabelNames(ms...)
+		if err != nil {
+			return nil, nil, err
+		}
+		ws = append(ws, w...)
+		for _, n := range names {
+			seen[n] = struct{}{}
+		}
+	}
+
+	return sortedKeys(seen), ws, nil
+}
+
+func (q *mergeQuerier) LabelValues(name string, ms ...*labels.Matcher) ([]string, Warnings, error) {
+	var ws Warnings
+	seen := map[string]struct{}{}
+	for _, querier := range q.queriers {
+		values, w, err := querier.LabelValues(name, ms...)
+		if err != nil {
+			return nil, nil, err
+		}
+		ws = append(ws, w...)
+		for _, v := range values {
+			seen[v] = struct{}{}
+		}
+	}
+
+	return sortedKeys(seen), ws, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func (q *mergeQuerier) Select(hints *SelectHints, ms ...*labels.Matcher) SeriesSet {
+	var (
+		ws     Warnings
+		groups = map[uint64][][]Series{}
+	)
+
+	for _, querier := range q.queriers {
+		set := querier.Select(hints, ms...)
+		for set.Next() {
+			s := set.At()
+			lset := s.Labels()
+			h := lset.Hash()
+
+			found := false
+			for i, g := range groups[h] {
+				if labels.Equal(g[0].Labels(), lset) {
+					groups[h][i] = append(g, s)
+					found = true
+					break
+				}
+			}
+			if !found {
+				groups[h] = append(groups[h], []Series{s})
+			}
+		}
+		if err := set.Err(); err != nil {
+			return &errSeriesSet{err: err}
+		}
+		ws = append(ws, set.Warnings()...)
+	}
+
+	ss := make([]Series, 0, len(groups))
+	for _, gs := range groups {
+		for _, g := range gs {
+			if len(g) == 1 {
+				ss = append(ss, g[0])
+				continue
+			}
+			if hints != nil && hints.Merge {
+				ss = append(ss, &mergedSeries{series: g})
+				continue
+			}
+			ss = append(ss, &chainedSeries{series: g})
+		}
+	}
+
+	sort.Slice(ss, func(i, j int) bool {
+		return labels.Compare(ss[i].Labels(), ss[j].Labels()) < 0
+	})
+
+	return &SliceSeriesSet{
+		series: ss,
+		i:      -1,
+	}
+}
+
+// mergedSeries merges the profiles of all its series into a single profile.
+type mergedSeries struct {
+	series []Series
+}
+
+func (m *mergedSeries) Labels() labels.Labels {
+	return m.series[0].Labels()
+}
+
+func (m *mergedSeries) Iterator() ProfileSeriesIterator {
+	sl := &SliceProfileSeriesIterator{i: -1}
+
+	var merged InstantProfile
+	for _, s := range m.series {
+		it := s.Iterator()
+		for it.Next() {
+			var err error
+			merged, err = NewMergeProfile(merged, CopyInstantProfile(it.At()))
+			if err != nil {
+				sl.err = err
+				return sl
+			}
+		}
+		if err := it.Err(); err != nil {
+			sl.err = err
+			return sl
+		}
+	}
+
+	if merged != nil {
+		sl.samples = append(sl.samples, CopyInstantProfile(merged))
+	}
+	return sl
+}
+
+// chainedSeries iterates the profiles of all its series ordered by their timestamp.
+type chainedSeries struct {
+	series []Series
+}
+
+func (c *chainedSeries) Labels() labels.Labels {
+	return c.series[0].Labels()
+}
+
+func (c *chainedSeries) Iterator() ProfileSeriesIterator {
+	its := make([]ProfileSeriesIterator, 0, len(c.series))
+	for _, s := range c.series {
+		its = append(its, s.Iterator())
+	}
+	return &chainedSeriesIterator{its: its}
+}
+
+type chainedSeriesIterator struct {
+	its []ProfileSeriesIterator
+	// ok tracks which iterators still have a current profile.
+	ok  []bool
+	cur int
+	err error
+}
+
+func (it *chainedSeriesIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.ok == nil {
+		// Initially all iterators need to be advanced.
+		it.ok = make([]bool, len(it.its))
+		for i, sit := range it.its {
+			it.ok[i] = it.next(sit)
+		}
+	} else if it.cur >= 0 {
+		// Only the previously returned iterator needs to be advanced.
+		it.ok[it.cur] = it.next(it.its[it.cur])
+	}
+	if it.err != nil {
+		return false
+	}
+
+	it.cur = -1
+	for i, sit := range it.its {
+		if !it.ok[i] {
+			continue
+		}
+		if it.cur == -1 || sit.At().ProfileMeta().Timestamp < it.its[it.cur].At().ProfileMeta().Timestamp {
+			it.cur = i
+		}
+	}
+
+	return it.cur >= 0
+}
+
+func (it *chainedSeriesIterator) next(sit ProfileSeriesIterator) bool {
+	if sit.Next() {
+		return true
+	}
+	if err := sit.Err(); err != nil {
+		it.err = err
+	}
+	return false
+}
+
+func (it *chainedSeriesIterator) At() InstantProfile {
+	return it.its[it.cur].At()
+}
+
+func (it *chainedSeriesIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,233 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/google/pprof/profile"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ProfileFilter restricts a profile to some of its stacks and frames, the
+// same way pprof's focus, ignore, hide and show options do.
+// A nil regular expression doesn't filter anything.
+type ProfileFilter struct {
+	// Focus keeps only the stacks with a frame that matches.
+	Focus *regexp.Regexp
+	// Ignore drops the stacks with a frame that matches.
+	Ignore *regexp.Regexp
+	// Hide removes the frames that match from the stacks.
+	Hide *regexp.Regexp
+	// Show removes the frames that don't match from the stacks.
+	Show *regexp.Regexp
+}
+
+// IsEmpty returns whether the filter doesn't filter anything.
+func (f *ProfileFilter) IsEmpty() bool {
+	return f.Focus == nil && f.Ignore == nil && f.Hide == nil && f.Show == nil
+}
+
+// FilterProfile returns the profile with the filter applied to its tree.
+// As the locations are shared, hide and show remove a frame only if pprof
+// would remove all of its lines, inlined frames are not split up.
+// Stacks whose frames are all removed are dropped, like in pprof.
+func FilterProfile(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	p InstantProfile,
+	f *ProfileFilter,
+) (InstantProfile, error) {
+	if f == nil || f.IsEmpty() {
+		return p, nil
+	}
+
+	ctx, span := tracer.Start(ctx, "filter-profile")
+	defer span.End()
+
+	pt := CopyInstantProfileTree(p.ProfileTree())
+	if pt == nil {
+		return p, nil
+	}
+
+	locs, err := getLocations(ctx, tracer, locations, pt)
+	if err != nil {
+		return nil, fmt.Errorf("get locations: %w", err)
+	}
+
+	// Like in pprof, focusOrIgnore only contains the locations that are
+	// explicitly focused, true, or ignored, false.
+	focusOrIgnore := make(map[uint64]bool, len(locs))
+	hidden := map[uint64]bool{}
+	for id, l := range locs {
+		if f.Ignore != nil && matchesName(l, f.Ignore) {
+			focusOrIgnore[id] = false
+		} else if f.Focus == nil || matchesName(l, f.Focus) {
+			focusOrIgnore[id] = true
+		}
+
+		if f.Hide != nil && matchesName(l, f.Hide) && !hasUnmatchedLines(l, f.Hide) {
+			hidden[id] = true
+		}
+		if f.Show != nil && !hasMatchedLines(l, f.Show) {
+			hidden[id] = true
+		}
+	}
+
+	res := NewProfileTree()
+
+	// stack are the location IDs from the root to the current node.
+	var (
+		stack []uint64
+		walk  func(n *ProfileTreeNode)
+	)
+	walk = func(n *ProfileTreeNode) {
+		stack = append(stack, n.locationID)
+		if len(n.flatValues) > 0 || len(n.flatDiffValues) > 0 {
+			if focusedAndNotIgnored(stack, focusOrIgnore) {
+				kept := make([]uint64, 0, len(stack))
+				for _, id := range stack {
+					if !hidden[id] {
+						kept = append(kept, id)
+					}
+				}
+				if len(kept) > 0 {
+					res.insertValues(kept, n.flatValues, n.flatDiffValues)
+				}
+			}
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+		stack = stack[:len(stack)-1]
+	}
+	for _, child := range pt.Roots.Children {
+		walk(child)
+	}
+
+	return &Profile{
+		Tree: res,
+		Meta: p.ProfileMeta(),
+	}, nil
+}
+
+// insertValues adds the flat values at the stack of location IDs, given from
+// the root to the leaf, and adds them to the cumulative values along the stack.
+func (t *ProfileTree) insertValues(locationIDs []uint64, flat, flatDiff []*ProfileTreeValueNode) {
+	flatSum := sumValues(flat)
+	flatDiffSum := sumValues(flatDiff)
+
+	addCumulative := func(n *ProfileTreeNode) {
+		if n.cumulativeValues == nil {
+			n.cumulativeValues = []*ProfileTreeValueNode{{}}
+		}
+		n.cumulativeValues[0].Value += flatSum
+
+		if len(flatDiff) > 0 {
+			if n.cumulativeDiffValues == nil {
+				n.cumulativeDiffValues = []*ProfileTreeValueNode{{}}
+			}
+			n.cumulativeDiffValues[0].Value += flatDiffSum
+		}
+	}
+
+	cur := t.Roots
+	addCumulative(cur)
+	for _, id := range locationIDs {
+		index := sort.Search(len(cur.Children), func(i int) bool { return cur.Children[i].LocationID() >= id })
+		if index == len(cur.Children) || cur.Children[index].LocationID() != id {
+			children := make([]*ProfileTreeNode, len(cur.Children)+1)
+			copy(children, cur.Children[:index])
+			children[index] = &ProfileTreeNode{locationID: id}
+			copy(children[index+1:], cur.Children[index:])
+			cur.Children = children
+		}
+		cur = cur.Children[index]
+		addCumulative(cur)
+	}
+
+	cur.flatValues = append(cur.flatValues, copyValueNodes(flat)...)
+	cur.flatDiffValues = append(cur.flatDiffValues, copyValueNodes(flatDiff)...)
+}
+
+func copyValueNodes(values []*ProfileTreeValueNode) []*ProfileTreeValueNode {
+	res := make([]*ProfileTreeValueNode, 0, len(values))
+	for _, v := range values {
+		res = append(res, &ProfileTreeValueNode{
+			Value:    v.Value,
+			Label:    v.Label,
+			NumLabel: v.NumLabel,
+			NumUnit:  v.NumUnit,
+		})
+	}
+	return res
+}
+
+// focusedAndNotIgnored returns whether at least one of the locations is
+// focused, and none is ignored.
+func focusedAndNotIgnored(locationIDs []uint64, focusOrIgnore map[uint64]bool) bool {
+	focused := false
+	for _, id := range locationIDs {
+		if focus, ok := focusOrIgnore[id]; ok {
+			if !focus {
+				return false
+			}
+			focused = true
+		}
+	}
+	return focused
+}
+
+// matchesName returns whether a line's function name or filename, or the
+// location's mapping file, matches.
+func matchesName(l *profile.Location, re *regexp.Regexp) bool {
+	for _, line := range l.Line {
+		if fn := line.Function; fn != nil {
+			if re.MatchString(fn.Name) || re.MatchString(fn.Filename) {
+				return true
+			}
+		}
+	}
+	return l.Mapping != nil && re.MatchString(l.Mapping.File)
+}
+
+// hasUnmatchedLines returns whether hiding the matching lines leaves any line.
+func hasUnmatchedLines(l *profile.Location, re *regexp.Regexp) bool {
+	if l.Mapping != nil && re.MatchString(l.Mapping.File) {
+		return false
+	}
+	for _, line := range l.Line {
+		if fn := line.Function; fn == nil || !(re.MatchString(fn.Name) || re.MatchString(fn.Filename)) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasMatchedLines returns whether showing only the matching lines leaves any line.
+func hasMatchedLines(l *profile.Location, re *regexp.Regexp) bool {
+	if l.Mapping != nil && re.MatchString(l.Mapping.File) {
+		return len(l.Line) > 0
+	}
+	for _, line := range l.Line {
+		if fn := line.Function; fn == nil || re.MatchString(fn.Name) || re.MatchString(fn.Filename) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// treeStacks returns the flat values of the tree by stack, from the root to the leaf,
+// and checks that the cumulative values add up.
+func treeStacks(t *testing.T, pt *ProfileTree) map[string]int64 {
+	stacks := map[string]int64{}
+
+	var walk func(n *ProfileTreeNode, stack []string) int64
+	walk = func(n *ProfileTreeNode, stack []string) int64 {
+		cum := sumValues(n.flatValues)
+		if cum != 0 {
+			stacks[strings.Join(stack, ";")] = cum
+		}
+		for _, child := range n.Children {
+			cum += walk(child, append(stack, fmt.Sprint(child.locationID)))
+		}
+		require.Equal(t, cum, n.CumulativeValue(), "cumulative value of %v", stack)
+		return cum
+	}
+	walk(pt.Roots, nil)
+
+	return stacks
+}
+
+func TestFilterProfile(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(1, []uint64{5, 3, 2, 1}))
+	pt.Insert(makeSample(3, []uint64{4, 3, 2, 1}))
+	pt.Insert(makeSample(4, []uint64{3, 1}))
+
+	m := &profile.Mapping{ID: 1, File: "libc.so"}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "main"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "runtime.a", Filename: "runtime.go"}}}},
+		3: {ID: 3, Line: []profile.Line{
+			{Function: &profile.Function{ID: 3, Name: "inlined"}},
+			{Function: &profile.Function{ID: 4, Name: "runtime.b", Filename: "runtime.go"}},
+		}},
+		4: {ID: 4, Line: []profile.Line{{Function: &profile.Function{ID: 5, Name: "leaf"}}}},
+		5: {ID: 5, Mapping: m, Address: 0x5},
+	}}
+	p := &Profile{Tree: pt}
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	filter := func(f *ProfileFilter) map[string]int64 {
+		fp, err := FilterProfile(ctx, tracer, l, p, f)
+		require.NoError(t, err)
+		return treeStacks(t, fp.ProfileTree().(*ProfileTree))
+	}
+
+	require.Equal(t, map[string]int64{
+		"1;2":     2,
+		"1;2;3;5": 1,
+		"1;2;3;4": 3,
+		"1;3":     4,
+	}, filter(&ProfileFilter{}))
+
+	require.Equal(t, map[string]int64{
+		"1;2;3;4": 3,
+	}, filter(&ProfileFilter{Focus: regexp.MustCompile("^leaf$")}))
+
+	// The mapping file matches as well.
+	require.Equal(t, map[string]int64{
+		"1;2":     2,
+		"1;2;3;4": 3,
+		"1;3":     4,
+	}, filter(&ProfileFilter{Ignore: regexp.MustCompile(`libc\.so`)}))
+
+	// Stacks without any frame left are dropped, like in pprof an
+	// unsymbolized location has no lines to show.
+	require.Empty(t, filter(&ProfileFilter{Show: regexp.MustCompile(`libc\.so`)}))
+
+	require.Equal(t, map[string]int64{
+		"1;3": 4,
+	}, filter(&ProfileFilter{
+		Focus:  regexp.MustCompile("inlined"),
+		Ignore: regexp.MustCompile("runtime.a"),
+	}))
+
+	// Location 3 still has a line left after hiding runtime frames, the
+	// unsymbolized location 5 doesn't match.
+	require.Equal(t, map[string]int64{
+		"1":     2,
+		"1;3;5": 1,
+		"1;3;4": 3,
+		"1;3":   4,
+	}, filter(&ProfileFilter{Hide: regexp.MustCompile(`runtime\.go`)}))
+
+	// The unsymbolized location 5 has no lines to show.
+	require.Equal(t, map[string]int64{
+		"2":   2,
+		"2;3": 1 + 3,
+		"3":   4,
+	}, filter(&ProfileFilter{Show: regexp.MustCompile(`^runtime\.`)}))
+}
+
+func TestFilterProfileDiff(t *testing.T) {
+	ctx := context.Background()
+
+	a := NewProfileTree()
+	a.Insert(makeSample(2, []uint64{2, 1}))
+	a.Insert(makeSample(1, []uint64{3, 1}))
+
+	b := NewProfileTree()
+	b.Insert(makeSample(1, []uint64{2, 1}))
+	b.Insert(makeSample(4, []uint64{3, 1}))
+
+	p, err := NewDiffProfile(&Profile{Tree: a}, &Profile{Tree: b})
+	require.NoError(t, err)
+
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "1"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "2"}}}},
+		3: {ID: 3, Line: []profile.Line{{Function: &profile.Function{ID: 3, Name: "3"}}}},
+	}}
+
+	fp, err := FilterProfile(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p, &ProfileFilter{
+		Focus: regexp.MustCompile("^3$"),
+	})
+	require.NoError(t, err)
+
+	root := fp.ProfileTree().(*ProfileTree).Roots
+	require.Equal(t, int64(4), root.CumulativeValue())
+	require.Equal(t, int64(3), root.CumulativeDiffValue())
+	require.Len(t, root.Children, 1)
+	require.Len(t, root.Children[0].Children, 1)
+
+	leaf := root.Children[0].Children[0]
+	require.Equal(t, uint64(3), leaf.LocationID())
+	require.Equal(t, int64(3), sumValues(leaf.FlatDiffValues()))
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// javaPackageRegexp matches the package of a Java method, e.g. com.example of com.example.Foo.bar.
+var javaPackageRegexp = regexp.MustCompile(`^((?:[a-z]\w*\.)+)[A-Z]`)
+
+// AggregateFlamegraph merges the nodes of a flame graph, which are already
+// merged by function, by package, filename or mapping instead.
+// Siblings with the same key are merged, and so are consecutive nodes of a
+// stack, so a node's children are the calls leaving its package, file or mapping.
+func AggregateFlamegraph(fg *pb.Flamegraph, by pb.FlamegraphOptions_AggregateBy) *pb.Flamegraph {
+	if fg == nil || fg.Root == nil {
+		return fg
+	}
+
+	var key func(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta)
+	switch by {
+	case pb.FlamegraphOptions_AGGREGATE_BY_PACKAGE:
+		key = packageKey
+	case pb.FlamegraphOptions_AGGREGATE_BY_FILENAME:
+		key = filenameKey
+	case pb.FlamegraphOptions_AGGREGATE_BY_MAPPING:
+		key = mappingKey
+	default:
+		return fg
+	}
+
+	a := &flamegraphAggregator{key: key, height: 1}
+	fg.Root.Children = a.aggregate(fg.Root.Children, nil, 2)
+	fg.Height = a.height
+
+	return fg
+}
+
+type flamegraphAggregator struct {
+	// key returns the key the node is merged by, and the metadata of the merged node.
+	key    func(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta)
+	height int32
+}
+
+// aggregate merges the children of a node whose key is parent, nil for the root.
+// The children with the same key as their parent are replaced by their own children.
+func (a *flamegraphAggregator) aggregate(children []*pb.FlamegraphNode, parent *string, depth int32) []*pb.FlamegraphNode {
+	if len(children) == 0 {
+		return nil
+	}
+
+	var (
+		keys     []string
+		merged   = map[string]*pb.FlamegraphNode{}
+		pending  = map[string][]*pb.FlamegraphNode{}
+		worklist = append([]*pb.FlamegraphNode{}, children...)
+	)
+	for i := 0; i < len(worklist); i++ {
+		child := worklist[i]
+		k, meta := a.key(child)
+		if parent != nil && k == *parent {
+			// The child's values are already part of the parent's.
+			worklist = append(worklist, child.Children...)
+			continue
+		}
+
+		n, found := merged[k]
+		if !found {
+			n = &pb.FlamegraphNode{Meta: meta}
+			merged[k] = n
+			keys = append(keys, k)
+		}
+		n.Cumulative += child.Cumulative
+		n.Diff += child.Diff
+		pending[k] = append(pending[k], child.Children...)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if depth > a.height {
+		a.height = depth
+	}
+
+	sort.Strings(keys)
+	res := make([]*pb.FlamegraphNode, 0, len(keys))
+	for _, k := range keys {
+		k := k
+		n := merged[k]
+		n.Children = a.aggregate(pending[k], &k, depth+1)
+		res = append(res, n)
+	}
+	return res
+}
+
+// unsymbolizedKey keeps the unsymbolized nodes merged by address, like when
+// merging by function.
+func unsymbolizedKey(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta) {
+	return fmt.Sprintf("0x%x", n.Meta.GetLocation().GetAddress()), &pb.FlamegraphNodeMeta{
+		Location: n.Meta.GetLocation(),
+		Mapping:  n.Meta.GetMapping(),
+	}
+}
+
+func packageKey(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta) {
+	if n.Meta.GetFunction() == nil {
+		return unsymbolizedKey(n)
+	}
+
+	pkg := packageName(n.Meta.Function.Name)
+	return pkg, &pb.FlamegraphNodeMeta{
+		Function: &pb.Function{Name: pkg},
+	}
+}
+
+func filenameKey(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta) {
+	if n.Meta.GetFunction() == nil {
+		return unsymbolizedKey(n)
+	}
+
+	filename := n.Meta.Function.Filename
+	return filename, &pb.FlamegraphNodeMeta{
+		Function: &pb.Function{Name: filename, Filename: filename},
+	}
+}
+
+func mappingKey(n *pb.FlamegraphNode) (string, *pb.FlamegraphNodeMeta) {
+	m := n.Meta.GetMapping()
+	return m.GetFile(), &pb.FlamegraphNodeMeta{
+		Mapping: &pb.Mapping{
+			File:    m.GetFile(),
+			BuildId: m.GetBuildId(),
+		},
+	}
+}
+
+// packageName returns the package of a Go, Java or C++ function name, or the
+// name itself if it doesn't have one.
+func packageName(name string) string {
+	if i := strings.Index(name, "::"); i > 0 {
+		return name[:i]
+	}
+
+	slash := strings.LastIndex(name, "/")
+	if slash < 0 {
+		if m := javaPackageRegexp.FindStringSubmatch(name); m != nil {
+			return strings.TrimSuffix(m[1], ".")
+		}
+	}
+	if dot := strings.Index(name[slash+1:], "."); dot > 0 {
+		return name[:slash+1+dot]
+	}
+	return name
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+func TestPackageName(t *testing.T) {
+	for name, pkg := range map[string]string{
+		"main.main":                      "main",
+		"runtime.mallocgc":               "runtime",
+		"time.Time.String":               "time",
+		"sync.(*Mutex).Lock":             "sync",
+		"github.com/foo/bar.(*T).Method": "github.com/foo/bar",
+		"github.com/foo/bar.Func.func1":  "github.com/foo/bar",
+		"com.example.Foo.bar":            "com.example",
+		"std::vector<int>::push_back":    "std",
+		"malloc":                         "malloc",
+	} {
+		require.Equal(t, pkg, packageName(name), name)
+	}
+}
+
+// aggregatedStacks returns the cumulative values of the nodes of the flame
+// graph by their stack of keys.
+func aggregatedStacks(children []*pb.FlamegraphNode, stack string, key func(n *pb.FlamegraphNode) string, res map[string]int64) {
+	for _, child := range children {
+		name := key(child)
+		if stack != "" {
+			name = stack + ";" + name
+		}
+		res[name] = child.Cumulative
+		aggregatedStacks(child.Children, name, key, res)
+	}
+}
+
+func TestAggregateFlamegraph(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{3, 2, 1}))
+	pt.Insert(makeSample(3, []uint64{4, 2, 1}))
+	pt.Insert(makeSample(1, []uint64{5, 1}))
+	pt.Insert(makeSample(4, []uint64{6, 3, 2, 1}))
+
+	app := &profile.Mapping{ID: 1, File: "app"}
+	libc := &profile.Mapping{ID: 2, File: "libc.so"}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Mapping: app, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "main.main", Filename: "main.go"}}}},
+		2: {ID: 2, Mapping: app, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "main.run", Filename: "run.go"}}}},
+		3: {ID: 3, Mapping: app, Line: []profile.Line{{Function: &profile.Function{ID: 3, Name: "runtime.mallocgc", Filename: "malloc.go"}}}},
+		4: {ID: 4, Mapping: libc, Line: []profile.Line{{Function: &profile.Function{ID: 4, Name: "write", Filename: "write.c"}}}},
+		5: {ID: 5, Mapping: libc, Address: 0x5},
+		6: {ID: 6, Mapping: app, Line: []profile.Line{{Function: &profile.Function{ID: 5, Name: "main.hook", Filename: "main.go"}}}},
+	}}
+	p := &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Unit: "count"}}}
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	aggregate := func(by pb.FlamegraphOptions_AggregateBy, key func(n *pb.FlamegraphNode) string) (*pb.Flamegraph, map[string]int64) {
+		fg, err := GenerateFlamegraph(ctx, tracer, l, p)
+		require.NoError(t, err)
+
+		fg = AggregateFlamegraph(fg, by)
+		require.Equal(t, int64(10), fg.Total)
+		require.Equal(t, int64(10), fg.Root.Cumulative)
+
+		stacks := map[string]int64{}
+		aggregatedStacks(fg.Root.Children, "", key, stacks)
+		return fg, stacks
+	}
+	name := func(n *pb.FlamegraphNode) string {
+		if n.Meta.Function == nil {
+			return "unknown"
+		}
+		return n.Meta.Function.Name
+	}
+
+	// Consecutive frames of the main package are merged, main.hook called
+	// from the runtime isn't.
+	fg, stacks := aggregate(pb.FlamegraphOptions_AGGREGATE_BY_PACKAGE, name)
+	require.Equal(t, map[string]int64{
+		"main":              10,
+		"main;runtime":      6,
+		"main;runtime;main": 4,
+		"main;unknown":      1,
+		"main;write":        3,
+	}, stacks)
+	require.Equal(t, int32(4), fg.Height)
+
+	_, stacks = aggregate(pb.FlamegraphOptions_AGGREGATE_BY_FILENAME, name)
+	require.Equal(t, map[string]int64{
+		"main.go":                          10,
+		"main.go;run.go":                   9,
+		"main.go;run.go;malloc.go":         6,
+		"main.go;run.go;malloc.go;main.go": 4,
+		"main.go;run.go;write.c":           3,
+		"main.go;unknown":                  1,
+	}, stacks)
+
+	fg, stacks = aggregate(pb.FlamegraphOptions_AGGREGATE_BY_MAPPING, func(n *pb.FlamegraphNode) string {
+		return n.Meta.Mapping.File
+	})
+	require.Equal(t, map[string]int64{
+		"app":         10,
+		"app;libc.so": 4,
+	}, stacks)
+	require.Equal(t, int32(3), fg.Height)
+
+	// Merging by function leaves the graph as is.
+	fg, err := GenerateFlamegraph(ctx, tracer, l, p)
+	require.NoError(t, err)
+	require.Equal(t, fg, AggregateFlamegraph(fg, pb.FlamegraphOptions_AGGREGATE_BY_FUNCTION_UNSPECIFIED))
+	require.Equal(t, "main.main", fg.Root.Children[0].Meta.Function.Name)
+}
@@ -0,0 +1,230 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/pprof/profile"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// invertedKey identifies the children of a node of the inverted flame graph.
+// Like in the flame graph, symbolized frames are merged by function name and
+// unsymbolized ones by address.
+type invertedKey struct {
+	name       string
+	address    uint64
+	symbolized bool
+}
+
+// invertedNode is a node of the inverted flame graph being built.
+type invertedNode struct {
+	node     *pb.FlamegraphNode
+	children map[invertedKey]*invertedNode
+}
+
+// GenerateInvertedFlamegraph generates a bottom-up flame graph of the profile.
+// Its roots are the functions the samples were taken in and the children of
+// a node are its callers, each node has the flat values of the stacks it is
+// on from the leaf.
+func GenerateInvertedFlamegraph(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	p InstantProfile,
+) (*pb.Flamegraph, error) {
+	ctx, span := tracer.Start(ctx, "generate-inverted-flamegraph")
+	defer span.End()
+
+	meta := p.ProfileMeta()
+	pt := CopyInstantProfileTree(p.ProfileTree())
+	if pt == nil {
+		return nil, nil
+	}
+
+	locs, err := getLocations(ctx, tracer, locations, pt)
+	if err != nil {
+		return nil, fmt.Errorf("get locations: %w", err)
+	}
+
+	cumulative := pt.Roots.CumulativeValue()
+	flamegraph := &pb.Flamegraph{
+		Root: &pb.FlamegraphRootNode{
+			Cumulative: cumulative,
+			Diff:       pt.Roots.CumulativeDiffValue(),
+		},
+		Total:  cumulative,
+		Unit:   meta.SampleType.Unit,
+		Height: 1,
+	}
+
+	root := &invertedNode{node: &pb.FlamegraphNode{}}
+
+	// stack are the locations from the root to the current node.
+	var (
+		stack []*profile.Location
+		walk  func(n *ProfileTreeNode) error
+	)
+	walk = func(n *ProfileTreeNode) error {
+		l, found := locs[n.locationID]
+		if !found {
+			return fmt.Errorf("could not find location with ID %d", n.locationID)
+		}
+		stack = append(stack, l)
+
+		// Like in the flame graph, only positive values are kept.
+		if flat := sumValues(n.flatValues); flat > 0 {
+			depth := root.insert(stack, flat, sumValues(n.flatDiffValues))
+			if depth+1 > flamegraph.Height {
+				flamegraph.Height = depth + 1
+			}
+		}
+
+		for _, child := range n.Children {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		stack = stack[:len(stack)-1]
+		return nil
+	}
+	for _, child := range pt.Roots.Children {
+		if err := walk(child); err != nil {
+			return nil, err
+		}
+	}
+
+	flamegraph.Root.Children = root.build()
+
+	return flamegraph, nil
+}
+
+// insert adds the values along the frames of the stack of locations, given
+// from the root to the leaf, starting with the leaf's innermost frame.
+// It returns the number of frames of the stack.
+func (n *invertedNode) insert(stack []*profile.Location, value, diff int64) int32 {
+	depth := int32(0)
+	cur := n
+	for i := len(stack) - 1; i >= 0; i-- {
+		for _, meta := range locationToFrames(stack[i]) {
+			cur = cur.child(meta)
+			cur.node.Cumulative += value
+			cur.node.Diff += diff
+			depth++
+		}
+	}
+	return depth
+}
+
+// child returns the child of the node for the frame, adding it if it doesn't exist yet.
+func (n *invertedNode) child(meta *pb.FlamegraphNodeMeta) *invertedNode {
+	key := invertedKey{address: meta.Location.Address}
+	if meta.Function != nil {
+		key = invertedKey{name: meta.Function.Name, symbolized: true}
+	}
+
+	if n.children == nil {
+		n.children = map[invertedKey]*invertedNode{}
+	}
+	child, found := n.children[key]
+	if !found {
+		child = &invertedNode{node: &pb.FlamegraphNode{Meta: meta}}
+		n.children[key] = child
+		return child
+	}
+
+	// Merge the frame into the existing node the same way mergeChildren does.
+	child.node.Meta.Line = nil
+	if m := child.node.Meta.Mapping; m != nil && meta.Mapping != nil && m.Id != meta.Mapping.Id {
+		child.node.Meta.Mapping = &pb.Mapping{}
+	}
+	return child
+}
+
+// build returns the children of the node as flame graph nodes sorted by name.
+func (n *invertedNode) build() []*pb.FlamegraphNode {
+	if len(n.children) == 0 {
+		return nil
+	}
+
+	children := make([]*pb.FlamegraphNode, 0, len(n.children))
+	for _, child := range n.children {
+		child.node.Children = child.build()
+		children = append(children, child.node)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return compareByName(children[i], children[j])
+	})
+	return children
+}
+
+// locationToFrames returns the metadata of the frames of the location,
+// starting with the innermost inlined one.
+func locationToFrames(location *profile.Location) []*pb.FlamegraphNodeMeta {
+	mappingId := uint64(0)
+	var mapping *pb.Mapping
+	if location.Mapping != nil {
+		mappingId = location.Mapping.ID
+		mapping = &pb.Mapping{
+			Id:      location.Mapping.ID,
+			Start:   location.Mapping.Start,
+			Limit:   location.Mapping.Limit,
+			Offset:  location.Mapping.Offset,
+			File:    location.Mapping.File,
+			BuildId: location.Mapping.BuildID,
+		}
+	}
+
+	loc := func() *pb.Location {
+		return &pb.Location{
+			Id:        location.ID,
+			MappingId: mappingId,
+			Address:   location.Address,
+			IsFolded:  location.IsFolded,
+		}
+	}
+
+	if len(location.Line) == 0 {
+		return []*pb.FlamegraphNodeMeta{{
+			Location: loc(),
+			Mapping:  mapping,
+		}}
+	}
+
+	frames := make([]*pb.FlamegraphNodeMeta, 0, len(location.Line))
+	for _, line := range location.Line {
+		frames = append(frames, &pb.FlamegraphNodeMeta{
+			Location: loc(),
+			Function: &pb.Function{
+				Id:         line.Function.ID,
+				Name:       line.Function.Name,
+				SystemName: line.Function.SystemName,
+				Filename:   line.Function.Filename,
+				StartLine:  line.Function.StartLine,
+			},
+			Line: &pb.Line{
+				LocationId: location.ID,
+				FunctionId: line.Function.ID,
+				Line:       line.Line,
+			},
+			Mapping: mapping,
+		})
+	}
+	return frames
+}
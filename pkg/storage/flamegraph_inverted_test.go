@@ -0,0 +1,132 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// invertedStacks returns the cumulative and diff values of the nodes of the
+// flame graph by their stack of names, and checks the children of a node
+// don't add up to more than the node itself.
+func invertedStacks(t *testing.T, children []*pb.FlamegraphNode, stack string, res map[string][2]int64) {
+	for _, child := range children {
+		name := child.Meta.Function.Name
+		if stack != "" {
+			name = stack + ";" + name
+		}
+		res[name] = [2]int64{child.Cumulative, child.Diff}
+
+		cum, _ := sumChildren(child.Children)
+		require.LessOrEqual(t, cum, child.Cumulative, name)
+
+		invertedStacks(t, child.Children, name, res)
+	}
+}
+
+func TestGenerateInvertedFlamegraph(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{3, 1}))
+	pt.Insert(makeSample(3, []uint64{3, 2, 1}))
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+	pt.Insert(makeSample(4, []uint64{1}))
+
+	m := &profile.Mapping{ID: 1, File: "main"}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Mapping: m, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "main"}}}},
+		// inlined is inlined into b.
+		2: {ID: 2, Mapping: m, Line: []profile.Line{
+			{Function: &profile.Function{ID: 2, Name: "inlined"}},
+			{Function: &profile.Function{ID: 3, Name: "b"}},
+		}},
+		3: {ID: 3, Mapping: m, Line: []profile.Line{{Function: &profile.Function{ID: 4, Name: "c"}}}},
+	}}
+	p := &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Unit: "count"}}}
+
+	fg, err := GenerateInvertedFlamegraph(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(10), fg.Total)
+	require.Equal(t, int64(10), fg.Root.Cumulative)
+	require.Equal(t, "count", fg.Unit)
+	require.Equal(t, int32(5), fg.Height)
+
+	// The roots are sorted by name and add up to the total.
+	require.Len(t, fg.Root.Children, 3)
+	require.Equal(t, "c", fg.Root.Children[0].Meta.Function.Name)
+	require.Equal(t, "inlined", fg.Root.Children[1].Meta.Function.Name)
+	require.Equal(t, "main", fg.Root.Children[2].Meta.Function.Name)
+	cum, _ := sumChildren(fg.Root.Children)
+	require.Equal(t, fg.Total, cum)
+
+	stacks := map[string][2]int64{}
+	invertedStacks(t, fg.Root.Children, "", stacks)
+	require.Equal(t, map[string][2]int64{
+		"c":                {5, 0},
+		"c;main":           {2, 0},
+		"c;inlined":        {3, 0},
+		"c;inlined;b":      {3, 0},
+		"c;inlined;b;main": {3, 0},
+		"inlined":          {1, 0},
+		"inlined;b":        {1, 0},
+		"inlined;b;main":   {1, 0},
+		"main":             {4, 0},
+	}, stacks)
+}
+
+func TestGenerateInvertedFlamegraphDiff(t *testing.T) {
+	ctx := context.Background()
+
+	a := NewProfileTree()
+	a.Insert(makeSample(2, []uint64{2, 1}))
+	a.Insert(makeSample(1, []uint64{3, 1}))
+
+	b := NewProfileTree()
+	b.Insert(makeSample(1, []uint64{2, 1}))
+	b.Insert(makeSample(4, []uint64{3, 2, 1}))
+
+	p, err := NewDiffProfile(&Profile{Tree: a}, &Profile{Tree: b})
+	require.NoError(t, err)
+
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "1"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "2"}}}},
+		3: {ID: 3, Line: []profile.Line{{Function: &profile.Function{ID: 3, Name: "3"}}}},
+	}}
+
+	fg, err := GenerateInvertedFlamegraph(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(5), fg.Root.Cumulative)
+	require.Equal(t, int64(2), fg.Root.Diff)
+
+	stacks := map[string][2]int64{}
+	invertedStacks(t, fg.Root.Children, "", stacks)
+	require.Equal(t, map[string][2]int64{
+		"2":     {1, -1},
+		"2;1":   {1, -1},
+		"3":     {4, 4},
+		"3;2":   {4, 4},
+		"3;2;1": {4, 4},
+	}, stacks)
+}
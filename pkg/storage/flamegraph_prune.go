@@ -0,0 +1,114 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"container/heap"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// PruneFlamegraph prunes the nodes of the flame graph whose cumulative value
+// is below minFraction of the total and keeps at most maxNodes of the others,
+// preferring the ones with the largest cumulative values. A zero maxNodes or
+// minFraction doesn't limit anything.
+// The pruned children of a node are folded into a single other node, so that
+// the values of the remaining children still add up like before.
+func PruneFlamegraph(fg *pb.Flamegraph, maxNodes int, minFraction float64) *pb.Flamegraph {
+	if fg == nil || fg.Root == nil || (maxNodes <= 0 && minFraction <= 0) {
+		return fg
+	}
+
+	cutoff := float64(fg.Total) * minFraction
+	prunable := func(n *pb.FlamegraphNode) bool {
+		return float64(n.Cumulative) < cutoff
+	}
+
+	// A node is only kept once its parent is, so the kept nodes are
+	// always connected to the root.
+	kept := map[*pb.FlamegraphNode]bool{}
+	h := &flamegraphNodeHeap{}
+	for _, child := range fg.Root.Children {
+		if !prunable(child) {
+			heap.Push(h, child)
+		}
+	}
+	for h.Len() > 0 && (maxNodes <= 0 || len(kept) < maxNodes) {
+		n := heap.Pop(h).(*pb.FlamegraphNode)
+		kept[n] = true
+		for _, child := range n.Children {
+			if !prunable(child) {
+				heap.Push(h, child)
+			}
+		}
+	}
+
+	var (
+		height int32
+		prune  func(children []*pb.FlamegraphNode, depth int32) []*pb.FlamegraphNode
+	)
+	prune = func(children []*pb.FlamegraphNode, depth int32) []*pb.FlamegraphNode {
+		if len(children) == 0 {
+			return nil
+		}
+		if depth > height {
+			height = depth
+		}
+
+		var (
+			res   = make([]*pb.FlamegraphNode, 0, len(children))
+			other *pb.FlamegraphNode
+		)
+		for _, child := range children {
+			if kept[child] {
+				child.Children = prune(child.Children, depth+1)
+				res = append(res, child)
+				continue
+			}
+			if other == nil {
+				other = &pb.FlamegraphNode{Other: true}
+			}
+			other.Cumulative += child.Cumulative
+			other.Diff += child.Diff
+		}
+		if other != nil {
+			res = append(res, other)
+		}
+		return res
+	}
+	fg.Root.Children = prune(fg.Root.Children, 2)
+	if height > 0 {
+		fg.Height = height
+	}
+
+	return fg
+}
+
+// flamegraphNodeHeap is a max-heap of flame graph nodes by their cumulative values.
+type flamegraphNodeHeap []*pb.FlamegraphNode
+
+func (h flamegraphNodeHeap) Len() int           { return len(h) }
+func (h flamegraphNodeHeap) Less(i, j int) bool { return h[i].Cumulative > h[j].Cumulative }
+func (h flamegraphNodeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *flamegraphNodeHeap) Push(x interface{}) {
+	*h = append(*h, x.(*pb.FlamegraphNode))
+}
+
+func (h *flamegraphNodeHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
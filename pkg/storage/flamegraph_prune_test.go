@@ -0,0 +1,170 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+func sumChildren(children []*pb.FlamegraphNode) (cum, diff int64) {
+	for _, child := range children {
+		cum += child.Cumulative
+		diff += child.Diff
+	}
+	return cum, diff
+}
+
+// countFlamegraphNodes returns the number of nodes and other nodes of the graph.
+func countFlamegraphNodes(children []*pb.FlamegraphNode) (nodes, others int) {
+	for _, child := range children {
+		if child.Other {
+			others++
+		} else {
+			nodes++
+		}
+		n, o := countFlamegraphNodes(child.Children)
+		nodes += n
+		others += o
+	}
+	return nodes, others
+}
+
+func TestPruneFlamegraph(t *testing.T) {
+	leaf := func(name string, cum, diff int64) *pb.FlamegraphNode {
+		return &pb.FlamegraphNode{
+			Meta:       &pb.FlamegraphNodeMeta{Function: &pb.Function{Name: name}},
+			Cumulative: cum,
+			Diff:       diff,
+		}
+	}
+
+	fg := &pb.Flamegraph{
+		Total:  100,
+		Height: 3,
+		Root: &pb.FlamegraphRootNode{
+			Cumulative: 100,
+			Children: []*pb.FlamegraphNode{
+				{
+					Meta:       &pb.FlamegraphNodeMeta{Function: &pb.Function{Name: "a"}},
+					Cumulative: 90,
+					Children: []*pb.FlamegraphNode{
+						leaf("b", 50, 5),
+						leaf("c", 5, -2),
+						leaf("d", 4, 1),
+						leaf("e", 1, 0),
+					},
+				},
+				leaf("f", 6, 0),
+				leaf("g", 4, 3),
+			},
+		},
+	}
+
+	fg = PruneFlamegraph(fg, 3, 0.05)
+
+	require.Equal(t, int64(100), fg.Total)
+	require.Equal(t, int64(100), fg.Root.Cumulative)
+	require.Equal(t, int32(3), fg.Height)
+
+	root := fg.Root.Children
+	require.Len(t, root, 3)
+	require.Equal(t, "a", root[0].Meta.Function.Name)
+	require.Equal(t, "f", root[1].Meta.Function.Name)
+	require.Equal(t, &pb.FlamegraphNode{Other: true, Cumulative: 4, Diff: 3}, root[2])
+
+	// c is not below the min fraction but over the max number of nodes.
+	a := root[0].Children
+	require.Len(t, a, 2)
+	require.Equal(t, "b", a[0].Meta.Function.Name)
+	require.Equal(t, &pb.FlamegraphNode{Other: true, Cumulative: 10, Diff: -1}, a[1])
+
+	// Pruning all the children of the root lowers the height.
+	fg = PruneFlamegraph(fg, 0, 0.95)
+	require.Equal(t, []*pb.FlamegraphNode{{Other: true, Cumulative: 100, Diff: 3}}, fg.Root.Children)
+	require.Equal(t, int32(2), fg.Height)
+}
+
+func TestPruneFlamegraphFromProfileTree(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		maxNodes    int
+		minFraction float64
+	}{
+		{name: "max nodes", maxNodes: 20},
+		{name: "min fraction", minFraction: 0.05},
+		{name: "both", maxNodes: 50, minFraction: 0.001},
+		{name: "single node", maxNodes: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fg := testGenerateFlamegraphFromProfileTree(t)
+			total, rootCum, rootDiff := fg.Total, fg.Root.Cumulative, fg.Root.Diff
+			nodes, _ := countFlamegraphNodes(fg.Root.Children)
+
+			// The sums of the children of each node before pruning.
+			type sums struct{ cum, diff int64 }
+			before := map[*pb.FlamegraphNode]sums{}
+			var record func(n *pb.FlamegraphNode)
+			record = func(n *pb.FlamegraphNode) {
+				cum, diff := sumChildren(n.Children)
+				before[n] = sums{cum: cum, diff: diff}
+				for _, child := range n.Children {
+					record(child)
+				}
+			}
+			for _, child := range fg.Root.Children {
+				record(child)
+			}
+			rootChildrenCum, rootChildrenDiff := sumChildren(fg.Root.Children)
+
+			fg = PruneFlamegraph(fg, tc.maxNodes, tc.minFraction)
+
+			require.Equal(t, total, fg.Total)
+			require.Equal(t, rootCum, fg.Root.Cumulative)
+			require.Equal(t, rootDiff, fg.Root.Diff)
+
+			cum, diff := sumChildren(fg.Root.Children)
+			require.Equal(t, rootChildrenCum, cum)
+			require.Equal(t, rootChildrenDiff, diff)
+
+			var check func(n *pb.FlamegraphNode)
+			check = func(n *pb.FlamegraphNode) {
+				if n.Other {
+					require.Empty(t, n.Children)
+					return
+				}
+				if tc.minFraction > 0 {
+					require.GreaterOrEqual(t, float64(n.Cumulative), float64(total)*tc.minFraction)
+				}
+				cum, diff := sumChildren(n.Children)
+				require.Equal(t, before[n], sums{cum: cum, diff: diff})
+				for _, child := range n.Children {
+					check(child)
+				}
+			}
+			for _, child := range fg.Root.Children {
+				check(child)
+			}
+
+			pruned, _ := countFlamegraphNodes(fg.Root.Children)
+			require.Less(t, pruned, nodes)
+			if tc.maxNodes > 0 {
+				require.LessOrEqual(t, pruned, tc.maxNodes)
+			}
+		})
+	}
+}
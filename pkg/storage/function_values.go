@@ -0,0 +1,128 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// locationMatch is whether any of the lines of a location match, and whether
+// its innermost line does.
+type locationMatch struct {
+	any, innermost bool
+}
+
+// FunctionMatcher sums up the values of the functions matching a regular
+// expression in profiles. The locations are looked up once, so a matcher
+// can be reused for the profiles of a series.
+type FunctionMatcher struct {
+	locations Locations
+	re        *regexp.Regexp
+	matches   map[uint64]locationMatch
+}
+
+// NewFunctionMatcher returns a matcher of the functions whose names match the regular expression.
+func NewFunctionMatcher(locations Locations, re *regexp.Regexp) *FunctionMatcher {
+	return &FunctionMatcher{
+		locations: locations,
+		re:        re,
+		matches:   map[uint64]locationMatch{},
+	}
+}
+
+// Values returns the flat and cumulative values of the matching functions in
+// the profile. Like in the top table, the flat value is attributed to the
+// innermost function of a location, and a stack contributes to the
+// cumulative value only once even with multiple matching functions.
+func (m *FunctionMatcher) Values(ctx context.Context, p InstantProfile) (flat, cum int64, err error) {
+	pt := CopyInstantProfileTree(p.ProfileTree())
+	if pt == nil {
+		return 0, 0, nil
+	}
+
+	if err := m.matchLocations(ctx, pt); err != nil {
+		return 0, 0, err
+	}
+
+	var walk func(n *ProfileTreeNode, matched bool)
+	walk = func(n *ProfileTreeNode, matched bool) {
+		match := m.matches[n.locationID]
+		if match.any && !matched {
+			cum += n.CumulativeValue()
+			matched = true
+		}
+		if match.innermost {
+			flat += sumValues(n.flatValues)
+		}
+		for _, child := range n.Children {
+			walk(child, matched)
+		}
+	}
+	for _, child := range pt.Roots.Children {
+		walk(child, false)
+	}
+
+	return flat, cum, nil
+}
+
+// matchLocations looks up the locations of the tree that weren't seen yet.
+func (m *FunctionMatcher) matchLocations(ctx context.Context, pt *ProfileTree) error {
+	var (
+		ids  []uint64
+		seen = map[uint64]bool{}
+		walk func(n *ProfileTreeNode)
+	)
+	walk = func(n *ProfileTreeNode) {
+		if _, found := m.matches[n.locationID]; !found && !seen[n.locationID] {
+			seen[n.locationID] = true
+			ids = append(ids, n.locationID)
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	for _, child := range pt.Roots.Children {
+		walk(child)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	locs, err := m.locations.GetLocationsByIDs(ctx, ids...)
+	if err != nil {
+		return fmt.Errorf("get locations by ids: %w", err)
+	}
+
+	for _, id := range ids {
+		l, found := locs[id]
+		if !found {
+			return fmt.Errorf("could not find location with ID %d", id)
+		}
+
+		var match locationMatch
+		for i, line := range l.Line {
+			if line.Function != nil && m.re.MatchString(line.Function.Name) {
+				match.any = true
+				if i == 0 {
+					match.innermost = true
+				}
+			}
+		}
+		m.matches[id] = match
+	}
+
+	return nil
+}
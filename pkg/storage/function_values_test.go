@@ -0,0 +1,77 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLocations counts the locations looked up.
+type countingLocations struct {
+	fakeLocations
+	lookups int
+}
+
+func (l *countingLocations) GetLocationsByIDs(ctx context.Context, ids ...uint64) (map[uint64]*profile.Location, error) {
+	l.lookups += len(ids)
+	return l.fakeLocations.GetLocationsByIDs(ctx, ids...)
+}
+
+func TestFunctionMatcher(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(3, []uint64{3, 2, 1}))
+	pt.Insert(makeSample(1, []uint64{2, 3, 2, 1}))
+	pt.Insert(makeSample(4, []uint64{4, 1}))
+
+	l := &countingLocations{fakeLocations: fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "main.main"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "encoding/json.Marshal"}}}},
+		// Marshal is inlined into the location.
+		3: {ID: 3, Line: []profile.Line{
+			{Function: &profile.Function{ID: 3, Name: "encoding/json.(*encodeState).marshal"}},
+			{Function: &profile.Function{ID: 2, Name: "encoding/json.Marshal"}},
+		}},
+		4: {ID: 4},
+	}}}
+
+	m := NewFunctionMatcher(l, regexp.MustCompile(`^encoding/json\.Marshal$`))
+
+	// The recursive stack is only counted once.
+	flat, cum, err := m.Values(ctx, &Profile{Tree: pt})
+	require.NoError(t, err)
+	require.Equal(t, int64(2+1), flat)
+	require.Equal(t, int64(2+3+1), cum)
+	require.Equal(t, 4, l.lookups)
+
+	// The locations are only looked up once.
+	pt.Insert(makeSample(5, []uint64{3, 1}))
+	flat, cum, err = m.Values(ctx, &Profile{Tree: pt})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), flat)
+	require.Equal(t, int64(11), cum)
+	require.Equal(t, 4, l.lookups)
+
+	flat, cum, err = NewFunctionMatcher(l, regexp.MustCompile("marshal")).Values(ctx, &Profile{Tree: pt})
+	require.NoError(t, err)
+	require.Equal(t, int64(3+5), flat)
+	require.Equal(t, int64(3+1+5), cum)
+}
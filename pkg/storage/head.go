@@ -15,6 +15,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/parca-dev/parca/pkg/storage/index"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb/wal"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
@@ -42,6 +44,13 @@ type Head struct {
 
 	chunkPool ChunkPool
 
+	// wal persists all appended series and profiles, so the head can be
+	// restored after a restart. It is nil if the head is only kept in memory.
+	wal                   *wal.WAL
+	lastWALTruncationTime atomic.Int64
+	// walMtx prevents WAL segments from being truncated while they're read.
+	walMtx sync.Mutex
+
 	tracer              trace.Tracer
 	minTimeGauge        *prometheus.Desc
 	maxTimeGauge        *prometheus.Desc
@@ -52,6 +61,11 @@ type Head struct {
 	profilesAppended    prometheus.Counter
 	truncateDuration    prometheus.Summary
 	truncatedChunks     prometheus.Counter
+
+	checkpointCreationTotal prometheus.Counter
+	checkpointCreationFail  prometheus.Counter
+	walTruncateDuration     prometheus.Summary
+	walReplayDuration       prometheus.Gauge
 }
 
 // ChunkPool stores a set of temporary chunks that may be individually saved and retrieved.
@@ -66,6 +80,8 @@ type ChunkPool interface {
 type HeadOptions struct {
 	ChunkPool        ChunkPool
 	ExpensiveMetrics bool
+	// WAL to log appended series and profiles to. Leave nil to only keep the head in memory.
+	WAL *wal.WAL
 }
 
 func NewHead(r prometheus.Registerer, tracer trace.Tracer, opts *HeadOptions) *Head {
@@ -79,6 +95,7 @@ func NewHead(r prometheus.Registerer, tracer trace.Tracer, opts *HeadOptions) *H
 	h := &Head{
 		postings:  index.NewMemPostings(),
 		chunkPool: opts.ChunkPool,
+		wal:       opts.WAL,
 
 		tracer: tracer,
 		minTimeGauge: prometheus.NewDesc(
@@ -123,6 +140,22 @@ func NewHead(r prometheus.Registerer, tracer trace.Tracer, opts *HeadOptions) *H
 			Name: "parca_tsdb_head_truncated_chunks_total",
 			Help: "The total amount of truncated chunks over time.",
 		}),
+		checkpointCreationTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "parca_tsdb_checkpoint_creations_total",
+			Help: "Total number of checkpoint creations attempted.",
+		}),
+		checkpointCreationFail: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "parca_tsdb_checkpoint_creations_failed_total",
+			Help: "Total number of checkpoint creations that failed.",
+		}),
+		walTruncateDuration: prometheus.NewSummary(prometheus.SummaryOpts{
+			Name: "parca_tsdb_wal_truncate_duration_seconds",
+			Help: "Duration of WAL truncation.",
+		}),
+		walReplayDuration: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "parca_tsdb_wal_replay_duration_seconds",
+			Help: "Time taken to replay the WAL into the head on startup.",
+		}),
 	}
 
 	h.series = newStripeSeries(DefaultStripeSize, h.updateMaxTime)
@@ -134,6 +167,10 @@ func NewHead(r prometheus.Registerer, tracer trace.Tracer, opts *HeadOptions) *H
 		h.profilesAppended,
 		h.truncateDuration,
 		h.truncatedChunks,
+		h.checkpointCreationTotal,
+		h.checkpointCreationFail,
+		h.walTruncateDuration,
+		h.walReplayDuration,
 	)
 
 	h.minTime.Store(math.MaxInt64)
@@ -193,29 +230,33 @@ func (h *Head) updateMaxTime(t int64) {
 	}
 }
 
-func (h *Head) getOrCreate(ctx context.Context, lset labels.Labels) *MemSeries {
+func (h *Head) getOrCreate(ctx context.Context, lset labels.Labels) (*MemSeries, error) {
 	ctx, span := h.tracer.Start(ctx, "getOrCreate")
 	span.SetAttributes(attribute.String("labels", lset.String()))
 	defer span.End()
 
 	s := h.series.getByHash(lset.Hash(), lset)
-	if s != nil {
-		return s
-	}
+	if s == nil {
+		// Optimistically assume that we are the first one to create the series.
+		id := h.lastSeriesID.Inc()
 
-	// Optimistically assume that we are the first one to create the series.
-	id := h.lastSeriesID.Inc()
+		h.numSeries.Inc()
 
-	h.numSeries.Inc()
+		// Trace from the outside to not have to pass tracer into stripeSeries.
+		_, span = h.tracer.Start(ctx, "getOrCreateWithID")
+		s, _ = h.series.getOrCreateWithID(id, lset.Hash(), lset, h.chunkPool)
+		span.End()
 
-	// Trace from the outside to not have to pass tracer into stripeSeries.
-	_, span = h.tracer.Start(ctx, "getOrCreateWithID")
-	s, _ = h.series.getOrCreateWithID(id, lset.Hash(), lset, h.chunkPool)
-	span.End()
+		h.postings.Add(s.id, lset)
+	}
 
-	h.postings.Add(s.id, lset)
+	// The series needs to be in the WAL before any of its profiles.
+	// If logging it failed before, it's retried for the next appender.
+	if err := h.logSeries(s); err != nil {
+		return nil, fmt.Errorf("log series to WAL: %w", err)
+	}
 
-	return s
+	return s, nil
 }
 
 // Appender returns a new Appender on the database.
@@ -288,7 +329,18 @@ func (h *Head) stats() {
 
 // Truncate removes old data before mint from the head and WAL.
 func (h *Head) Truncate(mint int64) error {
-	return h.truncateMemory(mint)
+	if err := h.truncateMemory(mint); err != nil {
+		return err
+	}
+	return h.truncateWAL(mint)
+}
+
+// Close closes the head's WAL, if there is one.
+func (h *Head) Close() error {
+	if h.wal == nil {
+		return nil
+	}
+	return h.wal.Close()
 }
 
 func (h *Head) truncateMemory(mint int64) error {
@@ -313,11 +365,55 @@ func (h *Head) truncateMemory(mint int64) error {
 	return nil
 }
 
+// addLocationIDs adds the IDs of all locations referenced by the head's series to ids.
+func (h *Head) addLocationIDs(ids map[uint64]struct{}) {
+	for i := 0; i < h.series.size; i++ {
+		h.series.locks[i].RLock()
+		for _, s := range h.series.series[i] {
+			s.addLocationIDs(ids)
+		}
+		h.series.locks[i].RUnlock()
+	}
+}
+
 func (h *Head) appender(ctx context.Context, lset labels.Labels) (Appender, error) {
-	s := h.getOrCreate(ctx, lset)
+	s, err := h.getOrCreate(ctx, lset)
+	if err != nil {
+		return nil, err
+	}
 	s.tracer = h.tracer
 	s.samplesAppended = h.profilesAppended
-	return s.Appender()
+
+	app, err := s.Appender()
+	if err != nil {
+		return nil, err
+	}
+	if h.wal == nil {
+		return app, nil
+	}
+	return &headAppender{head: h, series: s, app: app}, nil
+}
+
+// headAppender logs every profile successfully appended to the series to the WAL.
+type headAppender struct {
+	head   *Head
+	series *MemSeries
+	app    Appender
+}
+
+func (a *headAppender) Append(ctx context.Context, p *Profile) error {
+	// Concurrent appenders must log the profiles of a series in the order
+	// they were appended, otherwise replaying them fails as out of order.
+	a.series.walMtx.Lock()
+	defer a.series.walMtx.Unlock()
+
+	if err := a.app.Append(ctx, p); err != nil {
+		return err
+	}
+	if err := a.head.logProfile(a.series, p); err != nil {
+		return fmt.Errorf("log profile to WAL: %w", err)
+	}
+	return nil
 }
 
 func (h *Head) Querier(ctx context.Context, mint, maxt int64) Querier {
@@ -405,15 +501,7 @@ func (q *HeadQuerier) Select(hints *SelectHints, ms ...*labels.Matcher) SeriesSe
 		if seriesMinTime > maxt {
 			continue
 		}
-		if hints != nil && hints.Merge {
-			ss = append(ss, &MemMergeSeries{s: s, mint: mint, maxt: maxt})
-			continue
-		}
-		if hints != nil && hints.Root {
-			ss = append(ss, &MemRootSeries{s: s, mint: mint, maxt: maxt})
-			continue
-		}
-		ss = append(ss, &MemRangeSeries{s: s, mint: mint, maxt: maxt})
+		ss = append(ss, seriesForHints(s, hints, mint, maxt))
 	}
 
 	return &SliceSeriesSet{
@@ -422,6 +510,29 @@ func (q *HeadQuerier) Select(hints *SelectHints, ms ...*labels.Matcher) SeriesSe
 	}
 }
 
+// seriesForHints wraps the MemSeries into the Series that reads what the hints ask for.
+func seriesForHints(s *MemSeries, hints *SelectHints, mint, maxt int64) Series {
+	if hints != nil && len(hints.SampleLabels) > 0 && !hints.Metadata {
+		// Only the whole trees have the labels of the samples, even the
+		// root's values need to be summed up from the matching samples.
+		var series Series = &MemRangeSeries{s: s, mint: mint, maxt: maxt}
+		if hints.Merge {
+			series = &MemMergeSeries{s: s, mint: mint, maxt: maxt}
+		}
+		return &sampleLabelsSeries{Series: series, matchers: hints.SampleLabels}
+	}
+	if hints != nil && hints.Merge {
+		return &MemMergeSeries{s: s, mint: mint, maxt: maxt}
+	}
+	if hints != nil && hints.Root {
+		return &MemRootSeries{s: s, mint: mint, maxt: maxt}
+	}
+	if hints != nil && hints.Metadata {
+		return &MemMetadataSeries{s: s, mint: mint, maxt: maxt}
+	}
+	return &MemRangeSeries{s: s, mint: mint, maxt: maxt}
+}
+
 const (
 	// DefaultStripeSize is the default number of entries to allocate in the stripeSeries hash map.
 	DefaultStripeSize = 1 << 10
@@ -17,7 +17,9 @@ import (
 	"context"
 	"errors"
 	"runtime"
+	"sort"
 
+	"github.com/prometheus/prometheus/pkg/labels"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/atomic"
@@ -98,6 +100,161 @@ func MergeSeriesSetProfiles(tracer trace.Tracer, ctx context.Context, set Series
 	)
 }
 
+// ProfileGroup is the merged profile of a group of series.
+type ProfileGroup struct {
+	// Labels are the labels the series were grouped by.
+	Labels  labels.Labels
+	Profile InstantProfile
+}
+
+// MergeSeriesSetProfilesByLabels merges the profiles of the series into a
+// profile per group of series with the same values for the groupBy labels.
+// The groups are merged concurrently by workers shared by all groups and
+// returned sorted by their labels, groups without any profile are left out.
+func MergeSeriesSetProfilesByLabels(tracer trace.Tracer, ctx context.Context, set SeriesSet, groupBy []string) ([]*ProfileGroup, error) {
+	ctx, span := tracer.Start(ctx, "MergeSeriesSetProfilesByLabels")
+	defer span.End()
+
+	type groupProfile struct {
+		key     string
+		profile InstantProfile
+	}
+
+	var (
+		groups      = map[string]*ProfileGroup{}
+		keys        []string
+		concurrency = runtime.NumCPU()
+		profileCh   = make(chan groupProfile)
+		// Every worker merges the profiles it receives into its own
+		// partial profile per group.
+		partials = make([]map[string]InstantProfile, concurrency)
+	)
+	g, gctx := errgroup.WithContext(ctx)
+
+	for i := range partials {
+		partial := map[string]InstantProfile{}
+		partials[i] = partial
+		g.Go(func() error {
+			for gp := range profileCh {
+				p, err := mergeCopy(partial[gp.key], gp.profile)
+				if err != nil {
+					return err
+				}
+				partial[gp.key] = p
+			}
+			return nil
+		})
+	}
+
+	_, seriesSpan := tracer.Start(ctx, "seriesIterate")
+	err := func() error {
+		defer close(profileCh)
+
+		for set.Next() {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			series := set.At()
+
+			ls := series.Labels().WithLabels(groupBy...)
+			key := ls.String()
+			if _, found := groups[key]; !found {
+				groups[key] = &ProfileGroup{Labels: ls}
+				keys = append(keys, key)
+			}
+
+			it := series.Iterator()
+			for it.Next() {
+				select {
+				// Have to copy as profile pointer is not stable for more than the
+				// current iteration.
+				case profileCh <- groupProfile{key: key, profile: CopyInstantProfile(it.At())}:
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+			}
+			if err := it.Err(); err != nil {
+				seriesSpan.RecordError(err)
+				return err
+			}
+		}
+		return set.Err()
+	}()
+	seriesSpan.End()
+
+	// A failed worker cancels the iteration, its error is the cause.
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The partial profiles of the groups are merged by as many workers.
+	keyCh := make(chan string)
+	g, gctx = errgroup.WithContext(ctx)
+	for i := 0; i < concurrency; i++ {
+		g.Go(func() error {
+			for key := range keyCh {
+				gr := groups[key]
+				for _, partial := range partials {
+					p, err := mergeCopy(gr.Profile, partial[key])
+					if err != nil {
+						return err
+					}
+					gr.Profile = p
+				}
+			}
+			return nil
+		})
+	}
+	err = func() error {
+		defer close(keyCh)
+
+		for _, key := range keys {
+			select {
+			case keyCh <- key:
+			case <-gctx.Done():
+				return gctx.Err()
+			}
+		}
+		return nil
+	}()
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	res := make([]*ProfileGroup, 0, len(keys))
+	for _, key := range keys {
+		if gr := groups[key]; gr.Profile != nil {
+			res = append(res, gr)
+		}
+	}
+
+	return res, nil
+}
+
+// mergeCopy returns a copy of the merge of the profiles, so that merging
+// into the result again doesn't nest the merges. A missing profile is not
+// merged.
+func mergeCopy(a, b InstantProfile) (InstantProfile, error) {
+	if a == nil {
+		return b, nil
+	}
+	if b == nil {
+		return a, nil
+	}
+	m, err := NewMergeProfile(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return CopyInstantProfile(m), nil
+}
+
 func MergeProfilesConcurrent(
 	tracer trace.Tracer,
 	ctx context.Context,
@@ -587,31 +744,25 @@ func (i *MergeProfileTreeIterator) StepUp() {
 }
 
 func MergeInstantProfileTreeNodes(a, b InstantProfileTreeNode) InstantProfileTreeNode {
+	// The flat values of samples with different labels are summed up as well.
 	var flatValues []*ProfileTreeValueNode
 	flatA := a.FlatValues()
 	if len(flatA) > 0 {
-		flatValues = append(flatValues, &ProfileTreeValueNode{Value: flatA[0].Value})
+		flatValues = append(flatValues, &ProfileTreeValueNode{Value: sumValues(flatA)})
 	}
 
 	flatB := b.FlatValues()
 	if len(flatB) > 0 {
 		if len(flatValues) > 0 {
-			flatValues[0].Value += flatB[0].Value
+			flatValues[0].Value += sumValues(flatB)
 		} else {
-			flatValues = append(flatValues, &ProfileTreeValueNode{Value: flatB[0].Value})
+			flatValues = append(flatValues, &ProfileTreeValueNode{Value: sumValues(flatB)})
 		}
 	}
 
-	cumulativeValues := []*ProfileTreeValueNode{{}}
-	cumulativeA := a.CumulativeValues()
-	if len(cumulativeA) > 0 {
-		cumulativeValues[0].Value += cumulativeA[0].Value
-	}
-
-	cumulativeB := b.CumulativeValues()
-	if len(cumulativeB) > 0 {
-		cumulativeValues[0].Value += cumulativeB[0].Value
-	}
+	cumulativeValues := []*ProfileTreeValueNode{{
+		Value: sumValues(a.CumulativeValues()) + sumValues(b.CumulativeValues()),
+	}}
 
 	return &ProfileTreeNode{
 		locationID:       a.LocationID(),
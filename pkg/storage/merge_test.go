@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"runtime"
 	"testing"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/google/pprof/profile"
 	"github.com/parca-dev/parca/pkg/storage/metastore"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -498,6 +500,90 @@ func TestMergeMany(t *testing.T) {
 	CopyInstantProfileTree(m.ProfileTree())
 }
 
+// sliceSeries is a series of the given profiles.
+type sliceSeries struct {
+	labels   labels.Labels
+	profiles []InstantProfile
+}
+
+func (s *sliceSeries) Labels() labels.Labels { return s.labels }
+
+func (s *sliceSeries) Iterator() ProfileSeriesIterator {
+	return &SliceProfileSeriesIterator{samples: s.profiles, i: -1}
+}
+
+func TestMergeSeriesSetProfilesByLabels(t *testing.T) {
+	profile := func(value int64) InstantProfile {
+		pt := NewProfileTree()
+		pt.Insert(makeSample(value, []uint64{2, 1}))
+		return &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Type: "alloc_space", Unit: "bytes"}}}
+	}
+
+	set := &SliceSeriesSet{
+		series: []Series{
+			&sliceSeries{
+				labels:   labels.FromStrings("pod", "a", "version", "canary"),
+				profiles: []InstantProfile{profile(1), profile(2)},
+			},
+			&sliceSeries{
+				labels:   labels.FromStrings("pod", "b", "version", "stable"),
+				profiles: []InstantProfile{profile(3)},
+			},
+			&sliceSeries{
+				labels:   labels.FromStrings("pod", "c", "version", "stable"),
+				profiles: []InstantProfile{profile(4), profile(5)},
+			},
+			// Groups without any profile are left out.
+			&sliceSeries{
+				labels: labels.FromStrings("pod", "d", "version", "old"),
+			},
+		},
+		i: -1,
+	}
+
+	groups, err := MergeSeriesSetProfilesByLabels(trace.NewNoopTracerProvider().Tracer(""), context.Background(), set, []string{"version"})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	require.Equal(t, labels.FromStrings("version", "canary"), groups[0].Labels)
+	require.Equal(t, int64(3), CopyInstantProfileTree(groups[0].Profile.ProfileTree()).Roots.CumulativeValue())
+
+	require.Equal(t, labels.FromStrings("version", "stable"), groups[1].Labels)
+	require.Equal(t, int64(12), CopyInstantProfileTree(groups[1].Profile.ProfileTree()).Roots.CumulativeValue())
+}
+
+func TestMergeSeriesSetProfilesByLabelsManyGroups(t *testing.T) {
+	profile := func(value int64) InstantProfile {
+		pt := NewProfileTree()
+		pt.Insert(makeSample(value, []uint64{2, 1}))
+		return &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Type: "alloc_space", Unit: "bytes"}}}
+	}
+
+	// There are more groups than merge workers.
+	n := 4 * runtime.NumCPU()
+	series := make([]Series, 0, n)
+	for i := 0; i < n; i++ {
+		series = append(series, &sliceSeries{
+			labels:   labels.FromStrings("pod", fmt.Sprintf("%03d", i)),
+			profiles: []InstantProfile{profile(int64(i)), profile(1), profile(2)},
+		})
+	}
+
+	groups, err := MergeSeriesSetProfilesByLabels(trace.NewNoopTracerProvider().Tracer(""), context.Background(), &SliceSeriesSet{series: series, i: -1}, []string{"pod"})
+	require.NoError(t, err)
+	require.Len(t, groups, n)
+	for i, gr := range groups {
+		require.Equal(t, labels.FromStrings("pod", fmt.Sprintf("%03d", i)), gr.Labels)
+		require.Equal(t, int64(i+3), CopyInstantProfileTree(gr.Profile.ProfileTree()).Roots.CumulativeValue())
+	}
+
+	// Canceling the merge returns the error of the context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = MergeSeriesSetProfilesByLabels(trace.NewNoopTracerProvider().Tracer(""), ctx, &SliceSeriesSet{series: series, i: -1}, []string{"pod"})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 type sample struct {
 	id             uint64
 	flat           []*ProfileTreeValueNode
@@ -411,3 +411,67 @@ func (c *metaStoreCache) getLocationLinesByID(ctx context.Context, locationID ui
 	c.metrics.locationLinesIdHits.Inc()
 	return v, true, nil
 }
+
+// deleteLocations evicts the locations and their lines from the cache.
+func (c *metaStoreCache) deleteLocations(ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+
+	c.locationsMtx.Lock()
+	for _, id := range ids {
+		if l, found := c.locationsByID[id]; found {
+			if keyID, found := c.locationsByKey[l.LocationKey]; found && keyID == id {
+				delete(c.locationsByKey, l.LocationKey)
+			}
+			delete(c.locationsByID, id)
+		}
+	}
+	c.locationsMtx.Unlock()
+
+	c.locationLinesMtx.Lock()
+	for _, id := range ids {
+		delete(c.locationLinesByID, id)
+	}
+	c.locationLinesMtx.Unlock()
+}
+
+// deleteFunctions evicts the functions from the cache.
+func (c *metaStoreCache) deleteFunctions(ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+
+	c.functionsMtx.Lock()
+	defer c.functionsMtx.Unlock()
+
+	for _, id := range ids {
+		if fn, found := c.functionsByID[id]; found {
+			k := MakeFunctionKey(&fn)
+			if keyID, found := c.functionsByKey[k]; found && keyID == id {
+				delete(c.functionsByKey, k)
+			}
+			delete(c.functionsByID, id)
+		}
+	}
+}
+
+// deleteMappings evicts the mappings from the cache.
+func (c *metaStoreCache) deleteMappings(ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+
+	c.mappingsMtx.Lock()
+	defer c.mappingsMtx.Unlock()
+
+	for _, id := range ids {
+		if m, found := c.mappingsByID[id]; found {
+			k := MakeMappingKey(&m)
+			if keyID, found := c.mappingsByKey[k]; found && keyID == id {
+				delete(c.mappingsByKey, k)
+			}
+			delete(c.mappingsByID, id)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// gcBatchSize is the maximum number of IDs deleted by a single statement.
+const gcBatchSize = 1000
+
+type gcMetrics struct {
+	locationsDeleted prometheus.Counter
+	linesDeleted     prometheus.Counter
+	functionsDeleted prometheus.Counter
+	mappingsDeleted  prometheus.Counter
+
+	duration prometheus.Histogram
+}
+
+func newGCMetrics(reg prometheus.Registerer) *gcMetrics {
+	deleted := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "parca_metastore_gc_deleted_total",
+			Help: "Number of unreferenced items deleted by the metastore's garbage collection.",
+		},
+		[]string{"item_type"},
+	)
+	duration := prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "parca_metastore_gc_duration_seconds",
+			Help:    "Duration of the metastore's garbage collections.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+		},
+	)
+
+	m := &gcMetrics{
+		locationsDeleted: deleted.WithLabelValues("location"),
+		linesDeleted:     deleted.WithLabelValues("line"),
+		functionsDeleted: deleted.WithLabelValues("function"),
+		mappingsDeleted:  deleted.WithLabelValues("mapping"),
+
+		duration: duration,
+	}
+
+	if reg != nil {
+		reg.MustRegister(deleted)
+		reg.MustRegister(duration)
+	}
+
+	return m
+}
+
+// gcCandidates are the IDs found to be unreferenced by the previous garbage collection.
+// Only IDs that are unreferenced in two consecutive collections are deleted, so
+// that items which are created while a collection runs, but aren't referenced
+// yet, survive it. IDs returned by lookups are about to be referenced again,
+// so they are kept from being deleted by the next collection.
+type gcCandidates struct {
+	// mtx is held for writing by collections and for reading by lookups,
+	// so that an ID isn't deleted between being looked up and being kept.
+	mtx sync.RWMutex
+	// keepMtx serializes concurrent lookups keeping IDs.
+	keepMtx   sync.Mutex
+	locations map[uint64]struct{}
+	functions map[uint64]struct{}
+	mappings  map[uint64]struct{}
+}
+
+// keep removes the ID from the candidates. The read lock of mtx must be held.
+func (c *gcCandidates) keep(candidates map[uint64]struct{}, id uint64) {
+	c.keepMtx.Lock()
+	defer c.keepMtx.Unlock()
+	delete(candidates, id)
+}
+
+// sweep returns the unreferenced IDs that were candidates already,
+// and the IDs that are candidates of the next collection.
+func sweep(candidates map[uint64]struct{}, unreferenced []uint64) ([]uint64, map[uint64]struct{}) {
+	deletable := []uint64{}
+	next := make(map[uint64]struct{}, len(unreferenced))
+	for _, id := range unreferenced {
+		if _, ok := candidates[id]; ok {
+			deletable = append(deletable, id)
+			continue
+		}
+		next[id] = struct{}{}
+	}
+	return deletable, next
+}
+
+// GarbageCollect deletes the locations that aren't referenced anymore, their
+// lines and the functions and mappings no other location references.
+func (s *sqlMetaStore) GarbageCollect(ctx context.Context, referencedLocationIDs map[uint64]struct{}) error {
+	ctx, span := s.tracer.Start(ctx, "GarbageCollect")
+	defer span.End()
+
+	start := time.Now()
+	defer func() {
+		s.gcMetrics.duration.Observe(time.Since(start).Seconds())
+	}()
+
+	s.gcCandidates.mtx.Lock()
+	defer s.gcCandidates.mtx.Unlock()
+
+	ids, err := s.queryIDs(ctx, `SELECT "id" FROM "locations"`)
+	if err != nil {
+		return fmt.Errorf("get location IDs: %w", err)
+	}
+	unreferenced := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := referencedLocationIDs[id]; !ok {
+			unreferenced = append(unreferenced, id)
+		}
+	}
+
+	locations, nextLocations := sweep(s.gcCandidates.locations, unreferenced)
+	span.SetAttributes(attribute.Int("locations-deleted", len(locations)))
+
+	// A location's lines need to be deleted before the location itself,
+	// as they reference it.
+	lines, err := s.deleteByIDs(ctx, `DELETE FROM "lines" WHERE "location_id" IN `, locations)
+	if err != nil {
+		return fmt.Errorf("delete lines: %w", err)
+	}
+	if _, err := s.deleteByIDs(ctx, `DELETE FROM "locations" WHERE "id" IN `, locations); err != nil {
+		return fmt.Errorf("delete locations: %w", err)
+	}
+	s.cache.deleteLocations(locations)
+	s.gcCandidates.locations = nextLocations
+	s.gcMetrics.linesDeleted.Add(float64(lines))
+	s.gcMetrics.locationsDeleted.Add(float64(len(locations)))
+
+	unreferenced, err = s.queryIDs(ctx, `SELECT "id" FROM "functions" WHERE "id" NOT IN (SELECT "function_id" FROM "lines")`)
+	if err != nil {
+		return fmt.Errorf("get unreferenced function IDs: %w", err)
+	}
+	functions, nextFunctions := sweep(s.gcCandidates.functions, unreferenced)
+	if _, err := s.deleteByIDs(ctx, `DELETE FROM "functions" WHERE "id" IN `, functions); err != nil {
+		return fmt.Errorf("delete functions: %w", err)
+	}
+	s.cache.deleteFunctions(functions)
+	s.gcCandidates.functions = nextFunctions
+	s.gcMetrics.functionsDeleted.Add(float64(len(functions)))
+
+	unreferenced, err = s.queryIDs(ctx, `SELECT "id" FROM "mappings" WHERE "id" NOT IN (SELECT "mapping_id" FROM "locations" WHERE "mapping_id" IS NOT NULL)`)
+	if err != nil {
+		return fmt.Errorf("get unreferenced mapping IDs: %w", err)
+	}
+	mappings, nextMappings := sweep(s.gcCandidates.mappings, unreferenced)
+	if _, err := s.deleteByIDs(ctx, `DELETE FROM "mappings" WHERE "id" IN `, mappings); err != nil {
+		return fmt.Errorf("delete mappings: %w", err)
+	}
+	s.cache.deleteMappings(mappings)
+	s.gcCandidates.mappings = nextMappings
+	s.gcMetrics.mappingsDeleted.Add(float64(len(mappings)))
+
+	return nil
+}
+
+func (s *sqlMetaStore) queryIDs(ctx context.Context, query string) ([]uint64, error) {
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []uint64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, uint64(id))
+	}
+	return ids, rows.Err()
+}
+
+// deleteByIDs runs the statement, which has to end with "IN ", for the IDs
+// in batches and returns the number of deleted rows.
+func (s *sqlMetaStore) deleteByIDs(ctx context.Context, stmt string, ids []uint64) (int64, error) {
+	var deleted int64
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > gcBatchSize {
+			n = gcBatchSize
+		}
+
+		strIDs := make([]string, 0, n)
+		for _, id := range ids[:n] {
+			strIDs = append(strIDs, strconv.FormatUint(id, 10))
+		}
+		ids = ids[n:]
+
+		res, err := s.db.ExecContext(ctx, stmt+"("+strings.Join(strIDs, ",")+")")
+		if err != nil {
+			return deleted, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += affected
+	}
+	return deleted, nil
+}
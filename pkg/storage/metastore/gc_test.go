@@ -0,0 +1,163 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGarbageCollect(t *testing.T) {
+	s, err := NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"garbagecollect",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	ctx := context.Background()
+
+	m1 := &profile.Mapping{Start: 1, Limit: 10, File: "file1", BuildID: "buildID1"}
+	m1.ID, err = s.CreateMapping(ctx, m1)
+	require.NoError(t, err)
+	m2 := &profile.Mapping{Start: 1, Limit: 10, File: "file2", BuildID: "buildID2"}
+	m2.ID, err = s.CreateMapping(ctx, m2)
+	require.NoError(t, err)
+
+	f1 := &profile.Function{Name: "f1", SystemName: "f1", Filename: "file1", StartLine: 1}
+	f2 := &profile.Function{Name: "f2", SystemName: "f2", Filename: "file2", StartLine: 2}
+
+	l1 := &profile.Location{Address: 1, Mapping: m1, Line: []profile.Line{{Line: 1, Function: f1}}}
+	l1.ID, err = s.CreateLocation(ctx, l1)
+	require.NoError(t, err)
+	l2 := &profile.Location{Address: 2, Mapping: m2, Line: []profile.Line{{Line: 2, Function: f2}}}
+	l2.ID, err = s.CreateLocation(ctx, l2)
+	require.NoError(t, err)
+
+	// Populate the caches.
+	_, err = s.GetLocationByKey(ctx, MakeLocationKey(l2))
+	require.NoError(t, err)
+	_, err = s.GetMappingByKey(ctx, MakeMappingKey(m2))
+	require.NoError(t, err)
+	_, err = s.GetFunctionByKey(ctx, MakeFunctionKey(f2))
+	require.NoError(t, err)
+
+	referenced := map[uint64]struct{}{l1.ID: {}}
+
+	// The first collection only marks l2 as unreferenced.
+	require.NoError(t, s.GarbageCollect(ctx, referenced))
+	locs, err := s.GetLocationsByIDs(ctx, l1.ID, l2.ID)
+	require.NoError(t, err)
+	require.Len(t, locs, 2)
+
+	// The second collection deletes l2 and its lines,
+	// which leaves f2 and m2 unreferenced.
+	require.NoError(t, s.GarbageCollect(ctx, referenced))
+	locs, err = s.GetLocationsByIDs(ctx, l1.ID, l2.ID)
+	require.NoError(t, err)
+	require.Len(t, locs, 1)
+	require.Equal(t, l1.Address, locs[l1.ID].Address)
+	_, err = s.GetLocationByKey(ctx, MakeLocationKey(l2))
+	require.Equal(t, ErrLocationNotFound, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(s.gcMetrics.locationsDeleted))
+	require.Equal(t, 1.0, testutil.ToFloat64(s.gcMetrics.linesDeleted))
+
+	// Looking f2 up would keep it, so the functions are listed instead.
+	fns, err := s.GetFunctions(ctx)
+	require.NoError(t, err)
+	require.Len(t, fns, 2)
+
+	// f2 and m2 are deleted after being unreferenced for two collections.
+	require.NoError(t, s.GarbageCollect(ctx, referenced))
+	_, err = s.GetFunctionByKey(ctx, MakeFunctionKey(f2))
+	require.Equal(t, ErrFunctionNotFound, err)
+	_, err = s.GetMappingByKey(ctx, MakeMappingKey(m2))
+	require.Equal(t, ErrMappingNotFound, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(s.gcMetrics.functionsDeleted))
+	require.Equal(t, 1.0, testutil.ToFloat64(s.gcMetrics.mappingsDeleted))
+
+	// Everything l1 references is kept.
+	_, err = s.GetFunctionByKey(ctx, MakeFunctionKey(f1))
+	require.NoError(t, err)
+	_, err = s.GetMappingByKey(ctx, MakeMappingKey(m1))
+	require.NoError(t, err)
+
+	// Locations that were referenced in between aren't deleted.
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+	require.NoError(t, s.GarbageCollect(ctx, referenced))
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+	locs, err = s.GetLocationsByIDs(ctx, l1.ID)
+	require.NoError(t, err)
+	require.Len(t, locs, 1)
+}
+
+func TestGarbageCollectReusedCandidates(t *testing.T) {
+	s, err := NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		"garbagecollectreused",
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	ctx := context.Background()
+
+	m := &profile.Mapping{Start: 1, Limit: 10, File: "file", BuildID: "buildID"}
+	m.ID, err = s.CreateMapping(ctx, m)
+	require.NoError(t, err)
+	f := &profile.Function{Name: "f", SystemName: "f", Filename: "file", StartLine: 1}
+	l := &profile.Location{Address: 1, Mapping: m, Line: []profile.Line{{Line: 1, Function: f}}}
+	l.ID, err = s.CreateLocation(ctx, l)
+	require.NoError(t, err)
+	unused := &profile.Mapping{Start: 1, Limit: 10, File: "unused", BuildID: "unused"}
+	unused.ID, err = s.CreateMapping(ctx, unused)
+	require.NoError(t, err)
+
+	// The first collection marks the location and the unused mapping as candidates.
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+
+	// Ingestion reuses the location and the mapping, but they aren't
+	// referenced yet when the second collection runs.
+	_, err = s.GetLocationByKey(ctx, MakeLocationKey(l))
+	require.NoError(t, err)
+	_, err = s.GetMappingByKey(ctx, MakeMappingKey(unused))
+	require.NoError(t, err)
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+
+	locs, err := s.GetLocationsByIDs(ctx, l.ID)
+	require.NoError(t, err)
+	require.Len(t, locs, 1)
+	_, err = s.GetMappingByKey(ctx, MakeMappingKey(unused))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, testutil.ToFloat64(s.gcMetrics.locationsDeleted))
+	require.Equal(t, 0.0, testutil.ToFloat64(s.gcMetrics.mappingsDeleted))
+
+	// Once they aren't looked up anymore they are deleted after two collections.
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+	require.NoError(t, s.GarbageCollect(ctx, map[uint64]struct{}{}))
+	_, err = s.GetLocationByKey(ctx, MakeLocationKey(l))
+	require.Equal(t, ErrLocationNotFound, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(s.gcMetrics.locationsDeleted))
+}
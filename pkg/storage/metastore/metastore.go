@@ -36,6 +36,13 @@ type ProfileMetaStore interface {
 	Ping() error
 }
 
+// GarbageCollector is implemented by the metastores that can delete the
+// locations no stored profile references anymore, together with their lines
+// and the functions and mappings only they referenced.
+type GarbageCollector interface {
+	GarbageCollect(ctx context.Context, referencedLocationIDs map[uint64]struct{}) error
+}
+
 type LocationStore interface {
 	GetLocationByKey(ctx context.Context, k LocationKey) (*profile.Location, error)
 	GetLocationsByIDs(ctx context.Context, id ...uint64) (map[uint64]*profile.Location, error)
@@ -47,6 +47,8 @@ func NewDiskProfileMetaStore(
 		db:     db,
 		tracer: tracer,
 		cache:  newMetaStoreCache(reg),
+
+		gcMetrics: newGCMetrics(reg),
 	}
 	if err := sqlite.migrate(); err != nil {
 		return nil, fmt.Errorf("migrations failed: %w", err)
@@ -13,12 +13,174 @@
 
 package metastore
 
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/metastore/v1alpha1"
+)
+
 var _ ProfileMetaStore = &RemoteMetaStore{}
 
+// RemoteMetaStore is a ProfileMetaStore backed by the MetastoreService of
+// another Parca process. All replicas using the same remote metastore share
+// one ID space for locations, functions and mappings.
 type RemoteMetaStore struct {
-	*sqlMetaStore
+	conn *grpc.ClientConn
+	c    pb.MetastoreServiceClient
+}
+
+// NewRemoteProfileMetaStore connects to the MetastoreService served at addr.
+func NewRemoteProfileMetaStore(addr string, opts ...grpc.DialOption) (*RemoteMetaStore, error) {
+	conn, err := grpc.Dial(addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial metastore %s: %w", addr, err)
+	}
+
+	return &RemoteMetaStore{
+		conn: conn,
+		c:    pb.NewMetastoreServiceClient(conn),
+	}, nil
+}
+
+func (s *RemoteMetaStore) GetLocationByKey(ctx context.Context, k LocationKey) (*profile.Location, error) {
+	res, err := s.c.GetLocationByKey(ctx, &pb.GetLocationByKeyRequest{
+		Key: &pb.LocationKey{
+			NormalizedAddress: k.NormalizedAddress,
+			MappingId:         k.MappingID,
+			Lines:             k.Lines,
+			IsFolded:          k.IsFolded,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrLocationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get location by key: %w", err)
+	}
+
+	return locationFromProto(res.Location), nil
+}
+
+func (s *RemoteMetaStore) GetLocationsByIDs(ctx context.Context, ids ...uint64) (map[uint64]*profile.Location, error) {
+	res, err := s.c.GetLocationsByIDs(ctx, &pb.GetLocationsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("get locations by IDs: %w", err)
+	}
+
+	locs := make(map[uint64]*profile.Location, len(res.Locations))
+	for _, l := range res.Locations {
+		locs[l.Id] = locationFromProto(l)
+	}
+
+	return locs, nil
+}
+
+func (s *RemoteMetaStore) CreateLocation(ctx context.Context, l *profile.Location) (uint64, error) {
+	res, err := s.c.CreateLocation(ctx, &pb.CreateLocationRequest{Location: locationToProto(l)})
+	if err != nil {
+		return 0, fmt.Errorf("create location: %w", err)
+	}
+
+	return res.Id, nil
 }
 
-func NewRemoteProfileMetaStore(addr string) (*RemoteMetaStore, error) {
-	panic("implement me")
+func (s *RemoteMetaStore) Symbolize(ctx context.Context, l *profile.Location) error {
+	if _, err := s.c.Symbolize(ctx, &pb.SymbolizeRequest{Location: locationToProto(l)}); err != nil {
+		return fmt.Errorf("symbolize: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RemoteMetaStore) GetSymbolizableLocations(ctx context.Context) ([]*profile.Location, error) {
+	res, err := s.c.GetSymbolizableLocations(ctx, &pb.GetSymbolizableLocationsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get symbolizable locations: %w", err)
+	}
+
+	locs := make([]*profile.Location, 0, len(res.Locations))
+	for _, l := range res.Locations {
+		locs = append(locs, locationFromProto(l))
+	}
+
+	return locs, nil
+}
+
+func (s *RemoteMetaStore) GetFunctionByKey(ctx context.Context, k FunctionKey) (*profile.Function, error) {
+	res, err := s.c.GetFunctionByKey(ctx, &pb.GetFunctionByKeyRequest{
+		Key: &pb.FunctionKey{
+			StartLine:  k.StartLine,
+			Name:       k.Name,
+			SystemName: k.SystemName,
+			Filename:   k.FileName,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrFunctionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get function by key: %w", err)
+	}
+
+	return functionFromProto(res.Function), nil
+}
+
+func (s *RemoteMetaStore) CreateFunction(ctx context.Context, fn *profile.Function) (uint64, error) {
+	res, err := s.c.CreateFunction(ctx, &pb.CreateFunctionRequest{Function: functionToProto(fn)})
+	if err != nil {
+		return 0, fmt.Errorf("create function: %w", err)
+	}
+
+	return res.Id, nil
+}
+
+func (s *RemoteMetaStore) GetMappingByKey(ctx context.Context, k MappingKey) (*profile.Mapping, error) {
+	res, err := s.c.GetMappingByKey(ctx, &pb.GetMappingByKeyRequest{
+		Key: &pb.MappingKey{
+			Size:          k.Size,
+			Offset:        k.Offset,
+			BuildIdOrFile: k.BuildIDOrFile,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrMappingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get mapping by key: %w", err)
+	}
+
+	return mappingFromProto(res.Mapping), nil
+}
+
+func (s *RemoteMetaStore) CreateMapping(ctx context.Context, m *profile.Mapping) (uint64, error) {
+	res, err := s.c.CreateMapping(ctx, &pb.CreateMappingRequest{Mapping: mappingToProto(m)})
+	if err != nil {
+		return 0, fmt.Errorf("create mapping: %w", err)
+	}
+
+	return res.Id, nil
+}
+
+func (s *RemoteMetaStore) Close() error {
+	return s.conn.Close()
+}
+
+// Ping checks that the remote metastore is reachable by issuing a request
+// that does not touch any data.
+func (s *RemoteMetaStore) Ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+	defer cancel()
+
+	if _, err := s.c.GetLocationsByIDs(ctx, &pb.GetLocationsByIDsRequest{}); err != nil {
+		return fmt.Errorf("ping metastore: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,134 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/metastore/v1alpha1"
+)
+
+func newTestRemoteMetaStore(t *testing.T, name string) *RemoteMetaStore {
+	s, err := NewInMemorySQLiteProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		name,
+	)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterMetastoreServiceServer(srv, NewMetastoreService(s))
+	go srv.Serve(lis)
+
+	r, err := NewRemoteProfileMetaStore(
+		"bufconn",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		r.Close()
+		srv.Stop()
+		s.Close()
+	})
+
+	return r
+}
+
+func TestRemoteMetaStoreConnection(t *testing.T) {
+	s := newTestRemoteMetaStore(t, "remoteconnection")
+	require.NoError(t, s.Ping())
+}
+
+func TestRemoteMappingStore(t *testing.T) {
+	s := newTestRemoteMetaStore(t, "remotemapping")
+	mappingStoreTest(t, s)
+}
+
+func TestRemoteLocationStore(t *testing.T) {
+	s := newTestRemoteMetaStore(t, "remotelocation")
+	ctx := context.Background()
+
+	_, err := s.GetMappingByKey(ctx, MappingKey{BuildIDOrFile: "unknown"})
+	require.Equal(t, ErrMappingNotFound, err)
+	_, err = s.GetFunctionByKey(ctx, FunctionKey{Name: "unknown"})
+	require.Equal(t, ErrFunctionNotFound, err)
+
+	m := &profile.Mapping{
+		Start:   1,
+		Limit:   10,
+		Offset:  5,
+		File:    "file",
+		BuildID: "buildID0",
+	}
+	mID, err := s.CreateMapping(ctx, m)
+	require.NoError(t, err)
+	m.ID = mID
+
+	l := &profile.Location{
+		Address: uint64(421),
+		Mapping: m,
+	}
+	_, err = s.GetLocationByKey(ctx, MakeLocationKey(l))
+	require.Equal(t, ErrLocationNotFound, err)
+
+	lID, err := s.CreateLocation(ctx, l)
+	require.NoError(t, err)
+	l.ID = lID
+
+	locByKey, err := s.GetLocationByKey(ctx, MakeLocationKey(l))
+	require.NoError(t, err)
+	require.Equal(t, lID, locByKey.ID)
+
+	symbolizable, err := s.GetSymbolizableLocations(ctx)
+	require.NoError(t, err)
+	require.Len(t, symbolizable, 1)
+	require.Equal(t, lID, symbolizable[0].ID)
+
+	f := &profile.Function{
+		Name:       "name",
+		SystemName: "systemName",
+		Filename:   "filename",
+		StartLine:  22,
+	}
+	l.Line = []profile.Line{
+		{Line: 1, Function: f},
+		{Line: 5, Function: f},
+	}
+	require.NoError(t, s.Symbolize(ctx, l))
+
+	fn, err := s.GetFunctionByKey(ctx, MakeFunctionKey(f))
+	require.NoError(t, err)
+	f.ID = fn.ID
+
+	locs, err := s.GetLocationsByIDs(ctx, lID)
+	require.NoError(t, err)
+	require.Equal(t, l, locs[lID])
+
+	symbolizable, err = s.GetSymbolizableLocations(ctx)
+	require.NoError(t, err)
+	require.Len(t, symbolizable, 0)
+}
@@ -0,0 +1,307 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metastore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/pprof/profile"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/metastore/v1alpha1"
+)
+
+// MetastoreService serves a ProfileMetaStore via gRPC, so that other Parca
+// processes can use it through a RemoteMetaStore.
+type MetastoreService struct {
+	metaStore ProfileMetaStore
+}
+
+// NewMetastoreService returns a gRPC service exposing the given metastore.
+func NewMetastoreService(metaStore ProfileMetaStore) *MetastoreService {
+	return &MetastoreService{
+		metaStore: metaStore,
+	}
+}
+
+// GetLocationByKey implements the MetastoreServiceServer interface.
+func (s *MetastoreService) GetLocationByKey(ctx context.Context, req *pb.GetLocationByKeyRequest) (*pb.GetLocationByKeyResponse, error) {
+	k := req.GetKey()
+	l, err := s.metaStore.GetLocationByKey(ctx, LocationKey{
+		NormalizedAddress: k.GetNormalizedAddress(),
+		MappingID:         k.GetMappingId(),
+		Lines:             k.GetLines(),
+		IsFolded:          k.GetIsFolded(),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.GetLocationByKeyResponse{Location: locationToProto(l)}, nil
+}
+
+// GetLocationsByIDs implements the MetastoreServiceServer interface.
+func (s *MetastoreService) GetLocationsByIDs(ctx context.Context, req *pb.GetLocationsByIDsRequest) (*pb.GetLocationsByIDsResponse, error) {
+	locs, err := s.metaStore.GetLocationsByIDs(ctx, req.GetIds()...)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &pb.GetLocationsByIDsResponse{
+		Locations: make([]*pb.Location, 0, len(locs)),
+	}
+	for _, id := range req.GetIds() {
+		if l, ok := locs[id]; ok {
+			res.Locations = append(res.Locations, locationToProto(l))
+		}
+	}
+
+	return res, nil
+}
+
+// CreateLocation implements the MetastoreServiceServer interface.
+func (s *MetastoreService) CreateLocation(ctx context.Context, req *pb.CreateLocationRequest) (*pb.CreateLocationResponse, error) {
+	if req.GetLocation() == nil {
+		return nil, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	id, err := s.metaStore.CreateLocation(ctx, locationFromProto(req.GetLocation()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.CreateLocationResponse{Id: id}, nil
+}
+
+// Symbolize implements the MetastoreServiceServer interface.
+func (s *MetastoreService) Symbolize(ctx context.Context, req *pb.SymbolizeRequest) (*pb.SymbolizeResponse, error) {
+	if req.GetLocation() == nil {
+		return nil, status.Error(codes.InvalidArgument, "location is required")
+	}
+
+	if err := s.metaStore.Symbolize(ctx, locationFromProto(req.GetLocation())); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.SymbolizeResponse{}, nil
+}
+
+// GetSymbolizableLocations implements the MetastoreServiceServer interface.
+func (s *MetastoreService) GetSymbolizableLocations(ctx context.Context, _ *pb.GetSymbolizableLocationsRequest) (*pb.GetSymbolizableLocationsResponse, error) {
+	locs, err := s.metaStore.GetSymbolizableLocations(ctx)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	res := &pb.GetSymbolizableLocationsResponse{
+		Locations: make([]*pb.Location, 0, len(locs)),
+	}
+	for _, l := range locs {
+		res.Locations = append(res.Locations, locationToProto(l))
+	}
+
+	return res, nil
+}
+
+// GetFunctionByKey implements the MetastoreServiceServer interface.
+func (s *MetastoreService) GetFunctionByKey(ctx context.Context, req *pb.GetFunctionByKeyRequest) (*pb.GetFunctionByKeyResponse, error) {
+	k := req.GetKey()
+	fn, err := s.metaStore.GetFunctionByKey(ctx, FunctionKey{
+		StartLine:  k.GetStartLine(),
+		Name:       k.GetName(),
+		SystemName: k.GetSystemName(),
+		FileName:   k.GetFilename(),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.GetFunctionByKeyResponse{Function: functionToProto(fn)}, nil
+}
+
+// CreateFunction implements the MetastoreServiceServer interface.
+func (s *MetastoreService) CreateFunction(ctx context.Context, req *pb.CreateFunctionRequest) (*pb.CreateFunctionResponse, error) {
+	if req.GetFunction() == nil {
+		return nil, status.Error(codes.InvalidArgument, "function is required")
+	}
+
+	id, err := s.metaStore.CreateFunction(ctx, functionFromProto(req.GetFunction()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.CreateFunctionResponse{Id: id}, nil
+}
+
+// GetMappingByKey implements the MetastoreServiceServer interface.
+func (s *MetastoreService) GetMappingByKey(ctx context.Context, req *pb.GetMappingByKeyRequest) (*pb.GetMappingByKeyResponse, error) {
+	k := req.GetKey()
+	m, err := s.metaStore.GetMappingByKey(ctx, MappingKey{
+		Size:          k.GetSize(),
+		Offset:        k.GetOffset(),
+		BuildIDOrFile: k.GetBuildIdOrFile(),
+	})
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.GetMappingByKeyResponse{Mapping: mappingToProto(m)}, nil
+}
+
+// CreateMapping implements the MetastoreServiceServer interface.
+func (s *MetastoreService) CreateMapping(ctx context.Context, req *pb.CreateMappingRequest) (*pb.CreateMappingResponse, error) {
+	if req.GetMapping() == nil {
+		return nil, status.Error(codes.InvalidArgument, "mapping is required")
+	}
+
+	id, err := s.metaStore.CreateMapping(ctx, mappingFromProto(req.GetMapping()))
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &pb.CreateMappingResponse{Id: id}, nil
+}
+
+// statusFromError translates the metastore's sentinel errors to gRPC status
+// codes, so clients can translate them back.
+func statusFromError(err error) error {
+	if errors.Is(err, ErrLocationNotFound) ||
+		errors.Is(err, ErrFunctionNotFound) ||
+		errors.Is(err, ErrMappingNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func locationToProto(l *profile.Location) *pb.Location {
+	if l == nil {
+		return nil
+	}
+
+	res := &pb.Location{
+		Id:       l.ID,
+		Mapping:  mappingToProto(l.Mapping),
+		Address:  l.Address,
+		IsFolded: l.IsFolded,
+	}
+	if len(l.Line) > 0 {
+		res.Lines = make([]*pb.Line, 0, len(l.Line))
+		for _, ln := range l.Line {
+			res.Lines = append(res.Lines, &pb.Line{
+				Function: functionToProto(ln.Function),
+				Line:     ln.Line,
+			})
+		}
+	}
+
+	return res
+}
+
+func locationFromProto(l *pb.Location) *profile.Location {
+	if l == nil {
+		return nil
+	}
+
+	res := &profile.Location{
+		ID:       l.GetId(),
+		Mapping:  mappingFromProto(l.GetMapping()),
+		Address:  l.GetAddress(),
+		IsFolded: l.GetIsFolded(),
+	}
+	if len(l.GetLines()) > 0 {
+		res.Line = make([]profile.Line, 0, len(l.GetLines()))
+		for _, ln := range l.GetLines() {
+			res.Line = append(res.Line, profile.Line{
+				Function: functionFromProto(ln.GetFunction()),
+				Line:     ln.GetLine(),
+			})
+		}
+	}
+
+	return res
+}
+
+func functionToProto(fn *profile.Function) *pb.Function {
+	if fn == nil {
+		return nil
+	}
+
+	return &pb.Function{
+		Id:         fn.ID,
+		Name:       fn.Name,
+		SystemName: fn.SystemName,
+		Filename:   fn.Filename,
+		StartLine:  fn.StartLine,
+	}
+}
+
+func functionFromProto(fn *pb.Function) *profile.Function {
+	if fn == nil {
+		return nil
+	}
+
+	return &profile.Function{
+		ID:         fn.GetId(),
+		Name:       fn.GetName(),
+		SystemName: fn.GetSystemName(),
+		Filename:   fn.GetFilename(),
+		StartLine:  fn.GetStartLine(),
+	}
+}
+
+func mappingToProto(m *profile.Mapping) *pb.Mapping {
+	if m == nil {
+		return nil
+	}
+
+	return &pb.Mapping{
+		Id:              m.ID,
+		Start:           m.Start,
+		Limit:           m.Limit,
+		Offset:          m.Offset,
+		File:            m.File,
+		BuildId:         m.BuildID,
+		HasFunctions:    m.HasFunctions,
+		HasFilenames:    m.HasFilenames,
+		HasLineNumbers:  m.HasLineNumbers,
+		HasInlineFrames: m.HasInlineFrames,
+	}
+}
+
+func mappingFromProto(m *pb.Mapping) *profile.Mapping {
+	if m == nil {
+		return nil
+	}
+
+	return &profile.Mapping{
+		ID:              m.GetId(),
+		Start:           m.GetStart(),
+		Limit:           m.GetLimit(),
+		Offset:          m.GetOffset(),
+		File:            m.GetFile(),
+		BuildID:         m.GetBuildId(),
+		HasFunctions:    m.GetHasFunctions(),
+		HasFilenames:    m.GetHasFilenames(),
+		HasLineNumbers:  m.GetHasLineNumbers(),
+		HasInlineFrames: m.GetHasInlineFrames(),
+	}
+}
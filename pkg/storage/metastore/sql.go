@@ -32,14 +32,22 @@ type sqlMetaStore struct {
 	db     *sql.DB
 	cache  *metaStoreCache
 	tracer trace.Tracer
+
+	gcMetrics    *gcMetrics
+	gcCandidates gcCandidates
 }
 
-func (s *sqlMetaStore) migrate() error {
+// migrations are the schema changes of the metastore's database.
+// The user_version of the database records how many of them have been applied,
+// so each migration is only run once. Existing migrations must never be changed,
+// new ones are only ever appended.
+var migrations = [][]string{
 	// Most of the tables have started their lives as representation of pprof data types.
 	// Find detailed information in https://github.com/google/pprof/blob/master/proto/README.md
-	tables := []string{
-		"PRAGMA foreign_keys = ON",
-		`CREATE TABLE "mappings" (
+	// The statements are idempotent, as databases created before the schema was
+	// versioned already contain these tables.
+	{
+		`CREATE TABLE IF NOT EXISTS "mappings" (
 			"id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 			"start"           	INT64,
 			"limit"          	INT64,
@@ -54,8 +62,8 @@ func (s *sqlMetaStore) migrate() error {
 			"build_id_or_file"	TEXT,
 			UNIQUE (size, offset, build_id_or_file)
 		);`,
-		`CREATE INDEX idx_mapping_key ON mappings (size, offset, build_id_or_file);`,
-		`CREATE TABLE "functions" (
+		`CREATE INDEX IF NOT EXISTS idx_mapping_key ON mappings (size, offset, build_id_or_file);`,
+		`CREATE TABLE IF NOT EXISTS "functions" (
 			"id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 			"name"       	TEXT,
 			"system_name" 	TEXT,
@@ -63,8 +71,8 @@ func (s *sqlMetaStore) migrate() error {
 			"start_line"  	INT64,
 			UNIQUE (name, system_name, filename, start_line)
 		);`,
-		`CREATE INDEX idx_function_key ON functions (start_line, name, system_name, filename);`,
-		`CREATE TABLE "lines" (
+		`CREATE INDEX IF NOT EXISTS idx_function_key ON functions (start_line, name, system_name, filename);`,
+		`CREATE TABLE IF NOT EXISTS "lines" (
 			"location_id" INTEGER NOT NULL,
 			"function_id" INTEGER NOT NULL,
 			"line" 		  INT64,
@@ -72,8 +80,8 @@ func (s *sqlMetaStore) migrate() error {
 			FOREIGN KEY (location_id) REFERENCES locations (id),
 			UNIQUE (location_id, function_id, line)
 		);`,
-		`CREATE INDEX idx_line_location ON lines (location_id);`,
-		`CREATE TABLE "locations" (
+		`CREATE INDEX IF NOT EXISTS idx_line_location ON lines (location_id);`,
+		`CREATE TABLE IF NOT EXISTS "locations" (
 			"id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
 			"mapping_id"  			INTEGER,
 			"address"  				INT64,
@@ -83,23 +91,61 @@ func (s *sqlMetaStore) migrate() error {
 			FOREIGN KEY (mapping_id) REFERENCES mappings (id),
 			UNIQUE (mapping_id, is_folded, normalized_address, lines)
 		);`,
-		`CREATE INDEX idx_location_key ON locations (normalized_address, mapping_id, is_folded, lines);`,
+		`CREATE INDEX IF NOT EXISTS idx_location_key ON locations (normalized_address, mapping_id, is_folded, lines);`,
+	},
+}
+
+// migrate brings the database's schema up to date.
+// It is safe to call on new as well as existing databases.
+func (s *sqlMetaStore) migrate() error {
+	if _, err := s.db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		return err
 	}
 
-	for _, t := range tables {
-		statement, err := s.db.Prepare(t)
+	version, err := s.schemaVersion()
+	if err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than the latest supported version %d", version, len(migrations))
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := s.db.Begin()
 		if err != nil {
 			return err
 		}
-
-		if _, err := statement.Exec(); err != nil {
-			return err
+		for _, stmt := range migrations[i] {
+			if _, err := tx.Exec(stmt); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("migration to version %d: %w", i+1, err)
+			}
+		}
+		// PRAGMA statements don't support parameters.
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration to version %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration to version %d: %w", i+1, err)
 		}
 	}
 	return nil
 }
 
+// schemaVersion returns the number of migrations applied to the database.
+func (s *sqlMetaStore) schemaVersion() (int, error) {
+	var version int
+	if err := s.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("get schema version: %w", err)
+	}
+	return version, nil
+}
+
 func (s *sqlMetaStore) GetLocationByKey(ctx context.Context, k LocationKey) (*profile.Location, error) {
+	s.gcCandidates.mtx.RLock()
+	defer s.gcCandidates.mtx.RUnlock()
+
 	res := profile.Location{}
 
 	l, found, err := s.cache.getLocationByKey(ctx, k)
@@ -148,6 +194,7 @@ func (s *sqlMetaStore) GetLocationByKey(ctx context.Context, k LocationKey) (*pr
 			return nil, fmt.Errorf("set location by key in cache: %w", err)
 		}
 	}
+	s.gcCandidates.keep(s.gcCandidates.locations, l.ID)
 	res.ID = l.ID
 	res.Address = l.Address
 	res.IsFolded = l.IsFolded
@@ -595,6 +642,9 @@ func (s *sqlMetaStore) getFunctionsByIDs(ctx context.Context, ids ...uint64) (ma
 }
 
 func (s *sqlMetaStore) CreateLocation(ctx context.Context, l *profile.Location) (uint64, error) {
+	s.gcCandidates.mtx.RLock()
+	defer s.gcCandidates.mtx.RUnlock()
+
 	k := MakeLocationKey(l)
 	var (
 		stmt *sql.Stmt
@@ -608,6 +658,7 @@ func (s *sqlMetaStore) CreateLocation(ctx context.Context, l *profile.Location)
 		if err != nil {
 			return 0, fmt.Errorf("get mapping by id: %w", err)
 		}
+		s.gcCandidates.keep(s.gcCandidates.mappings, m.ID)
 
 		stmt, err = s.db.PrepareContext(ctx, `INSERT INTO "locations" (
                          address, is_folded, mapping_id, normalized_address, lines
@@ -649,6 +700,9 @@ func (s *sqlMetaStore) CreateLocation(ctx context.Context, l *profile.Location)
 }
 
 func (s *sqlMetaStore) Symbolize(ctx context.Context, l *profile.Location) error {
+	s.gcCandidates.mtx.RLock()
+	defer s.gcCandidates.mtx.RUnlock()
+
 	// NOTICE: We assume the given location is already persisted in the database.
 	if err := s.createLines(ctx, l.Line, int64(l.ID)); err != nil {
 		return fmt.Errorf("create lines: %w", err)
@@ -789,6 +843,15 @@ func (s *sqlMetaStore) GetSymbolizableLocations(ctx context.Context) ([]*profile
 }
 
 func (s *sqlMetaStore) GetFunctionByKey(ctx context.Context, k FunctionKey) (*profile.Function, error) {
+	s.gcCandidates.mtx.RLock()
+	defer s.gcCandidates.mtx.RUnlock()
+
+	return s.getFunctionByKey(ctx, k)
+}
+
+// getFunctionByKey is GetFunctionByKey for callers holding the read lock of
+// the GC candidates.
+func (s *sqlMetaStore) getFunctionByKey(ctx context.Context, k FunctionKey) (*profile.Function, error) {
 	var (
 		fn profile.Function
 		id int64
@@ -799,6 +862,7 @@ func (s *sqlMetaStore) GetFunctionByKey(ctx context.Context, k FunctionKey) (*pr
 		return nil, fmt.Errorf("get function by key from cache: %w", err)
 	}
 	if found {
+		s.gcCandidates.keep(s.gcCandidates.functions, fn.ID)
 		return &fn, nil
 	}
 
@@ -819,6 +883,7 @@ func (s *sqlMetaStore) GetFunctionByKey(ctx context.Context, k FunctionKey) (*pr
 	if err != nil {
 		return nil, fmt.Errorf("set function by key in cache: %w", err)
 	}
+	s.gcCandidates.keep(s.gcCandidates.functions, fn.ID)
 
 	return &fn, nil
 }
@@ -876,6 +941,9 @@ func (s *sqlMetaStore) GetFunctions(ctx context.Context) ([]*profile.Function, e
 }
 
 func (s *sqlMetaStore) GetMappingByKey(ctx context.Context, k MappingKey) (*profile.Mapping, error) {
+	s.gcCandidates.mtx.RLock()
+	defer s.gcCandidates.mtx.RUnlock()
+
 	var (
 		m                        profile.Mapping
 		id, start, limit, offset int64
@@ -886,6 +954,7 @@ func (s *sqlMetaStore) GetMappingByKey(ctx context.Context, k MappingKey) (*prof
 		return nil, err
 	}
 	if found {
+		s.gcCandidates.keep(s.gcCandidates.mappings, m.ID)
 		return &m, nil
 	}
 
@@ -913,6 +982,7 @@ func (s *sqlMetaStore) GetMappingByKey(ctx context.Context, k MappingKey) (*prof
 	if err != nil {
 		return nil, err
 	}
+	s.gcCandidates.keep(s.gcCandidates.mappings, m.ID)
 
 	return &m, nil
 }
@@ -1060,7 +1130,7 @@ func (s *sqlMetaStore) getLocationLines(ctx context.Context, locationID uint64)
 }
 
 func (s *sqlMetaStore) getOrCreateFunction(ctx context.Context, f *profile.Function) (uint64, error) {
-	fn, err := s.GetFunctionByKey(ctx, MakeFunctionKey(f))
+	fn, err := s.getFunctionByKey(ctx, MakeFunctionKey(f))
 	if err == nil {
 		return fn.ID, nil
 	}
@@ -15,7 +15,10 @@ package metastore
 
 import (
 	"context"
+	"database/sql"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/pprof/profile"
@@ -320,6 +323,90 @@ func TestDiskMetaStore(t *testing.T) {
 	metaStoreTest(t, s)
 }
 
+func TestDiskMetaStoreReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-metastore")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	dbPath := filepath.Join(dir, "metastore.sqlite")
+
+	// Create a database the way it was done before the schema was versioned.
+	db, err := sql.Open("sqlite", dbPath)
+	require.NoError(t, err)
+	for _, stmt := range migrations[0] {
+		_, err := db.Exec(stmt)
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.Close())
+
+	s, err := NewDiskProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		dbPath,
+	)
+	require.NoError(t, err)
+
+	version, err := s.schemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, len(migrations), version)
+
+	// Migrating an up to date database is a no-op.
+	require.NoError(t, s.migrate())
+
+	ctx := context.Background()
+	m := &profile.Mapping{
+		Start:   1,
+		Limit:   10,
+		Offset:  5,
+		File:    "file",
+		BuildID: "buildID0",
+	}
+	mID, err := s.CreateMapping(ctx, m)
+	require.NoError(t, err)
+	m.ID = mID
+
+	f := &profile.Function{
+		Name:       "name",
+		SystemName: "systemName",
+		Filename:   "filename",
+		StartLine:  22,
+	}
+	l := &profile.Location{
+		Address: uint64(421),
+		Mapping: m,
+		Line:    []profile.Line{{Line: 1, Function: f}},
+	}
+	lID, err := s.CreateLocation(ctx, l)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// All IDs are still resolvable after reopening the database.
+	s, err = NewDiskProfileMetaStore(
+		prometheus.NewRegistry(),
+		trace.NewNoopTracerProvider().Tracer(""),
+		dbPath,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		s.Close()
+	})
+
+	locs, err := s.GetLocationsByIDs(ctx, lID)
+	require.NoError(t, err)
+	require.Len(t, locs, 1)
+	require.Equal(t, lID, locs[lID].ID)
+	require.Equal(t, uint64(421), locs[lID].Address)
+	require.Equal(t, mID, locs[lID].Mapping.ID)
+	require.Len(t, locs[lID].Line, 1)
+	require.Equal(t, "name", locs[lID].Line[0].Function.Name)
+
+	// The location can still be looked up by its key.
+	l2, err := s.GetLocationByKey(ctx, MakeLocationKey(l))
+	require.NoError(t, err)
+	require.Equal(t, lID, l2.ID)
+}
+
 func metaStoreTest(t *testing.T, s TestProfileMetaStore) {
 	ctx := context.Background()
 
@@ -0,0 +1,60 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/parca-dev/parca/pkg/runutil"
+	"github.com/parca-dev/parca/pkg/storage/metastore"
+)
+
+// MetaStoreGC periodically deletes the locations no series of the DB references
+// anymore from the metastore, along with the functions and mappings only they referenced.
+type MetaStoreGC struct {
+	logger    log.Logger
+	db        *DB
+	metaStore metastore.GarbageCollector
+}
+
+func NewMetaStoreGC(logger log.Logger, db *DB, metaStore metastore.GarbageCollector) *MetaStoreGC {
+	return &MetaStoreGC{
+		logger:    log.With(logger, "component", "metastore-gc"),
+		db:        db,
+		metaStore: metaStore,
+	}
+}
+
+func (g *MetaStoreGC) Run(ctx context.Context, interval time.Duration) error {
+	return runutil.Repeat(interval, ctx.Done(), func() error {
+		if err := g.collect(ctx); err != nil {
+			level.Error(g.logger).Log("msg", "metastore garbage collection failed", "err", err)
+		}
+		return nil
+	})
+}
+
+func (g *MetaStoreGC) collect(ctx context.Context) error {
+	ids, err := g.db.LocationIDs()
+	if err != nil {
+		return fmt.Errorf("get referenced location IDs: %w", err)
+	}
+
+	return g.metaStore.GarbageCollect(ctx, ids)
+}
@@ -62,7 +62,9 @@ func (s *LocationStack) ToLocationStacktrace() []*profile.Location {
 	return a
 }
 
-func generatePprof(ctx context.Context, locationStore Locations, ip InstantProfile) (*profile.Profile, error) {
+// GeneratePprof turns the InstantProfile back into a pprof profile, resolving its locations through the locationStore.
+// For diff profiles the sample values are the differences to the base profile, as pprof's -diff_base would show them.
+func GeneratePprof(ctx context.Context, locationStore Locations, ip InstantProfile) (*profile.Profile, error) {
 	meta := ip.ProfileMeta()
 
 	mappingByID := map[uint64]*profile.Mapping{}
@@ -139,13 +141,16 @@ func generatePprof(ctx context.Context, locationStore Locations, ip InstantProfi
 			}
 
 			location := &profile.Location{
-				ID:      uint64(len(p.Location) + 1),
-				Mapping: mapping,
-				// TODO: Is this right?
-				Address:  loc.Address + mapping.Offset,
+				ID:       uint64(len(p.Location) + 1),
+				Mapping:  mapping,
+				Address:  loc.Address,
 				Line:     lines,
 				IsFolded: loc.IsFolded,
 			}
+			if mapping != nil {
+				// TODO: Is this right?
+				location.Address += mapping.Offset
+			}
 			p.Location = append(p.Location, location)
 			locationByID[id] = location
 		}
@@ -184,6 +189,9 @@ func generatePprof(ctx context.Context, locationStore Locations, ip InstantProfi
 
 			stack.Push(l)
 			flatValues := node.FlatValues()
+			if diffValues := node.FlatDiffValues(); len(diffValues) > 0 {
+				flatValues = diffValues
+			}
 			var stacktrace []*profile.Location
 			if len(flatValues) > 0 {
 				// Only calculate once per flat value.
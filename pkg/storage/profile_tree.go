@@ -139,18 +139,24 @@ func (t *ProfileTree) Insert(sample *profile.Sample) {
 		cv.Key(locationIDs...) // populate the keys
 	}
 
-	if cur.flatValues == nil {
-		cur.flatValues = []*ProfileTreeValueNode{{}}
+	// Samples of the same stack with different pprof labels keep their own
+	// flat values, so that they can still be told apart by their labels.
+	flat := &ProfileTreeValueNode{
+		Value:    sample.Value[0],
+		Label:    sample.Label,
+		NumLabel: sample.NumLabel,
+		NumUnit:  sample.NumUnit,
 	}
-	cur.flatValues[0].Value += sample.Value[0]
-	// TODO: We probably need to merge labels, numLabels and numUnits
-	cur.flatValues[0].Label = sample.Label
-	cur.flatValues[0].NumLabel = sample.NumLabel
-	cur.flatValues[0].NumUnit = sample.NumUnit
+	flat.Key(locationIDs...)
 
 	for _, fv := range cur.flatValues {
-		fv.Key(locationIDs...) //populate the keys
+		fv.Key(locationIDs...) // populate the keys
+		if fv.key.Equals(*flat.key) {
+			fv.Value += flat.Value
+			return
+		}
 	}
+	cur.flatValues = append(cur.flatValues, flat)
 }
 
 type ProfileTreeNode struct {
@@ -0,0 +1,146 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strconv"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// sampleLabelsSeries is a series whose profiles only contain the samples
+// whose pprof labels match the matchers.
+type sampleLabelsSeries struct {
+	Series
+	matchers []*labels.Matcher
+}
+
+func (s *sampleLabelsSeries) Iterator() ProfileSeriesIterator {
+	return &sampleLabelsSeriesIterator{
+		ProfileSeriesIterator: s.Series.Iterator(),
+		matchers:              s.matchers,
+	}
+}
+
+type sampleLabelsSeriesIterator struct {
+	ProfileSeriesIterator
+	matchers []*labels.Matcher
+	cur      InstantProfile
+}
+
+func (it *sampleLabelsSeriesIterator) Next() bool {
+	if !it.ProfileSeriesIterator.Next() {
+		return false
+	}
+	it.cur = FilterProfileBySampleLabels(it.ProfileSeriesIterator.At(), it.matchers)
+	return true
+}
+
+func (it *sampleLabelsSeriesIterator) At() InstantProfile {
+	return it.cur
+}
+
+// FilterProfileBySampleLabels returns the profile with only the values of the
+// samples whose pprof labels match all the matchers.
+func FilterProfileBySampleLabels(p InstantProfile, ms []*labels.Matcher) InstantProfile {
+	if len(ms) == 0 {
+		return p
+	}
+
+	pt := CopyInstantProfileTree(p.ProfileTree())
+	if pt == nil {
+		return p
+	}
+
+	res := NewProfileTree()
+
+	// stack are the location IDs from the root to the current node.
+	var (
+		stack []uint64
+		walk  func(n *ProfileTreeNode)
+	)
+	walk = func(n *ProfileTreeNode) {
+		stack = append(stack, n.locationID)
+		flat := matchingValues(n.flatValues, ms)
+		flatDiff := matchingValues(n.flatDiffValues, ms)
+		if len(flat) > 0 || len(flatDiff) > 0 {
+			res.insertValues(stack, flat, flatDiff)
+		}
+		for _, child := range n.Children {
+			walk(child)
+		}
+		stack = stack[:len(stack)-1]
+	}
+	for _, child := range pt.Roots.Children {
+		walk(child)
+	}
+
+	return &Profile{
+		Tree: res,
+		Meta: p.ProfileMeta(),
+	}
+}
+
+func matchingValues(values []*ProfileTreeValueNode, ms []*labels.Matcher) []*ProfileTreeValueNode {
+	res := make([]*ProfileTreeValueNode, 0, len(values))
+	for _, v := range values {
+		if sampleLabelsMatch(v, ms) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// sampleLabelsMatch returns whether the pprof labels of a value match all the
+// matchers. Numeric labels are matched by their decimal value, regardless of
+// their unit. Like in Prometheus a missing label matches the empty string.
+// As a pprof label can have multiple values, a label matches an equality or
+// regexp matcher if any of its values does, and a negative matcher only if
+// all of its values do.
+func sampleLabelsMatch(v *ProfileTreeValueNode, ms []*labels.Matcher) bool {
+	for _, m := range ms {
+		values := sampleLabelValues(v, m.Name)
+		if len(values) == 0 {
+			values = []string{""}
+		}
+
+		negative := m.Type == labels.MatchNotEqual || m.Type == labels.MatchNotRegexp
+		matched := negative
+		for _, value := range values {
+			if m.Matches(value) != negative {
+				matched = !negative
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func sampleLabelValues(v *ProfileTreeValueNode, name string) []string {
+	values := v.Label[name]
+	nums := v.NumLabel[name]
+	if len(nums) == 0 {
+		return values
+	}
+
+	res := make([]string, 0, len(values)+len(nums))
+	res = append(res, values...)
+	for _, n := range nums {
+		res = append(res, strconv.FormatInt(n, 10))
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterProfileBySampleLabels(t *testing.T) {
+	labeled := func(value int64, ids []uint64, lbls map[string][]string, numLabels map[string][]int64) *profile.Sample {
+		s := makeSample(value, ids)
+		s.Label = lbls
+		s.NumLabel = numLabels
+		return s
+	}
+
+	pt := NewProfileTree()
+	pt.Insert(labeled(1, []uint64{2, 1}, map[string][]string{"handler": {"/users"}}, nil))
+	pt.Insert(labeled(2, []uint64{2, 1}, map[string][]string{"handler": {"/orders"}}, nil))
+	pt.Insert(labeled(3, []uint64{3, 1}, map[string][]string{"handler": {"/users", "/orders"}}, nil))
+	pt.Insert(labeled(4, []uint64{3, 2, 1}, nil, map[string][]int64{"bytes": {64}}))
+	p := &Profile{Tree: pt}
+
+	filter := func(ms ...*labels.Matcher) map[string]int64 {
+		return treeStacks(t, FilterProfileBySampleLabels(p, ms).ProfileTree().(*ProfileTree))
+	}
+
+	// The samples of the same stack with different labels are kept apart.
+	require.Equal(t, map[string]int64{
+		"1;2":   1 + 2,
+		"1;3":   3,
+		"1;2;3": 4,
+	}, filter())
+
+	require.Equal(t, map[string]int64{
+		"1;2": 1,
+		"1;3": 3,
+	}, filter(labels.MustNewMatcher(labels.MatchEqual, "handler", "/users")))
+
+	// Only the samples with no value matching the negative matcher are kept,
+	// a missing label is empty.
+	require.Equal(t, map[string]int64{
+		"1;2":   2,
+		"1;2;3": 4,
+	}, filter(labels.MustNewMatcher(labels.MatchNotEqual, "handler", "/users")))
+
+	require.Equal(t, map[string]int64{
+		"1;2;3": 4,
+	}, filter(labels.MustNewMatcher(labels.MatchRegexp, "bytes", "6.")))
+
+	require.Empty(t, filter(
+		labels.MustNewMatcher(labels.MatchRegexp, "handler", "/.*"),
+		labels.MustNewMatcher(labels.MatchEqual, "bytes", "64"),
+	))
+}
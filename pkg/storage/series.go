@@ -62,6 +62,11 @@ type MemSeries struct {
 
 	tracer          trace.Tracer
 	samplesAppended prometheus.Counter
+
+	// walMtx serializes appending profiles with logging them to the WAL.
+	walMtx sync.Mutex
+	// walLogged is whether the series has been logged to the WAL.
+	walLogged bool
 }
 
 func NewMemSeries(id uint64, lset labels.Labels, updateMaxTime func(int64), chunkPool ChunkPool) *MemSeries {
@@ -104,6 +109,14 @@ func (s *MemSeries) Appender() (*MemSeriesAppender, error) {
 	return &MemSeriesAppender{s: s}, nil
 }
 
+// addLocationIDs adds the IDs of all locations referenced by the series to ids.
+func (s *MemSeries) addLocationIDs(ids map[uint64]struct{}) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.seriesTree.addLocationIDs(ids)
+}
+
 func (s *MemSeries) appendTree(profileTree *ProfileTree) error {
 	if s.seriesTree == nil {
 		s.seriesTree = &MemSeriesTree{s: s}
@@ -301,6 +314,10 @@ func (s *MemSeries) truncateChunksBefore(mint int64) (removed int) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if len(s.timestamps) == 0 {
+		return 0
+	}
+
 	if s.timestamps[0].maxTime > mint {
 		// We don't have anything to do and can exist early.
 		return 0
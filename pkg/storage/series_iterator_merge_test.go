@@ -49,7 +49,8 @@ func TestMergeMemSeriesConsistency(t *testing.T) {
 	p, err := ProfileFromPprof(ctx, log.NewNopLogger(), s, pprof1, 0)
 	require.NoError(t, err)
 
-	db := OpenDB(prometheus.NewRegistry(), tracer, nil)
+	db, err := OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), tracer, nil)
+	require.NoError(t, err)
 
 	app, err := db.Appender(ctx, labels.Labels{
 		labels.Label{
@@ -0,0 +1,137 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/parca-dev/parca/pkg/storage/chunkenc"
+	"github.com/prometheus/prometheus/pkg/labels"
+)
+
+// MemMetadataSeries is an iterator that only queries the timestamps, durations and periods of each series.
+// The profiles it returns have empty trees, which makes it cheap to discover which samples a series has.
+type MemMetadataSeries struct {
+	s    *MemSeries
+	mint int64
+	maxt int64
+}
+
+func (ms *MemMetadataSeries) Labels() labels.Labels {
+	return ms.s.Labels()
+}
+
+func (ms *MemMetadataSeries) Iterator() ProfileSeriesIterator {
+	ms.s.mu.RLock()
+	defer ms.s.mu.RUnlock()
+
+	chunkStart, chunkEnd := ms.s.timestamps.indexRange(ms.mint, ms.maxt)
+	timestamps := make([]chunkenc.Chunk, 0, chunkEnd-chunkStart)
+	for _, t := range ms.s.timestamps[chunkStart:chunkEnd] {
+		timestamps = append(timestamps, t.chunk)
+	}
+
+	return &MemMetadataSeriesIterator{
+		s:    ms.s,
+		mint: ms.mint,
+		maxt: ms.maxt,
+
+		timestamps: timestamps,
+		durations:  ms.s.durations[chunkStart:chunkEnd],
+		periods:    ms.s.periods[chunkStart:chunkEnd],
+		chunk:      -1,
+	}
+}
+
+// MemMetadataSeriesIterator iterates over the samples within [mint, maxt] chunk by chunk.
+// Unlike the MultiChunksIterator it stops at the end of each chunk,
+// so that it never reads past the last appended sample.
+type MemMetadataSeriesIterator struct {
+	s    *MemSeries
+	mint int64
+	maxt int64
+
+	timestamps []chunkenc.Chunk
+	durations  []chunkenc.Chunk
+	periods    []chunkenc.Chunk
+
+	chunk             int
+	timestampIterator chunkenc.Iterator
+	durationIterator  chunkenc.Iterator
+	periodIterator    chunkenc.Iterator
+
+	meta InstantProfileMeta
+	err  error
+}
+
+func (it *MemMetadataSeriesIterator) Next() bool {
+	it.s.mu.RLock()
+	defer it.s.mu.RUnlock()
+
+	for {
+		if it.timestampIterator == nil || !it.timestampIterator.Next() {
+			if it.timestampIterator != nil && it.timestampIterator.Err() != nil {
+				it.err = fmt.Errorf("next timestamp: %w", it.timestampIterator.Err())
+				return false
+			}
+
+			it.chunk++
+			if it.chunk >= len(it.timestamps) {
+				return false
+			}
+			it.timestampIterator = it.timestamps[it.chunk].Iterator(it.timestampIterator)
+			it.durationIterator = it.durations[it.chunk].Iterator(it.durationIterator)
+			it.periodIterator = it.periods[it.chunk].Iterator(it.periodIterator)
+			continue
+		}
+
+		if !it.durationIterator.Next() {
+			it.err = errors.New("unexpected end of durations iterator")
+			return false
+		}
+		if !it.periodIterator.Next() {
+			it.err = errors.New("unexpected end of periods iterator")
+			return false
+		}
+
+		t := it.timestampIterator.At()
+		if t < it.mint {
+			continue
+		}
+		if t > it.maxt {
+			return false
+		}
+
+		it.meta = InstantProfileMeta{
+			PeriodType: it.s.periodType,
+			SampleType: it.s.sampleType,
+			Timestamp:  t,
+			Duration:   it.durationIterator.At(),
+			Period:     it.periodIterator.At(),
+		}
+		return true
+	}
+}
+
+func (it *MemMetadataSeriesIterator) At() InstantProfile {
+	return &Profile{
+		Meta: it.meta,
+		Tree: &ProfileTree{},
+	}
+}
+
+func (it *MemMetadataSeriesIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,72 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemMetadataSeries_Iterator(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemSeries(0, labels.FromStrings("a", "b"), func(int64) {}, newHeadChunkPool())
+
+	app, err := s.Appender()
+	require.NoError(t, err)
+
+	// Append enough samples to span multiple chunks.
+	for i := 1; i < 500; i++ {
+		p := Profile{
+			Meta: InstantProfileMeta{
+				SampleType: ValueType{Type: "samples", Unit: "count"},
+				PeriodType: ValueType{Type: "cpu", Unit: "nanoseconds"},
+				Timestamp:  int64(i),
+				Duration:   time.Second.Nanoseconds(),
+				Period:     int64(i),
+			},
+			Tree: &ProfileTree{
+				Roots: &ProfileTreeNode{
+					locationID:       0,
+					cumulativeValues: []*ProfileTreeValueNode{{Value: int64(i)}},
+				},
+			},
+		}
+		err = app.Append(ctx, &p)
+		require.NoError(t, err)
+	}
+
+	it := (&MemMetadataSeries{s: s, mint: 74, maxt: 420}).Iterator()
+
+	seen := int64(74)
+	for it.Next() {
+		meta := it.At().ProfileMeta()
+		require.Equal(t, seen, meta.Timestamp)
+		require.Equal(t, time.Second.Nanoseconds(), meta.Duration)
+		require.Equal(t, seen, meta.Period)
+		require.Equal(t, ValueType{Type: "samples", Unit: "count"}, meta.SampleType)
+		require.Equal(t, ValueType{Type: "cpu", Unit: "nanoseconds"}, meta.PeriodType)
+		seen++
+	}
+
+	require.NoError(t, it.Err())
+	require.Equal(t, int64(421), seen) // Both mint and maxt are inclusive.
+
+	it = (&MemMetadataSeries{s: s, mint: 500, maxt: 1000}).Iterator()
+	require.False(t, it.Next())
+	require.NoError(t, it.Err())
+}
@@ -44,6 +44,25 @@ func (t *MemSeriesTree) Iterator() *MemSeriesTreeIterator {
 	return NewMemSeriesTreeIterator(t)
 }
 
+// addLocationIDs adds the IDs of all locations in the tree to ids.
+func (t *MemSeriesTree) addLocationIDs(ids map[uint64]struct{}) {
+	if t == nil || t.Roots == nil {
+		return
+	}
+
+	stack := []*MemSeriesTreeNode{t.Roots}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		// The roots don't reference an actual location.
+		if n.LocationID != 0 {
+			ids[n.LocationID] = struct{}{}
+		}
+		stack = append(stack, n.Children...)
+	}
+}
+
 func (t *MemSeriesTree) Insert(index uint16, profileTree *ProfileTree) error {
 	if t.Roots == nil {
 		t.Roots = &MemSeriesTreeNode{}
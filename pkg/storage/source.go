@@ -0,0 +1,192 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+	"github.com/parca-dev/parca/internal/pprof/graph"
+)
+
+// sourceMargin is the number of lines shown around the sampled lines, as in pprof.
+const sourceMargin = 5
+
+var ErrFunctionNotFound = errors.New("function not found in profile")
+
+// SourceFiles looks up the source files of the binary with the given build ID.
+type SourceFiles interface {
+	SourceFile(ctx context.Context, buildID, filename string) ([]byte, error)
+}
+
+// GenerateSource annotates the source lines of the given function with the
+// values of the profile, the same way the pprof source report does.
+// If the source of a file can't be found, only the line numbers that have
+// samples are returned, without any text.
+func GenerateSource(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	sources SourceFiles,
+	p InstantProfile,
+	function string,
+) (*pb.Source, error) {
+	ctx, span := tracer.Start(ctx, "generate-source")
+	defer span.End()
+
+	src := &pb.Source{
+		Unit: p.ProfileMeta().SampleType.Unit,
+	}
+
+	pp, err := GeneratePprof(ctx, locations, p)
+	if err != nil {
+		return nil, fmt.Errorf("generate pprof: %w", err)
+	}
+	if pp == nil {
+		return nil, ErrFunctionNotFound
+	}
+
+	for _, s := range pp.Sample {
+		src.Total += abs(s.Value[0])
+	}
+
+	buildIDs := make(map[string]string, len(pp.Mapping))
+	for _, m := range pp.Mapping {
+		buildIDs[m.File] = m.BuildID
+	}
+
+	g := graph.New(pp, &graph.Options{
+		SampleValue: func(v []int64) int64 { return v[0] },
+		// Keeps the objects, to find the build IDs, and the start lines of the functions.
+		ObjNames: true,
+	})
+
+	// Group the nodes of the function by source file.
+	found := false
+	var files graph.Nodes
+	fileNodes := map[string]graph.Nodes{}
+	for _, n := range g.Nodes {
+		if n.Info.Name != function {
+			continue
+		}
+		found = true
+		if n.Info.File == "" {
+			continue
+		}
+		if fileNodes[n.Info.File] == nil {
+			files = append(files, n)
+		}
+		fileNodes[n.Info.File] = append(fileNodes[n.Info.File], n)
+	}
+	if !found {
+		return nil, ErrFunctionNotFound
+	}
+	files.Sort(graph.FileOrder)
+
+	for _, fl := range files {
+		fns := fileNodes[fl.Info.File]
+		flat, cum := fns.Sum()
+		f := &pb.SourceFile{
+			Filename:   fl.Info.File,
+			BuildId:    buildIDs[fl.Info.Objfile],
+			Flat:       flat,
+			Cumulative: cum,
+		}
+
+		var text []string
+		if sources != nil && f.BuildId != "" {
+			content, err := sources.SourceFile(ctx, f.BuildId, f.Filename)
+			if err != nil {
+				span.RecordError(err)
+			} else {
+				text = strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+				f.HasSource = true
+			}
+		}
+
+		f.Lines = sourceLines(fns, text)
+		src.Files = append(src.Files, f)
+	}
+
+	return src, nil
+}
+
+// sourceLines picks the lines to show the same way getSourceFromFile of
+// internal/pprof/report does: from the start of the function, or a margin
+// before the first sampled line, to a margin after the last sampled line.
+// Without source text only the sampled lines are returned.
+func sourceLines(fns graph.Nodes, text []string) []*pb.SourceLine {
+	lineNodes := make(map[int]graph.Nodes)
+
+	start := fns[0].Info.StartLine
+	if start == 0 {
+		start = fns[0].Info.Lineno - sourceMargin
+	}
+	end := fns[0].Info.Lineno + sourceMargin
+	for _, n := range fns {
+		lineno := n.Info.Lineno
+		nodeStart := n.Info.StartLine
+		if nodeStart == 0 {
+			nodeStart = lineno - sourceMargin
+		}
+		nodeEnd := lineno + sourceMargin
+		if nodeStart < start {
+			start = nodeStart
+		} else if nodeEnd > end {
+			end = nodeEnd
+		}
+		lineNodes[lineno] = append(lineNodes[lineno], n)
+	}
+	if start < 1 {
+		start = 1
+	}
+
+	var lines []*pb.SourceLine
+	if text == nil {
+		linenos := make([]int, 0, len(lineNodes))
+		for lineno := range lineNodes {
+			if lineno >= 1 {
+				linenos = append(linenos, lineno)
+			}
+		}
+		sort.Ints(linenos)
+
+		for _, lineno := range linenos {
+			flat, cum := lineNodes[lineno].Sum()
+			lines = append(lines, &pb.SourceLine{
+				Line:       int64(lineno),
+				Flat:       flat,
+				Cumulative: cum,
+			})
+		}
+		return lines
+	}
+
+	for lineno := start; lineno <= end && lineno <= len(text); lineno++ {
+		flat, cum := lineNodes[lineno].Sum()
+		lines = append(lines, &pb.SourceLine{
+			Line:       int64(lineno),
+			Text:       strings.TrimRight(text[lineno-1], "\r"),
+			Flat:       flat,
+			Cumulative: cum,
+		})
+	}
+	return lines
+}
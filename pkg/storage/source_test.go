@@ -0,0 +1,113 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+type fakeSourceFiles struct {
+	m map[string]string
+}
+
+func (s *fakeSourceFiles) SourceFile(ctx context.Context, buildID, filename string) ([]byte, error) {
+	content, ok := s.m[buildID+":"+filename]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return []byte(content), nil
+}
+
+func sourceText(n int) string {
+	lines := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestGenerateSource(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(3, []uint64{3, 1}))
+	pt.Insert(makeSample(1, []uint64{1}))
+	pt.Insert(makeSample(4, []uint64{4}))
+
+	m := &profile.Mapping{ID: 1, File: "main", BuildID: "abcd"}
+	main := &profile.Function{ID: 1, Name: "main", Filename: "/src/main.go", StartLine: 10}
+	f := &profile.Function{ID: 2, Name: "f", Filename: "/src/f.go", StartLine: 3}
+	other := &profile.Function{ID: 3, Name: "other", Filename: "/src/other.go", StartLine: 30}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Mapping: m, Line: []profile.Line{{Function: main, Line: 12}}},
+		2: {ID: 2, Mapping: m, Line: []profile.Line{{Function: f, Line: 4}}},
+		// f is inlined into main.
+		3: {ID: 3, Mapping: m, Line: []profile.Line{{Function: f, Line: 5}, {Function: main, Line: 14}}},
+		4: {ID: 4, Mapping: m, Line: []profile.Line{{Function: other, Line: 31}}},
+	}}
+	p := &Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Unit: "count"}}}
+	tracer := trace.NewNoopTracerProvider().Tracer("")
+
+	sources := &fakeSourceFiles{m: map[string]string{
+		"abcd:/src/main.go": sourceText(16),
+	}}
+	src, err := GenerateSource(ctx, tracer, l, sources, p, "main")
+	require.NoError(t, err)
+
+	require.Equal(t, int64(10), src.Total)
+	require.Equal(t, "count", src.Unit)
+	require.Len(t, src.Files, 1)
+
+	file := src.Files[0]
+	require.Equal(t, "/src/main.go", file.Filename)
+	require.Equal(t, "abcd", file.BuildId)
+	require.True(t, file.HasSource)
+	require.Equal(t, int64(1), file.Flat)
+	// Like in pprof, the cumulative values of the lines are summed up.
+	require.Equal(t, int64(9), file.Cumulative)
+
+	// From the start of the function to the end of the file, which is
+	// shorter than the margin after the last sampled line.
+	require.Len(t, file.Lines, 7)
+	for i, line := range file.Lines {
+		require.Equal(t, int64(10+i), line.Line)
+		require.Equal(t, fmt.Sprintf("line %d", 10+i), line.Text)
+	}
+	require.Equal(t, &pb.SourceLine{Line: 12, Text: "line 12", Flat: 1, Cumulative: 6}, file.Lines[2])
+	require.Equal(t, &pb.SourceLine{Line: 14, Text: "line 14", Flat: 0, Cumulative: 3}, file.Lines[4])
+
+	// Without an uploaded source only the sampled lines are returned.
+	src, err = GenerateSource(ctx, tracer, l, nil, p, "f")
+	require.NoError(t, err)
+	require.Len(t, src.Files, 1)
+	require.False(t, src.Files[0].HasSource)
+	require.Equal(t, []*pb.SourceLine{
+		{Line: 4, Flat: 2, Cumulative: 2},
+		{Line: 5, Flat: 3, Cumulative: 3},
+	}, src.Files[0].Lines)
+
+	_, err = GenerateSource(ctx, tracer, l, sources, p, "unknown")
+	require.ErrorIs(t, err, ErrFunctionNotFound)
+}
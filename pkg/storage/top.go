@@ -0,0 +1,235 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/pprof/profile"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+// topKey identifies a row of the top table.
+// Symbolized locations are aggregated by function, unsymbolized ones by location.
+type topKey struct {
+	functionID uint64
+	locationID uint64
+}
+
+// GenerateTopTable aggregates the profile's values by function, sorted by flat value.
+// Functions appearing multiple times on a stack, e.g. due to recursion,
+// contribute the stack's value to their cumulative value only once.
+func GenerateTopTable(
+	ctx context.Context,
+	tracer trace.Tracer,
+	locations Locations,
+	p InstantProfile,
+) (*pb.Top, error) {
+	ctx, topSpan := tracer.Start(ctx, "generate-top-table")
+	defer topSpan.End()
+
+	meta := p.ProfileMeta()
+	pt := CopyInstantProfileTree(p.ProfileTree())
+
+	locs, err := getLocations(ctx, tracer, locations, pt)
+	if err != nil {
+		return nil, fmt.Errorf("get locations: %w", err)
+	}
+
+	top := &pb.Top{
+		Unit: meta.SampleType.Unit,
+	}
+
+	it := pt.Iterator()
+	if !it.HasMore() || !it.NextChild() {
+		return top, nil
+	}
+
+	n := it.At()
+	if n.LocationID() != uint64(0) {
+		return nil, errors.New("expected root node to be first node returned by iterator")
+	}
+	top.Total = n.CumulativeValue()
+
+	var (
+		rows = map[topKey]*pb.TopNode{}
+		// onStack counts how often a row is on the current stack.
+		onStack = map[*pb.TopNode]int{}
+		stack   [][]*pb.TopNode
+	)
+
+	if !it.StepInto() {
+		return top, nil
+	}
+
+	for it.HasMore() {
+		if it.NextChild() {
+			child := it.At()
+			id := child.LocationID()
+			l, found := locs[id]
+			if !found {
+				return nil, fmt.Errorf("could not find location with ID %d", id)
+			}
+
+			nodes := locationToTopNodes(rows, l)
+			for i, node := range nodes {
+				if i == 0 {
+					// The flat values belong to the innermost function only.
+					node.Flat += sumValues(child.FlatValues())
+					node.FlatDiff += sumValues(child.FlatDiffValues())
+				}
+				if onStack[node] == 0 {
+					node.Cumulative += child.CumulativeValue()
+					node.CumulativeDiff += child.CumulativeDiffValue()
+				}
+				onStack[node]++
+			}
+			stack = append(stack, nodes)
+
+			it.StepInto()
+			continue
+		}
+
+		it.StepUp()
+		if len(stack) > 0 {
+			for _, node := range stack[len(stack)-1] {
+				onStack[node]--
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	top.List = make([]*pb.TopNode, 0, len(rows))
+	for _, node := range rows {
+		top.List = append(top.List, node)
+	}
+	SortTopTable(top, pb.TopOptions_SORT_BY_FLAT_UNSPECIFIED)
+	top.Reported = int32(len(top.List))
+
+	return top, nil
+}
+
+// locationToTopNodes returns the rows of the location's lines, the innermost first.
+// Rows that don't exist yet are created.
+func locationToTopNodes(rows map[topKey]*pb.TopNode, location *profile.Location) []*pb.TopNode {
+	var mapping *pb.Mapping
+	if location.Mapping != nil {
+		mapping = &pb.Mapping{
+			Id:      location.Mapping.ID,
+			Start:   location.Mapping.Start,
+			Limit:   location.Mapping.Limit,
+			Offset:  location.Mapping.Offset,
+			File:    location.Mapping.File,
+			BuildId: location.Mapping.BuildID,
+		}
+	}
+
+	if len(location.Line) == 0 {
+		k := topKey{locationID: location.ID}
+		node, found := rows[k]
+		if !found {
+			node = &pb.TopNode{
+				Meta: &pb.TopNodeMeta{
+					Location: &pb.Location{
+						Id:        location.ID,
+						MappingId: mapping.GetId(),
+						Address:   location.Address,
+						IsFolded:  location.IsFolded,
+					},
+					Mapping: mapping,
+				},
+			}
+			rows[k] = node
+		}
+		return []*pb.TopNode{node}
+	}
+
+	nodes := make([]*pb.TopNode, 0, len(location.Line))
+	for _, line := range location.Line {
+		k := topKey{functionID: line.Function.ID}
+		node, found := rows[k]
+		if !found {
+			node = &pb.TopNode{
+				Meta: &pb.TopNodeMeta{
+					Mapping: mapping,
+					Function: &pb.Function{
+						Id:         line.Function.ID,
+						Name:       line.Function.Name,
+						SystemName: line.Function.SystemName,
+						Filename:   line.Function.Filename,
+						StartLine:  line.Function.StartLine,
+					},
+				},
+			}
+			rows[k] = node
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func sumValues(values []*ProfileTreeValueNode) int64 {
+	var sum int64
+	for _, v := range values {
+		sum += v.Value
+	}
+	return sum
+}
+
+// SortTopTable sorts the top table's list by the given value.
+// Values are sorted in descending order, diffs by their absolute value, names in ascending order.
+// Ties are broken by name to keep the order stable across requests.
+func SortTopTable(top *pb.Top, sortBy pb.TopOptions_SortBy) {
+	var value func(n *pb.TopNode) int64
+	switch sortBy {
+	case pb.TopOptions_SORT_BY_CUMULATIVE:
+		value = func(n *pb.TopNode) int64 { return n.Cumulative }
+	case pb.TopOptions_SORT_BY_FLAT_DIFF:
+		value = func(n *pb.TopNode) int64 { return abs(n.FlatDiff) }
+	case pb.TopOptions_SORT_BY_CUMULATIVE_DIFF:
+		value = func(n *pb.TopNode) int64 { return abs(n.CumulativeDiff) }
+	case pb.TopOptions_SORT_BY_NAME:
+		value = func(n *pb.TopNode) int64 { return 0 }
+	default:
+		value = func(n *pb.TopNode) int64 { return n.Flat }
+	}
+
+	sort.SliceStable(top.List, func(i, j int) bool {
+		a, b := top.List[i], top.List[j]
+		if va, vb := value(a), value(b); va != vb {
+			return va > vb
+		}
+		return topNodeName(a) < topNodeName(b)
+	})
+}
+
+// topNodeName is the function name of the node, or its hex address if it is unsymbolized.
+func topNodeName(n *pb.TopNode) string {
+	if n.Meta.Function != nil {
+		return n.Meta.Function.Name
+	}
+	return fmt.Sprintf("%#x", n.Meta.Location.GetAddress())
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
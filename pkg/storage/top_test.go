@@ -0,0 +1,122 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/query/v1alpha1"
+)
+
+func TestGenerateTopTable(t *testing.T) {
+	ctx := context.Background()
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(2, []uint64{2, 1}))
+	pt.Insert(makeSample(1, []uint64{5, 3, 2, 1}))
+	pt.Insert(makeSample(3, []uint64{4, 3, 2, 1}))
+	pt.Insert(makeSample(4, []uint64{3, 3, 1}))
+
+	f1 := &profile.Function{ID: 1, Name: "1"}
+	f2 := &profile.Function{ID: 2, Name: "2"}
+	f3 := &profile.Function{ID: 3, Name: "3"}
+	f4 := &profile.Function{ID: 4, Name: "4"}
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: f1}}},
+		2: {ID: 2, Line: []profile.Line{{Function: f2}}},
+		3: {ID: 3, Line: []profile.Line{{Function: f3}}},
+		// 4 is inlined into 2, which is already on the stack.
+		4: {ID: 4, Line: []profile.Line{{Function: f4}, {Function: f2}}},
+		// 5 is not symbolized.
+		5: {ID: 5, Address: 0x5},
+	}}
+
+	top, err := GenerateTopTable(
+		ctx,
+		trace.NewNoopTracerProvider().Tracer(""),
+		l,
+		&Profile{Tree: pt, Meta: InstantProfileMeta{SampleType: ValueType{Unit: "count"}}},
+	)
+	require.NoError(t, err)
+
+	fn := func(id uint64, name string) *pb.TopNodeMeta {
+		return &pb.TopNodeMeta{Function: &pb.Function{Id: id, Name: name}}
+	}
+	require.Equal(t, &pb.Top{
+		Total:    10,
+		Unit:     "count",
+		Reported: 5,
+		List: []*pb.TopNode{
+			// Recursive calls are accounted only once.
+			{Meta: fn(3, "3"), Flat: 4, Cumulative: 8},
+			{Meta: fn(4, "4"), Flat: 3, Cumulative: 3},
+			{Meta: fn(2, "2"), Flat: 2, Cumulative: 6},
+			{Meta: &pb.TopNodeMeta{Location: &pb.Location{Id: 5, Address: 0x5}}, Flat: 1, Cumulative: 1},
+			{Meta: fn(1, "1"), Flat: 0, Cumulative: 10},
+		},
+	}, top)
+
+	SortTopTable(top, pb.TopOptions_SORT_BY_CUMULATIVE)
+	names := []string{}
+	for _, n := range top.List {
+		names = append(names, topNodeName(n))
+	}
+	require.Equal(t, []string{"1", "3", "2", "4", "0x5"}, names)
+
+	SortTopTable(top, pb.TopOptions_SORT_BY_NAME)
+	names = names[:0]
+	for _, n := range top.List {
+		names = append(names, topNodeName(n))
+	}
+	require.Equal(t, []string{"0x5", "1", "2", "3", "4"}, names)
+}
+
+func TestGenerateTopTableDiff(t *testing.T) {
+	ctx := context.Background()
+
+	a := NewProfileTree()
+	a.Insert(makeSample(2, []uint64{2, 1}))
+	a.Insert(makeSample(1, []uint64{3, 1}))
+
+	b := NewProfileTree()
+	b.Insert(makeSample(1, []uint64{2, 1}))
+	b.Insert(makeSample(4, []uint64{3, 1}))
+
+	p, err := NewDiffProfile(&Profile{Tree: a}, &Profile{Tree: b})
+	require.NoError(t, err)
+
+	l := &fakeLocations{m: map[uint64]*profile.Location{
+		1: {ID: 1, Line: []profile.Line{{Function: &profile.Function{ID: 1, Name: "1"}}}},
+		2: {ID: 2, Line: []profile.Line{{Function: &profile.Function{ID: 2, Name: "2"}}}},
+		3: {ID: 3, Line: []profile.Line{{Function: &profile.Function{ID: 3, Name: "3"}}}},
+	}}
+
+	top, err := GenerateTopTable(ctx, trace.NewNoopTracerProvider().Tracer(""), l, p)
+	require.NoError(t, err)
+
+	SortTopTable(top, pb.TopOptions_SORT_BY_FLAT_DIFF)
+	require.Len(t, top.List, 3)
+	require.Equal(t, "3", top.List[0].Meta.Function.Name)
+	require.Equal(t, int64(4), top.List[0].Flat)
+	require.Equal(t, int64(3), top.List[0].FlatDiff)
+	require.Equal(t, "2", top.List[1].Meta.Function.Name)
+	require.Equal(t, int64(-1), top.List[1].FlatDiff)
+	require.Equal(t, "1", top.List[2].Meta.Function.Name)
+	require.Equal(t, int64(2), top.List[2].CumulativeDiff)
+}
@@ -0,0 +1,680 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb/encoding"
+	"github.com/prometheus/prometheus/tsdb/fileutil"
+	"github.com/prometheus/prometheus/tsdb/record"
+	"github.com/prometheus/prometheus/tsdb/wal"
+)
+
+// walRecordType represents the data type of a WAL record.
+type walRecordType uint8
+
+const (
+	// walRecordInvalid is returned for unrecognised WAL record types.
+	walRecordInvalid walRecordType = 255
+	// walRecordSeries is used to match WAL records of type series.
+	walRecordSeries walRecordType = 1
+	// walRecordProfiles is used to match WAL records of type profiles.
+	walRecordProfiles walRecordType = 2
+)
+
+// walSeries is the WAL record of a newly created series.
+type walSeries struct {
+	Ref    uint64
+	Labels labels.Labels
+}
+
+// walProfile is the WAL record of a profile appended to a series.
+type walProfile struct {
+	Ref     uint64
+	Profile *Profile
+}
+
+func walRecordTypeOf(rec []byte) walRecordType {
+	if len(rec) < 1 {
+		return walRecordInvalid
+	}
+	switch t := walRecordType(rec[0]); t {
+	case walRecordSeries, walRecordProfiles:
+		return t
+	}
+	return walRecordInvalid
+}
+
+// encodeWALSeries appends the encoded series to b and returns the resulting slice.
+func encodeWALSeries(series []walSeries, b []byte) []byte {
+	buf := encoding.Encbuf{B: b}
+	buf.PutByte(byte(walRecordSeries))
+
+	for _, s := range series {
+		buf.PutUvarint64(s.Ref)
+		buf.PutUvarint(len(s.Labels))
+		for _, l := range s.Labels {
+			buf.PutUvarintStr(l.Name)
+			buf.PutUvarintStr(l.Value)
+		}
+	}
+	return buf.Get()
+}
+
+// decodeWALSeries appends the series in rec to the given slice.
+func decodeWALSeries(rec []byte, series []walSeries) ([]walSeries, error) {
+	dec := encoding.Decbuf{B: rec}
+	if walRecordType(dec.Byte()) != walRecordSeries {
+		return nil, errors.New("invalid record type")
+	}
+
+	for len(dec.B) > 0 && dec.Err() == nil {
+		ref := dec.Uvarint64()
+		lset := make(labels.Labels, dec.Uvarint())
+		for i := range lset {
+			lset[i].Name = dec.UvarintStr()
+			lset[i].Value = dec.UvarintStr()
+		}
+		series = append(series, walSeries{Ref: ref, Labels: lset})
+	}
+	if dec.Err() != nil {
+		return nil, dec.Err()
+	}
+	if len(dec.B) > 0 {
+		return nil, fmt.Errorf("unexpected %d bytes left in entry", len(dec.B))
+	}
+	return series, nil
+}
+
+// encodeWALProfiles appends the encoded profiles to b and returns the resulting slice.
+func encodeWALProfiles(profiles []walProfile, b []byte) []byte {
+	buf := encoding.Encbuf{B: b}
+	buf.PutByte(byte(walRecordProfiles))
+
+	for _, p := range profiles {
+		buf.PutUvarint64(p.Ref)
+
+		m := p.Profile.Meta
+		buf.PutUvarintStr(m.PeriodType.Type)
+		buf.PutUvarintStr(m.PeriodType.Unit)
+		buf.PutUvarintStr(m.SampleType.Type)
+		buf.PutUvarintStr(m.SampleType.Unit)
+		buf.PutVarint64(m.Timestamp)
+		buf.PutVarint64(m.Duration)
+		buf.PutVarint64(m.Period)
+
+		if p.Profile.Tree == nil || p.Profile.Tree.Roots == nil {
+			buf.PutByte(0)
+			continue
+		}
+		buf.PutByte(1)
+		encodeProfileTreeNode(&buf, p.Profile.Tree.Roots)
+	}
+	return buf.Get()
+}
+
+func encodeProfileTreeNode(buf *encoding.Encbuf, n *ProfileTreeNode) {
+	buf.PutUvarint64(n.locationID)
+	encodeProfileTreeValueNodes(buf, n.flatValues)
+	encodeProfileTreeValueNodes(buf, n.cumulativeValues)
+
+	buf.PutUvarint(len(n.Children))
+	for _, c := range n.Children {
+		encodeProfileTreeNode(buf, c)
+	}
+}
+
+func encodeProfileTreeValueNodes(buf *encoding.Encbuf, values []*ProfileTreeValueNode) {
+	buf.PutUvarint(len(values))
+	for _, v := range values {
+		buf.PutVarint64(v.Value)
+		encodeStringsMap(buf, v.Label)
+		encodeInt64sMap(buf, v.NumLabel)
+		encodeStringsMap(buf, v.NumUnit)
+	}
+}
+
+// decodeWALProfiles appends the profiles in rec to the given slice.
+func decodeWALProfiles(rec []byte, profiles []walProfile) ([]walProfile, error) {
+	dec := encoding.Decbuf{B: rec}
+	if walRecordType(dec.Byte()) != walRecordProfiles {
+		return nil, errors.New("invalid record type")
+	}
+
+	for len(dec.B) > 0 && dec.Err() == nil {
+		ref := dec.Uvarint64()
+
+		p := &Profile{}
+		p.Meta.PeriodType = ValueType{Type: dec.UvarintStr(), Unit: dec.UvarintStr()}
+		p.Meta.SampleType = ValueType{Type: dec.UvarintStr(), Unit: dec.UvarintStr()}
+		p.Meta.Timestamp = dec.Varint64()
+		p.Meta.Duration = dec.Varint64()
+		p.Meta.Period = dec.Varint64()
+
+		if dec.Byte() == 1 {
+			p.Tree = &ProfileTree{Roots: decodeProfileTreeNode(&dec, nil)}
+		}
+
+		profiles = append(profiles, walProfile{Ref: ref, Profile: p})
+	}
+	if dec.Err() != nil {
+		return nil, dec.Err()
+	}
+	if len(dec.B) > 0 {
+		return nil, fmt.Errorf("unexpected %d bytes left in entry", len(dec.B))
+	}
+	return profiles, nil
+}
+
+// decodeProfileTreeNode decodes a node and its children.
+// The keys of the value nodes are populated from the location IDs of the node
+// and its ancestors, the same way ProfileTree.Insert does.
+func decodeProfileTreeNode(dec *encoding.Decbuf, ancestorIDs []uint64) *ProfileTreeNode {
+	locationID := dec.Uvarint64()
+	locationIDs := append([]uint64{locationID}, ancestorIDs...)
+
+	n := &ProfileTreeNode{
+		locationID:       locationID,
+		flatValues:       decodeProfileTreeValueNodes(dec, locationIDs),
+		cumulativeValues: decodeProfileTreeValueNodes(dec, locationIDs),
+	}
+
+	numChildren := dec.Uvarint()
+	if numChildren > 0 {
+		n.Children = make([]*ProfileTreeNode, 0, numChildren)
+	}
+	for i := 0; i < numChildren && dec.Err() == nil; i++ {
+		n.Children = append(n.Children, decodeProfileTreeNode(dec, locationIDs))
+	}
+	return n
+}
+
+func decodeProfileTreeValueNodes(dec *encoding.Decbuf, locationIDs []uint64) []*ProfileTreeValueNode {
+	num := dec.Uvarint()
+	if num == 0 {
+		return nil
+	}
+	values := make([]*ProfileTreeValueNode, 0, num)
+	for i := 0; i < num && dec.Err() == nil; i++ {
+		v := &ProfileTreeValueNode{
+			Value:    dec.Varint64(),
+			Label:    decodeStringsMap(dec),
+			NumLabel: decodeInt64sMap(dec),
+			NumUnit:  decodeStringsMap(dec),
+		}
+		v.Key(locationIDs...)
+		values = append(values, v)
+	}
+	return values
+}
+
+// Init replays the WAL into the head.
+// Profiles with a timestamp before minValidTime of their series are skipped,
+// as they have already been persisted into blocks.
+func (h *Head) Init(minValidTime func(lset labels.Labels) int64) error {
+	if h.wal == nil {
+		return nil
+	}
+
+	start := time.Now()
+	refs := map[uint64]*MemSeries{}
+
+	dir, startFrom, err := wal.LastCheckpoint(h.wal.Dir())
+	if err != nil && err != record.ErrNotFound {
+		return fmt.Errorf("find last checkpoint: %w", err)
+	}
+	if err == nil {
+		sr, err := wal.NewSegmentsReader(dir)
+		if err != nil {
+			return fmt.Errorf("open checkpoint: %w", err)
+		}
+		err = h.loadWAL(wal.NewReader(sr), refs, minValidTime)
+		sr.Close()
+		if err != nil {
+			return fmt.Errorf("load checkpoint: %w", err)
+		}
+		startFrom++
+	}
+
+	_, last, err := wal.Segments(h.wal.Dir())
+	if err != nil {
+		return fmt.Errorf("find last segment: %w", err)
+	}
+
+	// Segments are read one by one, so a corrupted segment can be repaired.
+	for i := startFrom; i <= last; i++ {
+		s, err := wal.OpenReadSegment(wal.SegmentName(h.wal.Dir(), i))
+		if err != nil {
+			return fmt.Errorf("open WAL segment %d: %w", i, err)
+		}
+
+		err = h.loadWAL(wal.NewReader(wal.NewSegmentBufReader(s)), refs, minValidTime)
+		s.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	h.walReplayDuration.Set(time.Since(start).Seconds())
+
+	return nil
+}
+
+func (h *Head) loadWAL(r *wal.Reader, refs map[uint64]*MemSeries, minValidTime func(lset labels.Labels) int64) error {
+	var (
+		ctx      = context.Background()
+		series   []walSeries
+		profiles []walProfile
+		minValid = map[uint64]int64{}
+		err      error
+	)
+
+	for r.Next() {
+		rec := r.Record()
+
+		switch walRecordTypeOf(rec) {
+		case walRecordSeries:
+			series, err = decodeWALSeries(rec, series[:0])
+			if err != nil {
+				return &wal.CorruptionErr{
+					Err:     fmt.Errorf("decode series: %w", err),
+					Segment: r.Segment(),
+					Offset:  r.Offset(),
+				}
+			}
+
+			for _, s := range series {
+				ms, created := h.series.getOrCreateWithID(s.Ref, s.Labels.Hash(), s.Labels, h.chunkPool)
+				if created {
+					h.numSeries.Inc()
+					h.postings.Add(ms.id, s.Labels)
+				}
+				ms.tracer = h.tracer
+				ms.samplesAppended = h.profilesAppended
+				ms.walLogged = true
+				refs[s.Ref] = ms
+
+				if minValidTime != nil {
+					minValid[s.Ref] = minValidTime(s.Labels)
+				}
+				if h.lastSeriesID.Load() < s.Ref {
+					h.lastSeriesID.Store(s.Ref)
+				}
+			}
+
+		case walRecordProfiles:
+			profiles, err = decodeWALProfiles(rec, profiles[:0])
+			if err != nil {
+				return &wal.CorruptionErr{
+					Err:     fmt.Errorf("decode profiles: %w", err),
+					Segment: r.Segment(),
+					Offset:  r.Offset(),
+				}
+			}
+
+			for _, p := range profiles {
+				ms, ok := refs[p.Ref]
+				if !ok {
+					// The series has been dropped by a checkpoint.
+					continue
+				}
+				if mt, ok := minValid[p.Ref]; ok && p.Profile.Meta.Timestamp < mt {
+					continue
+				}
+
+				h.initTime(p.Profile.Meta.Timestamp)
+
+				app, err := ms.Appender()
+				if err != nil {
+					return err
+				}
+				if err := app.Append(ctx, p.Profile); err != nil && !errors.Is(err, ErrOutOfOrderSample) {
+					return fmt.Errorf("replay profile of series %s: %w", ms.lset, err)
+				}
+			}
+		}
+	}
+
+	return r.Err()
+}
+
+// addWALLocationIDs adds the IDs of all locations referenced by the profiles
+// in the WAL to ids. After a crash these profiles are replayed into the head,
+// even if the head has already truncated them from memory.
+func (h *Head) addWALLocationIDs(ids map[uint64]struct{}) error {
+	if h.wal == nil {
+		return nil
+	}
+
+	h.walMtx.Lock()
+	defer h.walMtx.Unlock()
+
+	dir, startFrom, err := wal.LastCheckpoint(h.wal.Dir())
+	if err != nil && err != record.ErrNotFound {
+		return fmt.Errorf("find last checkpoint: %w", err)
+	}
+	if err == nil {
+		sr, err := wal.NewSegmentsReader(dir)
+		if err != nil {
+			return fmt.Errorf("open checkpoint: %w", err)
+		}
+		r := wal.NewReader(sr)
+		err = addRecordLocationIDs(r, ids)
+		sr.Close()
+		if err == nil {
+			err = r.Err()
+		}
+		if err != nil {
+			return fmt.Errorf("read checkpoint: %w", err)
+		}
+		startFrom++
+	}
+
+	_, last, err := wal.Segments(h.wal.Dir())
+	if err != nil {
+		return fmt.Errorf("find last segment: %w", err)
+	}
+
+	metrics := wal.NewLiveReaderMetrics(nil)
+	for i := startFrom; i <= last; i++ {
+		s, err := wal.OpenReadSegment(wal.SegmentName(h.wal.Dir(), i))
+		if err != nil {
+			return fmt.Errorf("open WAL segment %d: %w", i, err)
+		}
+
+		// The last segment is still written to, the live reader stops at
+		// a record that is only partially written instead of failing.
+		r := wal.NewLiveReader(log.NewNopLogger(), metrics, s)
+		err = addRecordLocationIDs(r, ids)
+		s.Close()
+		if err == nil && r.Err() != io.EOF {
+			err = r.Err()
+		}
+		if err != nil {
+			return fmt.Errorf("read WAL segment %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// addRecordLocationIDs adds the IDs of all locations referenced by the
+// profile records read by r to ids.
+func addRecordLocationIDs(r interface {
+	Next() bool
+	Record() []byte
+}, ids map[uint64]struct{}) error {
+	var (
+		profiles []walProfile
+		err      error
+	)
+	for r.Next() {
+		rec := r.Record()
+		if walRecordTypeOf(rec) != walRecordProfiles {
+			continue
+		}
+		profiles, err = decodeWALProfiles(rec, profiles[:0])
+		if err != nil {
+			return fmt.Errorf("decode profiles: %w", err)
+		}
+		for _, p := range profiles {
+			if p.Profile.Tree != nil && p.Profile.Tree.Roots != nil {
+				addProfileTreeNodeLocationIDs(p.Profile.Tree.Roots, ids)
+			}
+		}
+	}
+	return nil
+}
+
+func addProfileTreeNodeLocationIDs(n *ProfileTreeNode, ids map[uint64]struct{}) {
+	if n.locationID != 0 {
+		ids[n.locationID] = struct{}{}
+	}
+	for _, c := range n.Children {
+		addProfileTreeNodeLocationIDs(c, ids)
+	}
+}
+
+// logSeries logs the series to the WAL, unless it has been logged already.
+func (h *Head) logSeries(s *MemSeries) error {
+	if h.wal == nil {
+		return nil
+	}
+
+	s.walMtx.Lock()
+	defer s.walMtx.Unlock()
+
+	if s.walLogged {
+		return nil
+	}
+	if err := h.wal.Log(encodeWALSeries([]walSeries{{Ref: s.id, Labels: s.lset}}, nil)); err != nil {
+		return err
+	}
+	s.walLogged = true
+	return nil
+}
+
+func (h *Head) logProfile(s *MemSeries, p *Profile) error {
+	if h.wal == nil {
+		return nil
+	}
+	return h.wal.Log(encodeWALProfiles([]walProfile{{Ref: s.id, Profile: p}}, nil))
+}
+
+// truncateWAL checkpoints the oldest two thirds of the WAL segments,
+// dropping all profiles before mint, and removes the checkpointed segments.
+func (h *Head) truncateWAL(mint int64) error {
+	if h.wal == nil || mint <= h.lastWALTruncationTime.Load() {
+		return nil
+	}
+
+	h.walMtx.Lock()
+	defer h.walMtx.Unlock()
+
+	first, last, err := wal.Segments(h.wal.Dir())
+	if err != nil {
+		return fmt.Errorf("get segment range: %w", err)
+	}
+	last-- // Never consider the last segment for the checkpoint, as it's still written to.
+	if last < 0 {
+		return nil // No segments yet.
+	}
+	// The lower two thirds of segments should contain mostly obsolete samples.
+	// If we have less than two segments, it's not worth checkpointing yet.
+	last = first + (last-first)*2/3
+	if last <= first {
+		return nil
+	}
+
+	start := time.Now()
+
+	keep := func(id uint64) bool {
+		return h.series.getByID(id) != nil
+	}
+	h.checkpointCreationTotal.Inc()
+	if err := checkpoint(h.wal, first, last, keep, mint); err != nil {
+		h.checkpointCreationFail.Inc()
+		return fmt.Errorf("create checkpoint: %w", err)
+	}
+	if err := h.wal.Truncate(last + 1); err != nil {
+		return fmt.Errorf("truncate WAL: %w", err)
+	}
+	if err := wal.DeleteCheckpoints(h.wal.Dir(), last); err != nil {
+		return fmt.Errorf("delete old checkpoints: %w", err)
+	}
+
+	h.walTruncateDuration.Observe(time.Since(start).Seconds())
+	h.lastWALTruncationTime.Store(mint)
+
+	return nil
+}
+
+// checkpoint creates a compacted checkpoint of segments in range [from, to] in the given WAL.
+// It includes the most recent checkpoint if it exists.
+// All series not satisfying keep and profiles below mint are dropped.
+// This is the equivalent of wal.Checkpoint for Parca's record types.
+func checkpoint(w *wal.WAL, from, to int, keep func(id uint64) bool, mint int64) error {
+	var sgmRange []wal.SegmentRange
+	dir, idx, err := wal.LastCheckpoint(w.Dir())
+	if err != nil && err != record.ErrNotFound {
+		return fmt.Errorf("find last checkpoint: %w", err)
+	}
+	if err == nil {
+		if from > idx+1 {
+			return fmt.Errorf("unexpected gap to last checkpoint. expected:%v, requested:%v", idx+1, from)
+		}
+		// Ignore WAL files below the checkpoint. They shouldn't exist to begin with.
+		from = idx + 1
+
+		sgmRange = append(sgmRange, wal.SegmentRange{Dir: dir, Last: math.MaxInt32})
+	}
+	sgmRange = append(sgmRange, wal.SegmentRange{Dir: w.Dir(), First: from, Last: to})
+
+	sgmReader, err := wal.NewSegmentsRangeReader(sgmRange...)
+	if err != nil {
+		return fmt.Errorf("create segment reader: %w", err)
+	}
+	defer sgmReader.Close()
+
+	cpdir := filepath.Join(w.Dir(), fmt.Sprintf("checkpoint.%08d", to))
+	cpdirtmp := cpdir + ".tmp"
+
+	if err := os.RemoveAll(cpdirtmp); err != nil {
+		return fmt.Errorf("remove previous temporary checkpoint dir: %w", err)
+	}
+	cp, err := wal.New(nil, nil, cpdirtmp, w.CompressionEnabled())
+	if err != nil {
+		return fmt.Errorf("open checkpoint: %w", err)
+	}
+
+	// Ensures that an early return caused by an error doesn't leave any tmp files.
+	defer func() {
+		cp.Close()
+		os.RemoveAll(cpdirtmp)
+	}()
+
+	if err := writeCheckpoint(cp, wal.NewReader(sgmReader), keep, mint); err != nil {
+		return err
+	}
+	if err := cp.Close(); err != nil {
+		return fmt.Errorf("close checkpoint: %w", err)
+	}
+
+	// Sync temporary directory before rename.
+	df, err := fileutil.OpenDir(cpdirtmp)
+	if err != nil {
+		return fmt.Errorf("open temporary checkpoint directory: %w", err)
+	}
+	if err := df.Sync(); err != nil {
+		df.Close()
+		return fmt.Errorf("sync temporary checkpoint directory: %w", err)
+	}
+	if err := df.Close(); err != nil {
+		return fmt.Errorf("close temporary checkpoint directory: %w", err)
+	}
+
+	if err := fileutil.Replace(cpdirtmp, cpdir); err != nil {
+		return fmt.Errorf("rename checkpoint directory: %w", err)
+	}
+
+	return nil
+}
+
+func writeCheckpoint(w *wal.WAL, r *wal.Reader, keep func(id uint64) bool, mint int64) error {
+	var (
+		series   []walSeries
+		profiles []walProfile
+		buf      []byte
+		recs     [][]byte
+		err      error
+	)
+	for r.Next() {
+		// We don't reset the buffer since we batch up multiple records
+		// before writing them to the checkpoint.
+		// Remember where the record for this iteration starts.
+		start := len(buf)
+		rec := r.Record()
+
+		switch walRecordTypeOf(rec) {
+		case walRecordSeries:
+			series, err = decodeWALSeries(rec, series[:0])
+			if err != nil {
+				return fmt.Errorf("decode series: %w", err)
+			}
+			// Drop irrelevant series in place.
+			repl := series[:0]
+			for _, s := range series {
+				if keep(s.Ref) {
+					repl = append(repl, s)
+				}
+			}
+			if len(repl) > 0 {
+				buf = encodeWALSeries(repl, buf)
+			}
+
+		case walRecordProfiles:
+			profiles, err = decodeWALProfiles(rec, profiles[:0])
+			if err != nil {
+				return fmt.Errorf("decode profiles: %w", err)
+			}
+			// Drop irrelevant profiles in place.
+			repl := profiles[:0]
+			for _, p := range profiles {
+				if p.Profile.Meta.Timestamp >= mint && keep(p.Ref) {
+					repl = append(repl, p)
+				}
+			}
+			if len(repl) > 0 {
+				buf = encodeWALProfiles(repl, buf)
+			}
+
+		default:
+			// Unknown record type, probably from a future version.
+			continue
+		}
+		if len(buf[start:]) == 0 {
+			continue // All contents discarded.
+		}
+		recs = append(recs, buf[start:])
+
+		// Flush records in 1 MB increments.
+		if len(buf) > 1*1024*1024 {
+			if err := w.Log(recs...); err != nil {
+				return fmt.Errorf("flush records: %w", err)
+			}
+			buf, recs = buf[:0], recs[:0]
+		}
+	}
+	// If we hit any corruption during checkpointing, repairing is not an option.
+	// The head won't know which series records are lost.
+	if r.Err() != nil {
+		return fmt.Errorf("read segments: %w", r.Err())
+	}
+
+	// Flush remaining records.
+	if err := w.Log(recs...); err != nil {
+		return fmt.Errorf("flush records: %w", err)
+	}
+	return nil
+}
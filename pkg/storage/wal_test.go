@@ -0,0 +1,327 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/tsdb/wal"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/atomic"
+)
+
+func TestWALRecords(t *testing.T) {
+	series := []walSeries{
+		{Ref: 1, Labels: labels.FromStrings("__name__", "heap", "job", "parca")},
+		{Ref: 123, Labels: labels.FromStrings("__name__", "cpu")},
+	}
+	decodedSeries, err := decodeWALSeries(encodeWALSeries(series, nil), nil)
+	require.NoError(t, err)
+	require.Equal(t, series, decodedSeries)
+
+	s := makeSample(2, []uint64{3, 2, 1})
+	s.Label = map[string][]string{"foo": {"bar", "baz"}}
+	s.NumLabel = map[string][]int64{"bytes": {512}}
+	s.NumUnit = map[string][]string{"bytes": {"bytes"}}
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+	pt.Insert(s)
+
+	profiles := []walProfile{{
+		Ref: 1,
+		Profile: &Profile{
+			Tree: pt,
+			Meta: InstantProfileMeta{
+				PeriodType: ValueType{Type: "space", Unit: "bytes"},
+				SampleType: ValueType{Type: "alloc_space", Unit: "bytes"},
+				Timestamp:  1234,
+				Duration:   10_000,
+				Period:     524288,
+			},
+		},
+	}, {
+		Ref:     123,
+		Profile: &Profile{Meta: InstantProfileMeta{Timestamp: 1235}},
+	}}
+
+	rec := encodeWALProfiles(profiles, nil)
+	require.Equal(t, walRecordProfiles, walRecordTypeOf(rec))
+
+	decodedProfiles, err := decodeWALProfiles(rec, nil)
+	require.NoError(t, err)
+	require.Len(t, decodedProfiles, 2)
+	require.Equal(t, profiles[0].Profile.Meta, decodedProfiles[0].Profile.Meta)
+	require.Nil(t, decodedProfiles[1].Profile.Tree)
+
+	// Encoding the decoded profiles again must result in the same record.
+	require.Equal(t, rec, encodeWALProfiles(decodedProfiles, nil))
+}
+
+func TestHeadWALReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-wal")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	ctx := context.Background()
+	lset := labels.FromStrings("foo", "bar")
+
+	w, err := wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h := NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+
+	app, err := h.Appender(ctx, lset)
+	require.NoError(t, err)
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+
+	// Write 4 segments with 10 profiles each.
+	for i := int64(1); i <= 40; i++ {
+		require.NoError(t, app.Append(ctx, &Profile{
+			Tree: pt,
+			Meta: InstantProfileMeta{Timestamp: i},
+		}))
+		if i%10 == 0 && i < 40 {
+			require.NoError(t, w.NextSegment())
+		}
+	}
+
+	// The first two segments are checkpointed, dropping all profiles before 15.
+	require.NoError(t, h.Truncate(15))
+	require.NoError(t, h.Close())
+
+	_, err = os.Stat(filepath.Join(dir, "checkpoint.00000001"))
+	require.NoError(t, err)
+	first, last, err := wal.Segments(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, first)
+	require.Equal(t, 3, last)
+
+	w, err = wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h = NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+	t.Cleanup(func() {
+		h.Close()
+	})
+
+	require.Equal(t, int64(15), h.MinTime())
+	require.Equal(t, int64(40), h.MaxTime())
+
+	set := h.Querier(ctx, 0, 40).Select(nil, labels.MustNewMatcher(labels.MatchEqual, "foo", "bar"))
+	require.True(t, set.Next())
+	require.Equal(t, lset, set.At().Labels())
+
+	it := set.At().Iterator()
+	ts := int64(15)
+	for it.Next() {
+		require.Equal(t, ts, it.At().ProfileMeta().Timestamp)
+		ts++
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, int64(41), ts)
+	require.False(t, set.Next())
+
+	// Appending to the replayed series continues where the WAL left off.
+	app, err = h.Appender(ctx, lset)
+	require.NoError(t, err)
+	require.Equal(t, ErrOutOfOrderSample, app.Append(ctx, &Profile{Tree: pt, Meta: InstantProfileMeta{Timestamp: 40}}))
+	require.NoError(t, app.Append(ctx, &Profile{Tree: pt, Meta: InstantProfileMeta{Timestamp: 41}}))
+}
+
+func TestHeadWALLocationIDs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-wal")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	ctx := context.Background()
+
+	w, err := wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h := NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+
+	app, err := h.Appender(ctx, labels.FromStrings("foo", "bar"))
+	require.NoError(t, err)
+
+	// Write 4 segments with 10 profiles each, every profile references
+	// location 1 and a location of its own.
+	for i := int64(1); i <= 40; i++ {
+		pt := NewProfileTree()
+		pt.Insert(makeSample(1, []uint64{100 + uint64(i), 1}))
+		require.NoError(t, app.Append(ctx, &Profile{
+			Tree: pt,
+			Meta: InstantProfileMeta{Timestamp: i},
+		}))
+		if i%10 == 0 && i < 40 {
+			require.NoError(t, w.NextSegment())
+		}
+	}
+
+	// The first two segments are checkpointed, dropping all profiles before 15.
+	require.NoError(t, h.Truncate(15))
+
+	expected := map[uint64]struct{}{1: {}}
+	for i := uint64(15); i <= 40; i++ {
+		expected[100+i] = struct{}{}
+	}
+	ids := map[uint64]struct{}{}
+	require.NoError(t, h.addWALLocationIDs(ids))
+	require.Equal(t, expected, ids)
+
+	// Crash without closing the WAL.
+	w, err = wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h = NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	t.Cleanup(func() {
+		h.Close()
+	})
+
+	// Before the WAL is replayed only the WAL references the locations,
+	// afterwards the replayed profiles reference the same ones.
+	ids = map[uint64]struct{}{}
+	h.addLocationIDs(ids)
+	require.Empty(t, ids)
+	require.NoError(t, h.addWALLocationIDs(ids))
+	require.Equal(t, expected, ids)
+
+	require.NoError(t, h.Init(nil))
+	ids = map[uint64]struct{}{}
+	h.addLocationIDs(ids)
+	require.Equal(t, expected, ids)
+}
+
+// countReplayedProfiles replays the WAL in dir into a new head and returns
+// the number of profiles of the series.
+func countReplayedProfiles(t *testing.T, dir string, lset labels.Labels) int {
+	w, err := wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h := NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+	defer h.Close()
+
+	set := h.Querier(context.Background(), math.MinInt64, math.MaxInt64).Select(nil, labels.MustNewMatcher(labels.MatchEqual, "foo", "bar"))
+	n := 0
+	for set.Next() {
+		require.Equal(t, lset, set.At().Labels())
+		it := set.At().Iterator()
+		for it.Next() {
+			n++
+		}
+		require.NoError(t, it.Err())
+	}
+	require.NoError(t, set.Err())
+	return n
+}
+
+func TestHeadWALConcurrentAppends(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-wal")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	ctx := context.Background()
+	lset := labels.FromStrings("foo", "bar")
+
+	w, err := wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h := NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+	h.initTime(0)
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+
+	var (
+		wg        sync.WaitGroup
+		timestamp atomic.Int64
+		appended  atomic.Int64
+	)
+	apps := make([]Appender, 8)
+	for i := range apps {
+		apps[i], err = h.Appender(ctx, lset)
+		require.NoError(t, err)
+	}
+	for _, app := range apps {
+		app := app
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				err := app.Append(ctx, &Profile{Tree: pt, Meta: InstantProfileMeta{Timestamp: timestamp.Inc()}})
+				if err == ErrOutOfOrderSample {
+					continue
+				}
+				require.NoError(t, err)
+				appended.Inc()
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, h.Close())
+
+	// All profiles appended concurrently are replayed.
+	require.Equal(t, int(appended.Load()), countReplayedProfiles(t, dir, lset))
+}
+
+func TestHeadWALLogSeriesRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parca-wal")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	ctx := context.Background()
+	lset := labels.FromStrings("foo", "bar")
+
+	w, err := wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	h := NewHead(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), &HeadOptions{WAL: w})
+	require.NoError(t, h.Init(nil))
+	h.initTime(0)
+
+	// The series is created, but logging it fails.
+	require.NoError(t, w.Close())
+	_, err = h.Appender(ctx, lset)
+	require.Error(t, err)
+
+	// The next appender of the series logs it.
+	h.wal, err = wal.New(nil, nil, dir, true)
+	require.NoError(t, err)
+	app, err := h.Appender(ctx, lset)
+	require.NoError(t, err)
+
+	pt := NewProfileTree()
+	pt.Insert(makeSample(1, []uint64{2, 1}))
+	require.NoError(t, app.Append(ctx, &Profile{Tree: pt, Meta: InstantProfileMeta{Timestamp: 1}}))
+	require.NoError(t, h.Close())
+
+	require.Equal(t, 1, countReplayedProfiles(t, dir, lset))
+}
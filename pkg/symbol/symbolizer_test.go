@@ -197,7 +197,8 @@ func TestRealSymbolizer(t *testing.T) {
 		mStr.Close()
 	})
 
-	db := storage.OpenDB(prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	db, err := storage.OpenDB(log.NewNopLogger(), prometheus.NewRegistry(), trace.NewNoopTracerProvider().Tracer(""), nil)
+	require.NoError(t, err)
 	pStr := profilestore.NewProfileStore(
 		log.NewNopLogger(),
 		trace.NewNoopTracerProvider().Tracer(""),
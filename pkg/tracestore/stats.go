@@ -0,0 +1,255 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracestore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/trace/v1alpha1"
+)
+
+// The event types of the trace format written by Go 1.11 to 1.21 that are
+// needed to derive the stats or to decode the trace.
+const (
+	evBatch          = 1  // start of per-P batch of events [pid, timestamp]
+	evFrequency      = 2  // tracer timer frequency [ticks per second]
+	evStack          = 3  // stack [stack id, number of PCs, array of {PC, func string ID, file string ID, line}]
+	evGCStart        = 7  // GC start [timestamp, seq, stack id]
+	evSTWStart       = 9  // stop-the-world start [timestamp, kind]
+	evSTWDone        = 10 // stop-the-world done [timestamp]
+	evGoCreate       = 13 // goroutine creation [timestamp, new goroutine id, new stack id, stack id]
+	evGoEnd          = 15 // goroutine ends [timestamp]
+	evTimerGoroutine = 35 // denotes timer goroutine [timer goroutine id]
+	evString         = 37 // string dictionary entry [ID, length, string]
+	evUserLog        = 48 // trace.Log [timestamp, internal id, key string id, stack, value string]
+	evCPUSample      = 49 // CPU profiling sample [timestamp, real timestamp, real P id, goroutine id, stack id]
+	evCount          = 50
+)
+
+const traceHeaderLen = 16
+
+var errInvalidTrace = errors.New("invalid trace")
+
+// traceEvent is an event the stats are derived from, with its absolute timestamp in ticks.
+type traceEvent struct {
+	typ byte
+	ts  int64
+}
+
+// parseStats derives the stats of a Go execution trace. Only the format
+// written by Go 1.11 to 1.21 is parsed, newer formats only have their
+// version in the stats.
+func parseStats(b []byte) (*pb.TraceStats, error) {
+	if len(b) < traceHeaderLen {
+		return nil, fmt.Errorf("%w: missing header", errInvalidTrace)
+	}
+
+	var minor int
+	header := string(bytes.TrimRight(b[:traceHeaderLen], "\x00"))
+	if _, err := fmt.Sscanf(header, "go 1.%d trace", &minor); err != nil {
+		return nil, fmt.Errorf("%w: unknown header %q", errInvalidTrace, header)
+	}
+
+	stats := &pb.TraceStats{GoVersion: fmt.Sprintf("go1.%d", minor)}
+	if minor < 11 || minor > 21 {
+		return stats, nil
+	}
+
+	events, ticks, freq, err := readEvents(b[traceHeaderLen:])
+	if err != nil {
+		return stats, err
+	}
+	if freq <= 0 {
+		// The frequency is written last, the trace is likely truncated.
+		return stats, fmt.Errorf("%w: missing frequency", errInvalidTrace)
+	}
+	toDuration := func(ticks int64) time.Duration {
+		return time.Duration(float64(ticks) * 1e9 / float64(freq))
+	}
+
+	// Events are written per P, only once sorted by time the stop-the-world
+	// starts and ends can be paired up.
+	sort.SliceStable(events, func(i, j int) bool { return events[i].ts < events[j].ts })
+
+	var (
+		stwStart             int64
+		inSTW                bool
+		stwTotal, stwMax     time.Duration
+		goroutines, maxAlive uint64
+	)
+	for _, ev := range events {
+		switch ev.typ {
+		case evGCStart:
+			stats.GcCycles++
+		case evSTWStart:
+			stwStart, inSTW = ev.ts, true
+		case evSTWDone:
+			if !inSTW {
+				continue
+			}
+			inSTW = false
+			pause := toDuration(ev.ts - stwStart)
+			stats.StwPauses++
+			stwTotal += pause
+			if pause > stwMax {
+				stwMax = pause
+			}
+		case evGoCreate:
+			goroutines++
+			if goroutines > maxAlive {
+				maxAlive = goroutines
+			}
+		case evGoEnd:
+			if goroutines > 0 {
+				goroutines--
+			}
+		}
+	}
+
+	stats.Parsed = true
+	stats.Duration = durationpb.New(toDuration(ticks))
+	stats.StwPauseTotal = durationpb.New(stwTotal)
+	stats.StwPauseMax = durationpb.New(stwMax)
+	stats.GoroutinesMax = maxAlive
+	return stats, nil
+}
+
+// readEvents decodes the events of the trace and returns the ones the stats
+// are derived from, the span of the timestamps in ticks and the frequency of
+// the ticks.
+func readEvents(b []byte) (events []traceEvent, ticks, freq int64, err error) {
+	var (
+		off          int
+		lastTs       int64
+		minTs, maxTs int64
+		seenTs       bool
+		args         []uint64
+	)
+	readVal := func() (uint64, error) {
+		v, n := binary.Uvarint(b[off:])
+		if n <= 0 {
+			return 0, fmt.Errorf("%w: bad varint at offset 0x%x", errInvalidTrace, off)
+		}
+		off += n
+		return v, nil
+	}
+	skip := func(n uint64) error {
+		if n > uint64(len(b)-off) {
+			return fmt.Errorf("%w: truncated at offset 0x%x", errInvalidTrace, off)
+		}
+		off += int(n)
+		return nil
+	}
+
+	for off < len(b) {
+		off0 := off
+		typ := b[off] << 2 >> 2
+		narg := b[off]>>6 + 1
+		off++
+		if typ == 0 || typ >= evCount {
+			return nil, 0, 0, fmt.Errorf("%w: unknown event type %d at offset 0x%x", errInvalidTrace, typ, off0)
+		}
+
+		if typ == evString {
+			if _, err := readVal(); err != nil {
+				return nil, 0, 0, err
+			}
+			ln, err := readVal()
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			if err := skip(ln); err != nil {
+				return nil, 0, 0, err
+			}
+			continue
+		}
+
+		args = args[:0]
+		if narg < 4 {
+			for i := 0; i < int(narg); i++ {
+				v, err := readVal()
+				if err != nil {
+					return nil, 0, 0, err
+				}
+				args = append(args, v)
+			}
+		} else {
+			// The event has more arguments, prefixed by their length in bytes.
+			evLen, err := readVal()
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			end := off + int(evLen)
+			if evLen > uint64(len(b)-off) {
+				return nil, 0, 0, fmt.Errorf("%w: truncated at offset 0x%x", errInvalidTrace, off)
+			}
+			for off < end {
+				v, err := readVal()
+				if err != nil {
+					return nil, 0, 0, err
+				}
+				args = append(args, v)
+			}
+			if off != end {
+				return nil, 0, 0, fmt.Errorf("%w: bad event length at offset 0x%x", errInvalidTrace, off0)
+			}
+		}
+		if typ == evUserLog {
+			// The value string of the log follows the arguments.
+			ln, err := readVal()
+			if err != nil {
+				return nil, 0, 0, err
+			}
+			if err := skip(ln); err != nil {
+				return nil, 0, 0, err
+			}
+		}
+
+		switch typ {
+		case evBatch:
+			if len(args) != 2 {
+				return nil, 0, 0, fmt.Errorf("%w: bad batch at offset 0x%x", errInvalidTrace, off0)
+			}
+			lastTs = int64(args[1])
+		case evFrequency:
+			freq = int64(args[0])
+		case evStack, evTimerGoroutine, evCPUSample:
+			// These events don't advance the timestamps of the batch.
+		default:
+			// The timestamps of the other events are relative to the previous one of the batch.
+			lastTs += int64(args[0])
+			if !seenTs || lastTs < minTs {
+				minTs = lastTs
+			}
+			if !seenTs || lastTs > maxTs {
+				maxTs = lastTs
+			}
+			seenTs = true
+
+			switch typ {
+			case evGCStart, evSTWStart, evSTWDone, evGoCreate, evGoEnd:
+				events = append(events, traceEvent{typ: typ, ts: lastTs})
+			}
+		}
+	}
+
+	return events, maxTs - minTs, freq, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracestore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"runtime/trace"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// traceWriter writes traces in the format of Go 1.11 to 1.21.
+type traceWriter struct {
+	bytes.Buffer
+}
+
+func newTraceWriter(minor int) *traceWriter {
+	w := &traceWriter{}
+	header := make([]byte, traceHeaderLen)
+	copy(header, fmt.Sprintf("go 1.%d trace", minor))
+	w.Write(header)
+	return w
+}
+
+func (w *traceWriter) uvarint(v uint64) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	w.Write(buf[:binary.PutUvarint(buf, v)])
+}
+
+func (w *traceWriter) event(typ byte, args ...uint64) {
+	if len(args) < 4 {
+		w.WriteByte(typ | byte(len(args)-1)<<6)
+		for _, a := range args {
+			w.uvarint(a)
+		}
+		return
+	}
+
+	argw := &traceWriter{}
+	for _, a := range args {
+		argw.uvarint(a)
+	}
+	w.WriteByte(typ | 3<<6)
+	w.uvarint(uint64(argw.Len()))
+	w.Write(argw.Bytes())
+}
+
+func (w *traceWriter) str(id uint64, s string) {
+	w.WriteByte(evString)
+	w.uvarint(id)
+	w.uvarint(uint64(len(s)))
+	w.WriteString(s)
+}
+
+func TestParseStats(t *testing.T) {
+	// One tick is a microsecond.
+	w := newTraceWriter(19)
+	w.str(1, "main.main")
+	w.event(evBatch, 0, 1000)
+	w.event(evGoCreate, 0, 1, 1, 0)
+	w.event(evGoCreate, 10, 2, 1, 0)
+	w.event(evStack, 1, 1, 0x401000, 1, 1, 10)
+	w.event(evGCStart, 90, 1, 0)
+	w.event(evSTWStart, 10, 0)
+	w.event(evSTWDone, 300)
+	w.event(evGoEnd, 100)
+	w.event(evBatch, 1, 1100)
+	// The events of the second P are interleaved with the ones of the first.
+	w.event(evGoCreate, 10, 3, 1, 0)
+	w.event(evSTWStart, 500, 1)
+	w.event(evSTWDone, 200)
+	w.event(evGoCreate, 100, 4, 1, 0)
+	w.event(evFrequency, 1e6)
+
+	stats, err := parseStats(w.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, "go1.19", stats.GoVersion)
+	require.True(t, stats.Parsed)
+	require.Equal(t, 910*time.Microsecond, stats.Duration.AsDuration())
+	require.Equal(t, uint64(1), stats.GcCycles)
+	require.Equal(t, uint64(2), stats.StwPauses)
+	require.Equal(t, 500*time.Microsecond, stats.StwPauseTotal.AsDuration())
+	require.Equal(t, 300*time.Microsecond, stats.StwPauseMax.AsDuration())
+	require.Equal(t, uint64(3), stats.GoroutinesMax)
+}
+
+func TestParseStatsTruncated(t *testing.T) {
+	w := newTraceWriter(19)
+	w.event(evBatch, 0, 1000)
+	w.event(evGCStart, 90, 1, 0)
+
+	stats, err := parseStats(w.Bytes())
+	require.True(t, errors.Is(err, errInvalidTrace))
+	require.Equal(t, "go1.19", stats.GoVersion)
+	require.False(t, stats.Parsed)
+
+	_, err = parseStats([]byte("not a trace"))
+	require.True(t, errors.Is(err, errInvalidTrace))
+}
+
+func TestParseStatsNewFormat(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, trace.Start(buf))
+	trace.Stop()
+
+	stats, err := parseStats(buf.Bytes())
+	require.NoError(t, err)
+	require.NotEmpty(t, stats.GoVersion)
+	require.False(t, stats.Parsed)
+}
@@ -0,0 +1,280 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracestore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/thanos-io/thanos/pkg/objstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	profilestorepb "github.com/parca-dev/parca/gen/proto/go/parca/profilestore/v1alpha1"
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/trace/v1alpha1"
+)
+
+const (
+	traceSuffix = ".trace"
+	metaSuffix  = ".json"
+)
+
+// traceIDRegexp matches the IDs of traces, the hash of the labels of their
+// target and their timestamp in milliseconds.
+var traceIDRegexp = regexp.MustCompile(`^[0-9a-f]{16}/[0-9]+$`)
+
+// Store stores the raw execution traces scraped from targets in an object
+// storage, keyed by the labels of their target and their time. Next to each
+// trace its metadata is stored, including the stats derived from it.
+type Store struct {
+	logger log.Logger
+	bucket objstore.Bucket
+}
+
+var _ pb.TraceServiceServer = &Store{}
+
+// NewStore returns a store of the traces in the bucket.
+func NewStore(logger log.Logger, bucket objstore.Bucket) *Store {
+	return &Store{
+		logger: log.With(logger, "component", "tracestore"),
+		bucket: bucket,
+	}
+}
+
+// AppendTrace stores the trace scraped from the target with the labels at the time.
+func (s *Store) AppendTrace(ctx context.Context, lset labels.Labels, t time.Time, trace []byte) error {
+	stats, err := parseStats(trace)
+	if err != nil {
+		level.Debug(s.logger).Log("msg", "failed to derive stats from trace", "labels", lset.String(), "err", err)
+	}
+
+	id := fmt.Sprintf("%016x/%d", lset.Hash(), timestamp.FromTime(t))
+	meta, err := protojson.Marshal(&pb.Trace{
+		Labelset:  labelsToProto(lset),
+		Timestamp: timestamppb.New(t),
+		Size:      uint64(len(trace)),
+		Stats:     stats,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal trace metadata: %w", err)
+	}
+
+	// The metadata is uploaded last, so only complete traces are listed.
+	if err := s.bucket.Upload(ctx, id+traceSuffix, bytes.NewReader(trace)); err != nil {
+		return fmt.Errorf("upload trace: %w", err)
+	}
+	if err := s.bucket.Upload(ctx, id+metaSuffix, bytes.NewReader(meta)); err != nil {
+		return fmt.Errorf("upload trace metadata: %w", err)
+	}
+	return nil
+}
+
+// Traces returns the metadata of the traces matching the request sorted by time.
+func (s *Store) Traces(ctx context.Context, req *pb.TracesRequest) (*pb.TracesResponse, error) {
+	var (
+		sel []*labels.Matcher
+		err error
+	)
+	if req.Query != "" {
+		sel, err = parser.ParseMetricSelector(req.Query)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "failed to parse query")
+		}
+	}
+
+	mint, maxt := int64(0), int64(0)
+	if req.Start != nil {
+		mint = timestamp.FromTime(req.Start.AsTime())
+	}
+	if req.End != nil {
+		maxt = timestamp.FromTime(req.End.AsTime())
+	}
+
+	res := &pb.TracesResponse{}
+	err = s.iter(ctx, func(id string, ts int64) error {
+		if (req.Start != nil && ts < mint) || (req.End != nil && ts > maxt) {
+			return nil
+		}
+
+		t, err := s.meta(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !matches(labelsFromProto(t.Labelset), sel) {
+			return nil
+		}
+		res.Traces = append(res.Traces, t)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sort.Slice(res.Traces, func(i, j int) bool {
+		ti, tj := res.Traces[i].Timestamp.AsTime(), res.Traces[j].Timestamp.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return res.Traces[i].Id < res.Traces[j].Id
+	})
+	return res, nil
+}
+
+// DownloadTrace serves the trace with the ID of the request's id parameter
+// for `go tool trace`.
+func (s *Store) DownloadTrace(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	id := r.URL.Query().Get("id")
+	if !traceIDRegexp.MatchString(id) {
+		http.Error(w, fmt.Sprintf("invalid trace id %q", id), http.StatusBadRequest)
+		return
+	}
+
+	rc, err := s.bucket.Get(r.Context(), id+traceSuffix)
+	if s.bucket.IsObjNotFoundErr(err) {
+		http.Error(w, "trace not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="trace-%s.out"`, path.Base(id)))
+	if _, err := io.Copy(w, rc); err != nil {
+		level.Warn(s.logger).Log("msg", "failed to write trace download", "err", err)
+	}
+}
+
+// Run deletes the traces older than the retention at every interval until
+// the context is done.
+func (s *Store) Run(ctx context.Context, interval, retention time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.deleteBefore(ctx, timestamp.FromTime(time.Now().Add(-retention))); err != nil {
+				level.Warn(s.logger).Log("msg", "failed to delete traces past the retention", "err", err)
+			}
+		}
+	}
+}
+
+// deleteBefore deletes the traces with a timestamp before mint.
+func (s *Store) deleteBefore(ctx context.Context, mint int64) error {
+	return s.iter(ctx, func(id string, ts int64) error {
+		if ts >= mint {
+			return nil
+		}
+		// The metadata is deleted first, so the trace isn't listed anymore.
+		if err := s.bucket.Delete(ctx, id+metaSuffix); err != nil {
+			return fmt.Errorf("delete trace metadata: %w", err)
+		}
+		if err := s.bucket.Delete(ctx, id+traceSuffix); err != nil && !s.bucket.IsObjNotFoundErr(err) {
+			return fmt.Errorf("delete trace: %w", err)
+		}
+		return nil
+	})
+}
+
+// iter calls f with the ID and timestamp of every trace with metadata.
+func (s *Store) iter(ctx context.Context, f func(id string, ts int64) error) error {
+	return s.bucket.Iter(ctx, "", func(dir string) error {
+		if !strings.HasSuffix(dir, objstore.DirDelim) {
+			return nil
+		}
+		return s.bucket.Iter(ctx, dir, func(name string) error {
+			if !strings.HasSuffix(name, metaSuffix) {
+				return nil
+			}
+			id := strings.TrimSuffix(name, metaSuffix)
+			if !traceIDRegexp.MatchString(id) {
+				return nil
+			}
+			ts, err := strconv.ParseInt(path.Base(id), 10, 64)
+			if err != nil {
+				return nil
+			}
+			return f(id, ts)
+		})
+	})
+}
+
+// meta reads the metadata of the trace with the ID.
+func (s *Store) meta(ctx context.Context, id string) (*pb.Trace, error) {
+	rc, err := s.bucket.Get(ctx, id+metaSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("get trace metadata: %w", err)
+	}
+	defer rc.Close()
+
+	b, err := ioutil.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("read trace metadata: %w", err)
+	}
+
+	t := &pb.Trace{}
+	if err := protojson.Unmarshal(b, t); err != nil {
+		return nil, fmt.Errorf("unmarshal trace metadata: %w", err)
+	}
+	t.Id = id
+	return t, nil
+}
+
+func matches(lset labels.Labels, sel []*labels.Matcher) bool {
+	for _, m := range sel {
+		if !m.Matches(lset.Get(m.Name)) {
+			return false
+		}
+	}
+	return true
+}
+
+func labelsToProto(lset labels.Labels) *profilestorepb.LabelSet {
+	res := &profilestorepb.LabelSet{Labels: make([]*profilestorepb.Label, 0, len(lset))}
+	for _, l := range lset {
+		res.Labels = append(res.Labels, &profilestorepb.Label{Name: l.Name, Value: l.Value})
+	}
+	return res
+}
+
+func labelsFromProto(lset *profilestorepb.LabelSet) labels.Labels {
+	res := make(labels.Labels, 0, len(lset.GetLabels()))
+	for _, l := range lset.GetLabels() {
+		res = append(res, labels.Label{Name: l.Name, Value: l.Value})
+	}
+	return res
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 The Parca Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracestore
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/prometheus/pkg/labels"
+	"github.com/prometheus/prometheus/pkg/timestamp"
+	"github.com/stretchr/testify/require"
+	"github.com/thanos-io/thanos/pkg/objstore"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/parca-dev/parca/gen/proto/go/parca/trace/v1alpha1"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(log.NewNopLogger(), objstore.NewInMemBucket())
+
+	api := labels.FromStrings("__name__", "trace", "job", "api")
+	db := labels.FromStrings("__name__", "trace", "job", "db")
+	t1 := time.Unix(100, 0).UTC()
+	t2 := time.Unix(200, 0).UTC()
+
+	w := newTraceWriter(19)
+	w.event(evBatch, 0, 0)
+	w.event(evGCStart, 1, 1, 0)
+	w.event(evFrequency, 1e9)
+	require.NoError(t, s.AppendTrace(ctx, api, t2, w.Bytes()))
+	require.NoError(t, s.AppendTrace(ctx, api, t1, []byte("not a trace")))
+	require.NoError(t, s.AppendTrace(ctx, db, t1, w.Bytes()))
+
+	res, err := s.Traces(ctx, &pb.TracesRequest{Query: `{job="api"}`})
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 2)
+	require.Equal(t, t1, res.Traces[0].Timestamp.AsTime())
+	require.Equal(t, uint64(len("not a trace")), res.Traces[0].Size)
+	require.Nil(t, res.Traces[0].Stats)
+	require.Equal(t, t2, res.Traces[1].Timestamp.AsTime())
+	require.Equal(t, api, labelsFromProto(res.Traces[1].Labelset))
+	require.True(t, res.Traces[1].Stats.Parsed)
+	require.Equal(t, uint64(1), res.Traces[1].Stats.GcCycles)
+
+	res, err = s.Traces(ctx, &pb.TracesRequest{Start: timestamppb.New(t2)})
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	require.Equal(t, t2, res.Traces[0].Timestamp.AsTime())
+
+	res, err = s.Traces(ctx, &pb.TracesRequest{End: timestamppb.New(t1)})
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 2)
+
+	_, err = s.Traces(ctx, &pb.TracesRequest{Query: `{job=`})
+	require.Error(t, err)
+
+	// Only the traces past the retention are deleted.
+	require.NoError(t, s.deleteBefore(ctx, timestamp.FromTime(t2)))
+	res, err = s.Traces(ctx, &pb.TracesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+	require.Equal(t, t2, res.Traces[0].Timestamp.AsTime())
+}
+
+func TestStoreDownloadTrace(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(log.NewNopLogger(), objstore.NewInMemBucket())
+
+	lset := labels.FromStrings("__name__", "trace", "job", "api")
+	require.NoError(t, s.AppendTrace(ctx, lset, time.Unix(100, 0), []byte("trace")))
+
+	res, err := s.Traces(ctx, &pb.TracesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Traces, 1)
+
+	download := func(id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.DownloadTrace(w, httptest.NewRequest(http.MethodGet, "/traces/download?id="+id, nil), nil)
+		return w
+	}
+
+	w := download(res.Traces[0].Id)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "trace", w.Body.String())
+	require.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="trace-100000.out"`, w.Header().Get("Content-Disposition"))
+
+	require.Equal(t, http.StatusNotFound, download("0000000000000000/100000").Code)
+	require.Equal(t, http.StatusBadRequest, download("../secret").Code)
+}
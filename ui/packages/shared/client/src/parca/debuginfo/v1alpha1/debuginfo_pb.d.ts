@@ -84,6 +84,9 @@ export class UploadInfo extends jspb.Message {
   getBuildId(): string;
   setBuildId(value: string): void;
 
+  getType(): UploadInfo.TypeMap[keyof UploadInfo.TypeMap];
+  setType(value: UploadInfo.TypeMap[keyof UploadInfo.TypeMap]): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UploadInfo.AsObject;
   static toObject(includeInstance: boolean, msg: UploadInfo): UploadInfo.AsObject;
@@ -97,7 +100,15 @@ export class UploadInfo extends jspb.Message {
 export namespace UploadInfo {
   export type AsObject = {
     buildId: string,
+    type: UploadInfo.TypeMap[keyof UploadInfo.TypeMap],
+  }
+
+  export interface TypeMap {
+    TYPE_DEBUGINFO_UNSPECIFIED: 0;
+    TYPE_SOURCES: 1;
   }
+
+  export const Type: TypeMap;
 }
 
 export class UploadResponse extends jspb.Message {
@@ -18,6 +18,7 @@ var global = Function('return this')();
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.ExistsRequest', null, global);
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.ExistsResponse', null, global);
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.UploadInfo', null, global);
+goog.exportSymbol('proto.parca.debuginfo.v1alpha1.UploadInfo.Type', null, global);
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.UploadRequest', null, global);
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.UploadRequest.DataCase', null, global);
 goog.exportSymbol('proto.parca.debuginfo.v1alpha1.UploadResponse', null, global);
@@ -667,7 +668,8 @@ proto.parca.debuginfo.v1alpha1.UploadInfo.prototype.toObject = function(opt_incl
  */
 proto.parca.debuginfo.v1alpha1.UploadInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    buildId: jspb.Message.getFieldWithDefault(msg, 1, "")
+    buildId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -708,6 +710,10 @@ proto.parca.debuginfo.v1alpha1.UploadInfo.deserializeBinaryFromReader = function
       var value = /** @type {string} */ (reader.readString());
       msg.setBuildId(value);
       break;
+    case 2:
+      var value = /** @type {!proto.parca.debuginfo.v1alpha1.UploadInfo.Type} */ (reader.readEnum());
+      msg.setType(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -744,9 +750,24 @@ proto.parca.debuginfo.v1alpha1.UploadInfo.serializeBinaryToWriter = function(mes
       f
     );
   }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.parca.debuginfo.v1alpha1.UploadInfo.Type = {
+  TYPE_DEBUGINFO_UNSPECIFIED: 0,
+  TYPE_SOURCES: 1
+};
+
 /**
  * optional string build_id = 1;
  * @return {string}
@@ -765,6 +786,24 @@ proto.parca.debuginfo.v1alpha1.UploadInfo.prototype.setBuildId = function(value)
 };
 
 
+/**
+ * optional Type type = 2;
+ * @return {!proto.parca.debuginfo.v1alpha1.UploadInfo.Type}
+ */
+proto.parca.debuginfo.v1alpha1.UploadInfo.prototype.getType = function() {
+  return /** @type {!proto.parca.debuginfo.v1alpha1.UploadInfo.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.parca.debuginfo.v1alpha1.UploadInfo.Type} value
+ * @return {!proto.parca.debuginfo.v1alpha1.UploadInfo} returns this
+ */
+proto.parca.debuginfo.v1alpha1.UploadInfo.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
 
 
 
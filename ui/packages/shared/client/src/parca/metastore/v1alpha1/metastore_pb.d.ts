@@ -0,0 +1,633 @@
+// package: parca.metastore.v1alpha1
+// file: parca/metastore/v1alpha1/metastore.proto
+
+import * as jspb from "google-protobuf";
+
+export class Location extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  hasMapping(): boolean;
+  clearMapping(): void;
+  getMapping(): Mapping | undefined;
+  setMapping(value?: Mapping): void;
+
+  getAddress(): number;
+  setAddress(value: number): void;
+
+  clearLinesList(): void;
+  getLinesList(): Array<Line>;
+  setLinesList(value: Array<Line>): void;
+  addLines(value?: Line, index?: number): Line;
+
+  getIsFolded(): boolean;
+  setIsFolded(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Location.AsObject;
+  static toObject(includeInstance: boolean, msg: Location): Location.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Location, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Location;
+  static deserializeBinaryFromReader(message: Location, reader: jspb.BinaryReader): Location;
+}
+
+export namespace Location {
+  export type AsObject = {
+    id: number,
+    mapping?: Mapping.AsObject,
+    address: number,
+    linesList: Array<Line.AsObject>,
+    isFolded: boolean,
+  }
+}
+
+export class Line extends jspb.Message {
+  hasFunction(): boolean;
+  clearFunction(): void;
+  getFunction(): Function | undefined;
+  setFunction(value?: Function): void;
+
+  getLine(): number;
+  setLine(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Line.AsObject;
+  static toObject(includeInstance: boolean, msg: Line): Line.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Line, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Line;
+  static deserializeBinaryFromReader(message: Line, reader: jspb.BinaryReader): Line;
+}
+
+export namespace Line {
+  export type AsObject = {
+    pb_function?: Function.AsObject,
+    line: number,
+  }
+}
+
+export class Function extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getSystemName(): string;
+  setSystemName(value: string): void;
+
+  getFilename(): string;
+  setFilename(value: string): void;
+
+  getStartLine(): number;
+  setStartLine(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Function.AsObject;
+  static toObject(includeInstance: boolean, msg: Function): Function.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Function, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Function;
+  static deserializeBinaryFromReader(message: Function, reader: jspb.BinaryReader): Function;
+}
+
+export namespace Function {
+  export type AsObject = {
+    id: number,
+    name: string,
+    systemName: string,
+    filename: string,
+    startLine: number,
+  }
+}
+
+export class Mapping extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  getStart(): number;
+  setStart(value: number): void;
+
+  getLimit(): number;
+  setLimit(value: number): void;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getFile(): string;
+  setFile(value: string): void;
+
+  getBuildId(): string;
+  setBuildId(value: string): void;
+
+  getHasFunctions(): boolean;
+  setHasFunctions(value: boolean): void;
+
+  getHasFilenames(): boolean;
+  setHasFilenames(value: boolean): void;
+
+  getHasLineNumbers(): boolean;
+  setHasLineNumbers(value: boolean): void;
+
+  getHasInlineFrames(): boolean;
+  setHasInlineFrames(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Mapping.AsObject;
+  static toObject(includeInstance: boolean, msg: Mapping): Mapping.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Mapping, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Mapping;
+  static deserializeBinaryFromReader(message: Mapping, reader: jspb.BinaryReader): Mapping;
+}
+
+export namespace Mapping {
+  export type AsObject = {
+    id: number,
+    start: number,
+    limit: number,
+    offset: number,
+    file: string,
+    buildId: string,
+    hasFunctions: boolean,
+    hasFilenames: boolean,
+    hasLineNumbers: boolean,
+    hasInlineFrames: boolean,
+  }
+}
+
+export class LocationKey extends jspb.Message {
+  getNormalizedAddress(): number;
+  setNormalizedAddress(value: number): void;
+
+  getMappingId(): number;
+  setMappingId(value: number): void;
+
+  getLines(): string;
+  setLines(value: string): void;
+
+  getIsFolded(): boolean;
+  setIsFolded(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LocationKey.AsObject;
+  static toObject(includeInstance: boolean, msg: LocationKey): LocationKey.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LocationKey, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LocationKey;
+  static deserializeBinaryFromReader(message: LocationKey, reader: jspb.BinaryReader): LocationKey;
+}
+
+export namespace LocationKey {
+  export type AsObject = {
+    normalizedAddress: number,
+    mappingId: number,
+    lines: string,
+    isFolded: boolean,
+  }
+}
+
+export class FunctionKey extends jspb.Message {
+  getStartLine(): number;
+  setStartLine(value: number): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getSystemName(): string;
+  setSystemName(value: string): void;
+
+  getFilename(): string;
+  setFilename(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FunctionKey.AsObject;
+  static toObject(includeInstance: boolean, msg: FunctionKey): FunctionKey.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: FunctionKey, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FunctionKey;
+  static deserializeBinaryFromReader(message: FunctionKey, reader: jspb.BinaryReader): FunctionKey;
+}
+
+export namespace FunctionKey {
+  export type AsObject = {
+    startLine: number,
+    name: string,
+    systemName: string,
+    filename: string,
+  }
+}
+
+export class MappingKey extends jspb.Message {
+  getSize(): number;
+  setSize(value: number): void;
+
+  getOffset(): number;
+  setOffset(value: number): void;
+
+  getBuildIdOrFile(): string;
+  setBuildIdOrFile(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): MappingKey.AsObject;
+  static toObject(includeInstance: boolean, msg: MappingKey): MappingKey.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: MappingKey, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MappingKey;
+  static deserializeBinaryFromReader(message: MappingKey, reader: jspb.BinaryReader): MappingKey;
+}
+
+export namespace MappingKey {
+  export type AsObject = {
+    size: number,
+    offset: number,
+    buildIdOrFile: string,
+  }
+}
+
+export class GetLocationByKeyRequest extends jspb.Message {
+  hasKey(): boolean;
+  clearKey(): void;
+  getKey(): LocationKey | undefined;
+  setKey(value?: LocationKey): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLocationByKeyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLocationByKeyRequest): GetLocationByKeyRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetLocationByKeyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLocationByKeyRequest;
+  static deserializeBinaryFromReader(message: GetLocationByKeyRequest, reader: jspb.BinaryReader): GetLocationByKeyRequest;
+}
+
+export namespace GetLocationByKeyRequest {
+  export type AsObject = {
+    key?: LocationKey.AsObject,
+  }
+}
+
+export class GetLocationByKeyResponse extends jspb.Message {
+  hasLocation(): boolean;
+  clearLocation(): void;
+  getLocation(): Location | undefined;
+  setLocation(value?: Location): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLocationByKeyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLocationByKeyResponse): GetLocationByKeyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetLocationByKeyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLocationByKeyResponse;
+  static deserializeBinaryFromReader(message: GetLocationByKeyResponse, reader: jspb.BinaryReader): GetLocationByKeyResponse;
+}
+
+export namespace GetLocationByKeyResponse {
+  export type AsObject = {
+    location?: Location.AsObject,
+  }
+}
+
+export class GetLocationsByIDsRequest extends jspb.Message {
+  clearIdsList(): void;
+  getIdsList(): Array<number>;
+  setIdsList(value: Array<number>): void;
+  addIds(value: number, index?: number): number;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLocationsByIDsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLocationsByIDsRequest): GetLocationsByIDsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetLocationsByIDsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLocationsByIDsRequest;
+  static deserializeBinaryFromReader(message: GetLocationsByIDsRequest, reader: jspb.BinaryReader): GetLocationsByIDsRequest;
+}
+
+export namespace GetLocationsByIDsRequest {
+  export type AsObject = {
+    idsList: Array<number>,
+  }
+}
+
+export class GetLocationsByIDsResponse extends jspb.Message {
+  clearLocationsList(): void;
+  getLocationsList(): Array<Location>;
+  setLocationsList(value: Array<Location>): void;
+  addLocations(value?: Location, index?: number): Location;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLocationsByIDsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLocationsByIDsResponse): GetLocationsByIDsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetLocationsByIDsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLocationsByIDsResponse;
+  static deserializeBinaryFromReader(message: GetLocationsByIDsResponse, reader: jspb.BinaryReader): GetLocationsByIDsResponse;
+}
+
+export namespace GetLocationsByIDsResponse {
+  export type AsObject = {
+    locationsList: Array<Location.AsObject>,
+  }
+}
+
+export class CreateLocationRequest extends jspb.Message {
+  hasLocation(): boolean;
+  clearLocation(): void;
+  getLocation(): Location | undefined;
+  setLocation(value?: Location): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateLocationRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateLocationRequest): CreateLocationRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateLocationRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateLocationRequest;
+  static deserializeBinaryFromReader(message: CreateLocationRequest, reader: jspb.BinaryReader): CreateLocationRequest;
+}
+
+export namespace CreateLocationRequest {
+  export type AsObject = {
+    location?: Location.AsObject,
+  }
+}
+
+export class CreateLocationResponse extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateLocationResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateLocationResponse): CreateLocationResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateLocationResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateLocationResponse;
+  static deserializeBinaryFromReader(message: CreateLocationResponse, reader: jspb.BinaryReader): CreateLocationResponse;
+}
+
+export namespace CreateLocationResponse {
+  export type AsObject = {
+    id: number,
+  }
+}
+
+export class SymbolizeRequest extends jspb.Message {
+  hasLocation(): boolean;
+  clearLocation(): void;
+  getLocation(): Location | undefined;
+  setLocation(value?: Location): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SymbolizeRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: SymbolizeRequest): SymbolizeRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SymbolizeRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SymbolizeRequest;
+  static deserializeBinaryFromReader(message: SymbolizeRequest, reader: jspb.BinaryReader): SymbolizeRequest;
+}
+
+export namespace SymbolizeRequest {
+  export type AsObject = {
+    location?: Location.AsObject,
+  }
+}
+
+export class SymbolizeResponse extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SymbolizeResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SymbolizeResponse): SymbolizeResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SymbolizeResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SymbolizeResponse;
+  static deserializeBinaryFromReader(message: SymbolizeResponse, reader: jspb.BinaryReader): SymbolizeResponse;
+}
+
+export namespace SymbolizeResponse {
+  export type AsObject = {
+  }
+}
+
+export class GetSymbolizableLocationsRequest extends jspb.Message {
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetSymbolizableLocationsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetSymbolizableLocationsRequest): GetSymbolizableLocationsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetSymbolizableLocationsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetSymbolizableLocationsRequest;
+  static deserializeBinaryFromReader(message: GetSymbolizableLocationsRequest, reader: jspb.BinaryReader): GetSymbolizableLocationsRequest;
+}
+
+export namespace GetSymbolizableLocationsRequest {
+  export type AsObject = {
+  }
+}
+
+export class GetSymbolizableLocationsResponse extends jspb.Message {
+  clearLocationsList(): void;
+  getLocationsList(): Array<Location>;
+  setLocationsList(value: Array<Location>): void;
+  addLocations(value?: Location, index?: number): Location;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetSymbolizableLocationsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetSymbolizableLocationsResponse): GetSymbolizableLocationsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetSymbolizableLocationsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetSymbolizableLocationsResponse;
+  static deserializeBinaryFromReader(message: GetSymbolizableLocationsResponse, reader: jspb.BinaryReader): GetSymbolizableLocationsResponse;
+}
+
+export namespace GetSymbolizableLocationsResponse {
+  export type AsObject = {
+    locationsList: Array<Location.AsObject>,
+  }
+}
+
+export class GetFunctionByKeyRequest extends jspb.Message {
+  hasKey(): boolean;
+  clearKey(): void;
+  getKey(): FunctionKey | undefined;
+  setKey(value?: FunctionKey): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetFunctionByKeyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetFunctionByKeyRequest): GetFunctionByKeyRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetFunctionByKeyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetFunctionByKeyRequest;
+  static deserializeBinaryFromReader(message: GetFunctionByKeyRequest, reader: jspb.BinaryReader): GetFunctionByKeyRequest;
+}
+
+export namespace GetFunctionByKeyRequest {
+  export type AsObject = {
+    key?: FunctionKey.AsObject,
+  }
+}
+
+export class GetFunctionByKeyResponse extends jspb.Message {
+  hasFunction(): boolean;
+  clearFunction(): void;
+  getFunction(): Function | undefined;
+  setFunction(value?: Function): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetFunctionByKeyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetFunctionByKeyResponse): GetFunctionByKeyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetFunctionByKeyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetFunctionByKeyResponse;
+  static deserializeBinaryFromReader(message: GetFunctionByKeyResponse, reader: jspb.BinaryReader): GetFunctionByKeyResponse;
+}
+
+export namespace GetFunctionByKeyResponse {
+  export type AsObject = {
+    pb_function?: Function.AsObject,
+  }
+}
+
+export class CreateFunctionRequest extends jspb.Message {
+  hasFunction(): boolean;
+  clearFunction(): void;
+  getFunction(): Function | undefined;
+  setFunction(value?: Function): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateFunctionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateFunctionRequest): CreateFunctionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateFunctionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateFunctionRequest;
+  static deserializeBinaryFromReader(message: CreateFunctionRequest, reader: jspb.BinaryReader): CreateFunctionRequest;
+}
+
+export namespace CreateFunctionRequest {
+  export type AsObject = {
+    pb_function?: Function.AsObject,
+  }
+}
+
+export class CreateFunctionResponse extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateFunctionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateFunctionResponse): CreateFunctionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateFunctionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateFunctionResponse;
+  static deserializeBinaryFromReader(message: CreateFunctionResponse, reader: jspb.BinaryReader): CreateFunctionResponse;
+}
+
+export namespace CreateFunctionResponse {
+  export type AsObject = {
+    id: number,
+  }
+}
+
+export class GetMappingByKeyRequest extends jspb.Message {
+  hasKey(): boolean;
+  clearKey(): void;
+  getKey(): MappingKey | undefined;
+  setKey(value?: MappingKey): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetMappingByKeyRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetMappingByKeyRequest): GetMappingByKeyRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetMappingByKeyRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetMappingByKeyRequest;
+  static deserializeBinaryFromReader(message: GetMappingByKeyRequest, reader: jspb.BinaryReader): GetMappingByKeyRequest;
+}
+
+export namespace GetMappingByKeyRequest {
+  export type AsObject = {
+    key?: MappingKey.AsObject,
+  }
+}
+
+export class GetMappingByKeyResponse extends jspb.Message {
+  hasMapping(): boolean;
+  clearMapping(): void;
+  getMapping(): Mapping | undefined;
+  setMapping(value?: Mapping): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetMappingByKeyResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetMappingByKeyResponse): GetMappingByKeyResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetMappingByKeyResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetMappingByKeyResponse;
+  static deserializeBinaryFromReader(message: GetMappingByKeyResponse, reader: jspb.BinaryReader): GetMappingByKeyResponse;
+}
+
+export namespace GetMappingByKeyResponse {
+  export type AsObject = {
+    mapping?: Mapping.AsObject,
+  }
+}
+
+export class CreateMappingRequest extends jspb.Message {
+  hasMapping(): boolean;
+  clearMapping(): void;
+  getMapping(): Mapping | undefined;
+  setMapping(value?: Mapping): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateMappingRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateMappingRequest): CreateMappingRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateMappingRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateMappingRequest;
+  static deserializeBinaryFromReader(message: CreateMappingRequest, reader: jspb.BinaryReader): CreateMappingRequest;
+}
+
+export namespace CreateMappingRequest {
+  export type AsObject = {
+    mapping?: Mapping.AsObject,
+  }
+}
+
+export class CreateMappingResponse extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateMappingResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateMappingResponse): CreateMappingResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateMappingResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateMappingResponse;
+  static deserializeBinaryFromReader(message: CreateMappingResponse, reader: jspb.BinaryReader): CreateMappingResponse;
+}
+
+export namespace CreateMappingResponse {
+  export type AsObject = {
+    id: number,
+  }
+}
+
@@ -0,0 +1,4910 @@
+// source: parca/metastore/v1alpha1/metastore.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global = Function('return this')();
+
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateFunctionRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateFunctionResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateLocationRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateLocationResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateMappingRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.CreateMappingResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.Function', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.FunctionKey', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetLocationByKeyRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetLocationByKeyResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetMappingByKeyRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetMappingByKeyResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.Line', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.Location', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.LocationKey', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.Mapping', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.MappingKey', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.SymbolizeRequest', null, global);
+goog.exportSymbol('proto.parca.metastore.v1alpha1.SymbolizeResponse', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.Location = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.metastore.v1alpha1.Location.repeatedFields_, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.Location, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.Location.displayName = 'proto.parca.metastore.v1alpha1.Location';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.Line = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.Line, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.Line.displayName = 'proto.parca.metastore.v1alpha1.Line';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.Function = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.Function, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.Function.displayName = 'proto.parca.metastore.v1alpha1.Function';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.Mapping = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.Mapping, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.Mapping.displayName = 'proto.parca.metastore.v1alpha1.Mapping';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.LocationKey = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.LocationKey, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.LocationKey.displayName = 'proto.parca.metastore.v1alpha1.LocationKey';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.FunctionKey = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.FunctionKey, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.FunctionKey.displayName = 'proto.parca.metastore.v1alpha1.FunctionKey';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.MappingKey = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.MappingKey, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.MappingKey.displayName = 'proto.parca.metastore.v1alpha1.MappingKey';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetLocationByKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.displayName = 'proto.parca.metastore.v1alpha1.GetLocationByKeyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetLocationByKeyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.displayName = 'proto.parca.metastore.v1alpha1.GetLocationByKeyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.displayName = 'proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.displayName = 'proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateLocationRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateLocationRequest.displayName = 'proto.parca.metastore.v1alpha1.CreateLocationRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateLocationResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateLocationResponse.displayName = 'proto.parca.metastore.v1alpha1.CreateLocationResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.SymbolizeRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.SymbolizeRequest.displayName = 'proto.parca.metastore.v1alpha1.SymbolizeRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.SymbolizeResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.SymbolizeResponse.displayName = 'proto.parca.metastore.v1alpha1.SymbolizeResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.displayName = 'proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.displayName = 'proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.displayName = 'proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.displayName = 'proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateFunctionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateFunctionRequest.displayName = 'proto.parca.metastore.v1alpha1.CreateFunctionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateFunctionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateFunctionResponse.displayName = 'proto.parca.metastore.v1alpha1.CreateFunctionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetMappingByKeyRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.displayName = 'proto.parca.metastore.v1alpha1.GetMappingByKeyRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.GetMappingByKeyResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.displayName = 'proto.parca.metastore.v1alpha1.GetMappingByKeyResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateMappingRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateMappingRequest.displayName = 'proto.parca.metastore.v1alpha1.CreateMappingRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.metastore.v1alpha1.CreateMappingResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.metastore.v1alpha1.CreateMappingResponse.displayName = 'proto.parca.metastore.v1alpha1.CreateMappingResponse';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.metastore.v1alpha1.Location.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.Location.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.Location} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Location.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    mapping: (f = msg.getMapping()) && proto.parca.metastore.v1alpha1.Mapping.toObject(includeInstance, f),
+    address: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    linesList: jspb.Message.toObjectList(msg.getLinesList(),
+    proto.parca.metastore.v1alpha1.Line.toObject, includeInstance),
+    isFolded: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.Location.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.Location;
+  return proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.Location} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new proto.parca.metastore.v1alpha1.Mapping;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Mapping.deserializeBinaryFromReader);
+      msg.setMapping(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAddress(value);
+      break;
+    case 4:
+      var value = new proto.parca.metastore.v1alpha1.Line;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Line.deserializeBinaryFromReader);
+      msg.addLines(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsFolded(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.Location} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getMapping();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.parca.metastore.v1alpha1.Mapping.serializeBinaryToWriter
+    );
+  }
+  f = message.getAddress();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getLinesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.parca.metastore.v1alpha1.Line.serializeBinaryToWriter
+    );
+  }
+  f = message.getIsFolded();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional Mapping mapping = 2;
+ * @return {?proto.parca.metastore.v1alpha1.Mapping}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.getMapping = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Mapping} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Mapping, 2));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Mapping|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+*/
+proto.parca.metastore.v1alpha1.Location.prototype.setMapping = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.clearMapping = function() {
+  return this.setMapping(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.hasMapping = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional uint64 address = 3;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.getAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated Line lines = 4;
+ * @return {!Array<!proto.parca.metastore.v1alpha1.Line>}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.getLinesList = function() {
+  return /** @type{!Array<!proto.parca.metastore.v1alpha1.Line>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.metastore.v1alpha1.Line, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.metastore.v1alpha1.Line>} value
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+*/
+proto.parca.metastore.v1alpha1.Location.prototype.setLinesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.parca.metastore.v1alpha1.Line=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.metastore.v1alpha1.Line}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.addLines = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.parca.metastore.v1alpha1.Line, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.clearLinesList = function() {
+  return this.setLinesList([]);
+};
+
+
+/**
+ * optional bool is_folded = 5;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.getIsFolded = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.Location} returns this
+ */
+proto.parca.metastore.v1alpha1.Location.prototype.setIsFolded = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.Line.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.Line} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Line.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: (f = msg.getFunction()) && proto.parca.metastore.v1alpha1.Function.toObject(includeInstance, f),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.Line}
+ */
+proto.parca.metastore.v1alpha1.Line.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.Line;
+  return proto.parca.metastore.v1alpha1.Line.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.Line} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.Line}
+ */
+proto.parca.metastore.v1alpha1.Line.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Function;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Function.deserializeBinaryFromReader);
+      msg.setFunction(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.Line.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.Line} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Line.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Function.serializeBinaryToWriter
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Function function = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Function}
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.getFunction = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Function} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Function, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Function|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.Line} returns this
+*/
+proto.parca.metastore.v1alpha1.Line.prototype.setFunction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.Line} returns this
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.clearFunction = function() {
+  return this.setFunction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.hasFunction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int64 line = 2;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Line} returns this
+ */
+proto.parca.metastore.v1alpha1.Line.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.Function.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.Function} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Function.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    systemName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    filename: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    startLine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.Function}
+ */
+proto.parca.metastore.v1alpha1.Function.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.Function;
+  return proto.parca.metastore.v1alpha1.Function.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.Function} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.Function}
+ */
+proto.parca.metastore.v1alpha1.Function.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSystemName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartLine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.Function.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.Function} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Function.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSystemName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getStartLine();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Function} returns this
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.Function} returns this
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string system_name = 3;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.getSystemName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.Function} returns this
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.setSystemName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string filename = 4;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.Function} returns this
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 start_line = 5;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.getStartLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Function} returns this
+ */
+proto.parca.metastore.v1alpha1.Function.prototype.setStartLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.Mapping.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.Mapping} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Mapping.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    start: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    file: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    buildId: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    hasFunctions: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    hasFilenames: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    hasLineNumbers: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    hasInlineFrames: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.Mapping}
+ */
+proto.parca.metastore.v1alpha1.Mapping.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.Mapping;
+  return proto.parca.metastore.v1alpha1.Mapping.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.Mapping} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.Mapping}
+ */
+proto.parca.metastore.v1alpha1.Mapping.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStart(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setLimit(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOffset(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFile(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasFunctions(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasFilenames(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasLineNumbers(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasInlineFrames(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.Mapping.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.Mapping} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.Mapping.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getFile();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getHasFunctions();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getHasFilenames();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getHasLineNumbers();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getHasInlineFrames();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 start = 2;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getStart = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setStart = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 limit = 3;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 offset = 4;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional string file = 5;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string build_id = 6;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional bool has_functions = 7;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getHasFunctions = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setHasFunctions = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+/**
+ * optional bool has_filenames = 8;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getHasFilenames = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setHasFilenames = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional bool has_line_numbers = 9;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getHasLineNumbers = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setHasLineNumbers = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional bool has_inline_frames = 10;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.getHasInlineFrames = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.Mapping} returns this
+ */
+proto.parca.metastore.v1alpha1.Mapping.prototype.setHasInlineFrames = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.LocationKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.LocationKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.LocationKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    normalizedAddress: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    mappingId: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lines: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    isFolded: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.LocationKey;
+  return proto.parca.metastore.v1alpha1.LocationKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.LocationKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNormalizedAddress(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMappingId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLines(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsFolded(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.LocationKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.LocationKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.LocationKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNormalizedAddress();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getMappingId();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLines();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIsFolded();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 normalized_address = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.getNormalizedAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey} returns this
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.setNormalizedAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 mapping_id = 2;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.getMappingId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey} returns this
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.setMappingId = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string lines = 3;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.getLines = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey} returns this
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.setLines = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool is_folded = 4;
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.getIsFolded = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.metastore.v1alpha1.LocationKey} returns this
+ */
+proto.parca.metastore.v1alpha1.LocationKey.prototype.setIsFolded = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.FunctionKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.FunctionKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    startLine: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    systemName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    filename: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.FunctionKey;
+  return proto.parca.metastore.v1alpha1.FunctionKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.FunctionKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartLine(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSystemName(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.FunctionKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.FunctionKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStartLine();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSystemName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 start_line = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.getStartLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey} returns this
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.setStartLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey} returns this
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string system_name = 3;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.getSystemName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey} returns this
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.setSystemName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string filename = 4;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.FunctionKey} returns this
+ */
+proto.parca.metastore.v1alpha1.FunctionKey.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.MappingKey.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.MappingKey} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.MappingKey.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    size: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    buildIdOrFile: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.MappingKey}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.MappingKey;
+  return proto.parca.metastore.v1alpha1.MappingKey.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.MappingKey} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.MappingKey}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOffset(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildIdOrFile(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.MappingKey.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.MappingKey} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.MappingKey.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getOffset();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getBuildIdOrFile();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 size = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.MappingKey} returns this
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 offset = 2;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.MappingKey} returns this
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string build_id_or_file = 3;
+ * @return {string}
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.getBuildIdOrFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.metastore.v1alpha1.MappingKey} returns this
+ */
+proto.parca.metastore.v1alpha1.MappingKey.prototype.setBuildIdOrFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: (f = msg.getKey()) && proto.parca.metastore.v1alpha1.LocationKey.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetLocationByKeyRequest;
+  return proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.LocationKey;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.LocationKey.deserializeBinaryFromReader);
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.LocationKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional LocationKey key = 1;
+ * @return {?proto.parca.metastore.v1alpha1.LocationKey}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.getKey = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.LocationKey} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.LocationKey, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.LocationKey|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.setKey = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.clearKey = function() {
+  return this.setKey(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyRequest.prototype.hasKey = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    location: (f = msg.getLocation()) && proto.parca.metastore.v1alpha1.Location.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetLocationByKeyResponse;
+  return proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.setLocation(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocation();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Location location = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.getLocation = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Location} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Location|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse} returns this
+*/
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.setLocation = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationByKeyResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.clearLocation = function() {
+  return this.setLocation(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetLocationByKeyResponse.prototype.hasLocation = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    idsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest;
+  return proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var values = /** @type {!Array<number>} */ (reader.isDelimited() ? reader.readPackedUint64() : [reader.readUint64()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addIds(values[i]);
+      }
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIdsList();
+  if (f.length > 0) {
+    writer.writePackedUint64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated uint64 ids = 1;
+ * @return {!Array<number>}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.getIdsList = function() {
+  return /** @type {!Array<number>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<number>} value
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.setIdsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {number} value
+ * @param {number=} opt_index
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.addIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsRequest.prototype.clearIdsList = function() {
+  return this.setIdsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    locationsList: jspb.Message.toObjectList(msg.getLocationsList(),
+    proto.parca.metastore.v1alpha1.Location.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse;
+  return proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.addLocations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Location locations = 1;
+ * @return {!Array<!proto.parca.metastore.v1alpha1.Location>}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.getLocationsList = function() {
+  return /** @type{!Array<!proto.parca.metastore.v1alpha1.Location>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.metastore.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.metastore.v1alpha1.Location>} value
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse} returns this
+*/
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.setLocationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.metastore.v1alpha1.Location=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.addLocations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.metastore.v1alpha1.Location, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.GetLocationsByIDsResponse.prototype.clearLocationsList = function() {
+  return this.setLocationsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateLocationRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    location: (f = msg.getLocation()) && proto.parca.metastore.v1alpha1.Location.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateLocationRequest;
+  return proto.parca.metastore.v1alpha1.CreateLocationRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.setLocation(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateLocationRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocation();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Location location = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.getLocation = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Location} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Location|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.setLocation = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.clearLocation = function() {
+  return this.setLocation(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationRequest.prototype.hasLocation = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateLocationResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateLocationResponse;
+  return proto.parca.metastore.v1alpha1.CreateLocationResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateLocationResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateLocationResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateLocationResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateLocationResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.SymbolizeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    location: (f = msg.getLocation()) && proto.parca.metastore.v1alpha1.Location.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeRequest}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.SymbolizeRequest;
+  return proto.parca.metastore.v1alpha1.SymbolizeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeRequest}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.setLocation(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.SymbolizeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocation();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Location location = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.getLocation = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Location} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Location|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.setLocation = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.clearLocation = function() {
+  return this.setLocation(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeRequest.prototype.hasLocation = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.SymbolizeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeResponse}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.SymbolizeResponse;
+  return proto.parca.metastore.v1alpha1.SymbolizeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.SymbolizeResponse}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.SymbolizeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.SymbolizeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.SymbolizeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest;
+  return proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    locationsList: jspb.Message.toObjectList(msg.getLocationsList(),
+    proto.parca.metastore.v1alpha1.Location.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse;
+  return proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.addLocations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Location locations = 1;
+ * @return {!Array<!proto.parca.metastore.v1alpha1.Location>}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.getLocationsList = function() {
+  return /** @type{!Array<!proto.parca.metastore.v1alpha1.Location>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.metastore.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.metastore.v1alpha1.Location>} value
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse} returns this
+*/
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.setLocationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.metastore.v1alpha1.Location=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.metastore.v1alpha1.Location}
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.addLocations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.metastore.v1alpha1.Location, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.GetSymbolizableLocationsResponse.prototype.clearLocationsList = function() {
+  return this.setLocationsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: (f = msg.getKey()) && proto.parca.metastore.v1alpha1.FunctionKey.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest;
+  return proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.FunctionKey;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.FunctionKey.deserializeBinaryFromReader);
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.FunctionKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional FunctionKey key = 1;
+ * @return {?proto.parca.metastore.v1alpha1.FunctionKey}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.getKey = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.FunctionKey} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.FunctionKey, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.FunctionKey|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.setKey = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.clearKey = function() {
+  return this.setKey(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyRequest.prototype.hasKey = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: (f = msg.getFunction()) && proto.parca.metastore.v1alpha1.Function.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse;
+  return proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Function;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Function.deserializeBinaryFromReader);
+      msg.setFunction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Function.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Function function = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Function}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.getFunction = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Function} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Function, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Function|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse} returns this
+*/
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.setFunction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.clearFunction = function() {
+  return this.setFunction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetFunctionByKeyResponse.prototype.hasFunction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateFunctionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: (f = msg.getFunction()) && proto.parca.metastore.v1alpha1.Function.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateFunctionRequest;
+  return proto.parca.metastore.v1alpha1.CreateFunctionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Function;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Function.deserializeBinaryFromReader);
+      msg.setFunction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateFunctionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Function.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Function function = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Function}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.getFunction = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Function} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Function, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Function|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.setFunction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.clearFunction = function() {
+  return this.setFunction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionRequest.prototype.hasFunction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateFunctionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateFunctionResponse;
+  return proto.parca.metastore.v1alpha1.CreateFunctionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateFunctionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateFunctionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateFunctionResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateFunctionResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: (f = msg.getKey()) && proto.parca.metastore.v1alpha1.MappingKey.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetMappingByKeyRequest;
+  return proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.MappingKey;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.MappingKey.deserializeBinaryFromReader);
+      msg.setKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.MappingKey.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional MappingKey key = 1;
+ * @return {?proto.parca.metastore.v1alpha1.MappingKey}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.getKey = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.MappingKey} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.MappingKey, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.MappingKey|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.setKey = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.clearKey = function() {
+  return this.setKey(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyRequest.prototype.hasKey = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mapping: (f = msg.getMapping()) && proto.parca.metastore.v1alpha1.Mapping.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.GetMappingByKeyResponse;
+  return proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Mapping;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Mapping.deserializeBinaryFromReader);
+      msg.setMapping(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMapping();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Mapping.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Mapping mapping = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Mapping}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.getMapping = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Mapping} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Mapping, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Mapping|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse} returns this
+*/
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.setMapping = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.GetMappingByKeyResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.clearMapping = function() {
+  return this.setMapping(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.GetMappingByKeyResponse.prototype.hasMapping = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateMappingRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mapping: (f = msg.getMapping()) && proto.parca.metastore.v1alpha1.Mapping.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateMappingRequest;
+  return proto.parca.metastore.v1alpha1.CreateMappingRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingRequest}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.metastore.v1alpha1.Mapping;
+      reader.readMessage(value,proto.parca.metastore.v1alpha1.Mapping.deserializeBinaryFromReader);
+      msg.setMapping(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateMappingRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMapping();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.metastore.v1alpha1.Mapping.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Mapping mapping = 1;
+ * @return {?proto.parca.metastore.v1alpha1.Mapping}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.getMapping = function() {
+  return /** @type{?proto.parca.metastore.v1alpha1.Mapping} */ (
+    jspb.Message.getWrapperField(this, proto.parca.metastore.v1alpha1.Mapping, 1));
+};
+
+
+/**
+ * @param {?proto.parca.metastore.v1alpha1.Mapping|undefined} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingRequest} returns this
+*/
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.setMapping = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingRequest} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.clearMapping = function() {
+  return this.setMapping(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingRequest.prototype.hasMapping = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.metastore.v1alpha1.CreateMappingResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.metastore.v1alpha1.CreateMappingResponse;
+  return proto.parca.metastore.v1alpha1.CreateMappingResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingResponse}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.metastore.v1alpha1.CreateMappingResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.metastore.v1alpha1.CreateMappingResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint64 id = 1;
+ * @return {number}
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.metastore.v1alpha1.CreateMappingResponse} returns this
+ */
+proto.parca.metastore.v1alpha1.CreateMappingResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+goog.object.extend(exports, proto.parca.metastore.v1alpha1);
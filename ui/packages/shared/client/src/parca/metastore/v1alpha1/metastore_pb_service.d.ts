@@ -0,0 +1,215 @@
+// package: parca.metastore.v1alpha1
+// file: parca/metastore/v1alpha1/metastore.proto
+
+import * as parca_metastore_v1alpha1_metastore_pb from "../../../parca/metastore/v1alpha1/metastore_pb";
+import {grpc} from "@improbable-eng/grpc-web";
+
+type MetastoreServiceGetLocationByKey = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyResponse;
+};
+
+type MetastoreServiceGetLocationsByIDs = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsResponse;
+};
+
+type MetastoreServiceCreateLocation = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.CreateLocationRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.CreateLocationResponse;
+};
+
+type MetastoreServiceSymbolize = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.SymbolizeRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.SymbolizeResponse;
+};
+
+type MetastoreServiceGetSymbolizableLocations = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsResponse;
+};
+
+type MetastoreServiceGetFunctionByKey = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyResponse;
+};
+
+type MetastoreServiceCreateFunction = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.CreateFunctionRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.CreateFunctionResponse;
+};
+
+type MetastoreServiceGetMappingByKey = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyResponse;
+};
+
+type MetastoreServiceCreateMapping = {
+  readonly methodName: string;
+  readonly service: typeof MetastoreService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_metastore_v1alpha1_metastore_pb.CreateMappingRequest;
+  readonly responseType: typeof parca_metastore_v1alpha1_metastore_pb.CreateMappingResponse;
+};
+
+export class MetastoreService {
+  static readonly serviceName: string;
+  static readonly GetLocationByKey: MetastoreServiceGetLocationByKey;
+  static readonly GetLocationsByIDs: MetastoreServiceGetLocationsByIDs;
+  static readonly CreateLocation: MetastoreServiceCreateLocation;
+  static readonly Symbolize: MetastoreServiceSymbolize;
+  static readonly GetSymbolizableLocations: MetastoreServiceGetSymbolizableLocations;
+  static readonly GetFunctionByKey: MetastoreServiceGetFunctionByKey;
+  static readonly CreateFunction: MetastoreServiceCreateFunction;
+  static readonly GetMappingByKey: MetastoreServiceGetMappingByKey;
+  static readonly CreateMapping: MetastoreServiceCreateMapping;
+}
+
+export type ServiceError = { message: string, code: number; metadata: grpc.Metadata }
+export type Status = { details: string, code: number; metadata: grpc.Metadata }
+
+interface UnaryResponse {
+  cancel(): void;
+}
+interface ResponseStream<T> {
+  cancel(): void;
+  on(type: 'data', handler: (message: T) => void): ResponseStream<T>;
+  on(type: 'end', handler: (status?: Status) => void): ResponseStream<T>;
+  on(type: 'status', handler: (status: Status) => void): ResponseStream<T>;
+}
+interface RequestStream<T> {
+  write(message: T): RequestStream<T>;
+  end(): void;
+  cancel(): void;
+  on(type: 'end', handler: (status?: Status) => void): RequestStream<T>;
+  on(type: 'status', handler: (status: Status) => void): RequestStream<T>;
+}
+interface BidirectionalStream<ReqT, ResT> {
+  write(message: ReqT): BidirectionalStream<ReqT, ResT>;
+  end(): void;
+  cancel(): void;
+  on(type: 'data', handler: (message: ResT) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'end', handler: (status?: Status) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'status', handler: (status: Status) => void): BidirectionalStream<ReqT, ResT>;
+}
+
+export class MetastoreServiceClient {
+  readonly serviceHost: string;
+
+  constructor(serviceHost: string, options?: grpc.RpcOptions);
+  getLocationByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyResponse|null) => void
+  ): UnaryResponse;
+  getLocationByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyResponse|null) => void
+  ): UnaryResponse;
+  getLocationsByIDs(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsResponse|null) => void
+  ): UnaryResponse;
+  getLocationsByIDs(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsResponse|null) => void
+  ): UnaryResponse;
+  createLocation(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateLocationRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateLocationResponse|null) => void
+  ): UnaryResponse;
+  createLocation(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateLocationRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateLocationResponse|null) => void
+  ): UnaryResponse;
+  symbolize(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.SymbolizeRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.SymbolizeResponse|null) => void
+  ): UnaryResponse;
+  symbolize(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.SymbolizeRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.SymbolizeResponse|null) => void
+  ): UnaryResponse;
+  getSymbolizableLocations(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsResponse|null) => void
+  ): UnaryResponse;
+  getSymbolizableLocations(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsResponse|null) => void
+  ): UnaryResponse;
+  getFunctionByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyResponse|null) => void
+  ): UnaryResponse;
+  getFunctionByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyResponse|null) => void
+  ): UnaryResponse;
+  createFunction(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateFunctionRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateFunctionResponse|null) => void
+  ): UnaryResponse;
+  createFunction(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateFunctionRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateFunctionResponse|null) => void
+  ): UnaryResponse;
+  getMappingByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyResponse|null) => void
+  ): UnaryResponse;
+  getMappingByKey(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyResponse|null) => void
+  ): UnaryResponse;
+  createMapping(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateMappingRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateMappingResponse|null) => void
+  ): UnaryResponse;
+  createMapping(
+    requestMessage: parca_metastore_v1alpha1_metastore_pb.CreateMappingRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_metastore_v1alpha1_metastore_pb.CreateMappingResponse|null) => void
+  ): UnaryResponse;
+}
+
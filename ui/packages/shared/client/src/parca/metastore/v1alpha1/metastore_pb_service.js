@@ -0,0 +1,381 @@
+// package: parca.metastore.v1alpha1
+// file: parca/metastore/v1alpha1/metastore.proto
+
+var parca_metastore_v1alpha1_metastore_pb = require("../../../parca/metastore/v1alpha1/metastore_pb");
+var grpc = require("@improbable-eng/grpc-web").grpc;
+
+var MetastoreService = (function () {
+  function MetastoreService() {}
+  MetastoreService.serviceName = "parca.metastore.v1alpha1.MetastoreService";
+  return MetastoreService;
+}());
+
+MetastoreService.GetLocationByKey = {
+  methodName: "GetLocationByKey",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.GetLocationByKeyResponse
+};
+
+MetastoreService.GetLocationsByIDs = {
+  methodName: "GetLocationsByIDs",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.GetLocationsByIDsResponse
+};
+
+MetastoreService.CreateLocation = {
+  methodName: "CreateLocation",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.CreateLocationRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.CreateLocationResponse
+};
+
+MetastoreService.Symbolize = {
+  methodName: "Symbolize",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.SymbolizeRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.SymbolizeResponse
+};
+
+MetastoreService.GetSymbolizableLocations = {
+  methodName: "GetSymbolizableLocations",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.GetSymbolizableLocationsResponse
+};
+
+MetastoreService.GetFunctionByKey = {
+  methodName: "GetFunctionByKey",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.GetFunctionByKeyResponse
+};
+
+MetastoreService.CreateFunction = {
+  methodName: "CreateFunction",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.CreateFunctionRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.CreateFunctionResponse
+};
+
+MetastoreService.GetMappingByKey = {
+  methodName: "GetMappingByKey",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.GetMappingByKeyResponse
+};
+
+MetastoreService.CreateMapping = {
+  methodName: "CreateMapping",
+  service: MetastoreService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_metastore_v1alpha1_metastore_pb.CreateMappingRequest,
+  responseType: parca_metastore_v1alpha1_metastore_pb.CreateMappingResponse
+};
+
+exports.MetastoreService = MetastoreService;
+
+function MetastoreServiceClient(serviceHost, options) {
+  this.serviceHost = serviceHost;
+  this.options = options || {};
+}
+
+MetastoreServiceClient.prototype.getLocationByKey = function getLocationByKey(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.GetLocationByKey, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.getLocationsByIDs = function getLocationsByIDs(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.GetLocationsByIDs, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.createLocation = function createLocation(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.CreateLocation, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.symbolize = function symbolize(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.Symbolize, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.getSymbolizableLocations = function getSymbolizableLocations(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.GetSymbolizableLocations, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.getFunctionByKey = function getFunctionByKey(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.GetFunctionByKey, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.createFunction = function createFunction(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.CreateFunction, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.getMappingByKey = function getMappingByKey(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.GetMappingByKey, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+MetastoreServiceClient.prototype.createMapping = function createMapping(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(MetastoreService.CreateMapping, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+exports.MetastoreServiceClient = MetastoreServiceClient;
+
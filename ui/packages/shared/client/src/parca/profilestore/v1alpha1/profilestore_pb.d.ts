@@ -3,6 +3,7 @@
 
 import * as jspb from "google-protobuf";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
+import * as google_protobuf_duration_pb from "google-protobuf/google/protobuf/duration_pb";
 
 export class WriteRawRequest extends jspb.Message {
   getTenant(): string;
@@ -126,6 +127,11 @@ export class RawSample extends jspb.Message {
   getRawProfile_asB64(): string;
   setRawProfile(value: Uint8Array | string): void;
 
+  hasDuration(): boolean;
+  clearDuration(): void;
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RawSample.AsObject;
   static toObject(includeInstance: boolean, msg: RawSample): RawSample.AsObject;
@@ -139,6 +145,7 @@ export class RawSample extends jspb.Message {
 export namespace RawSample {
   export type AsObject = {
     rawProfile: Uint8Array | string,
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
   }
 }
 
@@ -17,6 +17,8 @@ var global = Function('return this')();
 
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js');
 goog.object.extend(proto, google_api_annotations_pb);
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+goog.object.extend(proto, google_protobuf_duration_pb);
 goog.exportSymbol('proto.parca.profilestore.v1alpha1.Label', null, global);
 goog.exportSymbol('proto.parca.profilestore.v1alpha1.LabelSet', null, global);
 goog.exportSymbol('proto.parca.profilestore.v1alpha1.RawProfileSeries', null, global);
@@ -1003,7 +1005,8 @@ proto.parca.profilestore.v1alpha1.RawSample.prototype.toObject = function(opt_in
  */
 proto.parca.profilestore.v1alpha1.RawSample.toObject = function(includeInstance, msg) {
   var f, obj = {
-    rawProfile: msg.getRawProfile_asB64()
+    rawProfile: msg.getRawProfile_asB64(),
+    duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1044,6 +1047,11 @@ proto.parca.profilestore.v1alpha1.RawSample.deserializeBinaryFromReader = functi
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setRawProfile(value);
       break;
+    case 2:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setDuration(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1080,6 +1088,14 @@ proto.parca.profilestore.v1alpha1.RawSample.serializeBinaryToWriter = function(m
       f
     );
   }
+  f = message.getDuration();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -1125,4 +1141,41 @@ proto.parca.profilestore.v1alpha1.RawSample.prototype.setRawProfile = function(v
 };
 
 
+/**
+ * optional google.protobuf.Duration duration = 2;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.parca.profilestore.v1alpha1.RawSample.prototype.getDuration = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.parca.profilestore.v1alpha1.RawSample} returns this
+*/
+proto.parca.profilestore.v1alpha1.RawSample.prototype.setDuration = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.profilestore.v1alpha1.RawSample} returns this
+ */
+proto.parca.profilestore.v1alpha1.RawSample.prototype.clearDuration = function() {
+  return this.setDuration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.profilestore.v1alpha1.RawSample.prototype.hasDuration = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 goog.object.extend(exports, proto.parca.profilestore.v1alpha1);
@@ -3,6 +3,7 @@
 
 import * as jspb from "google-protobuf";
 import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
+import * as google_protobuf_duration_pb from "google-protobuf/google/protobuf/duration_pb";
 import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 import * as parca_profilestore_v1alpha1_profilestore_pb from "../../../parca/profilestore/v1alpha1/profilestore_pb";
 
@@ -23,6 +24,22 @@ export class QueryRangeRequest extends jspb.Message {
   getLimit(): number;
   setLimit(value: number): void;
 
+  hasStep(): boolean;
+  clearStep(): void;
+  getStep(): google_protobuf_duration_pb.Duration | undefined;
+  setStep(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasAggregation(): boolean;
+  clearAggregation(): void;
+  getAggregation(): Aggregation | undefined;
+  setAggregation(value?: Aggregation): void;
+
+  getFunction(): string;
+  setFunction(value: string): void;
+
+  getSampleLabels(): string;
+  setSampleLabels(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): QueryRangeRequest.AsObject;
   static toObject(includeInstance: boolean, msg: QueryRangeRequest): QueryRangeRequest.AsObject;
@@ -39,7 +56,51 @@ export namespace QueryRangeRequest {
     start?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     end?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     limit: number,
+    step?: google_protobuf_duration_pb.Duration.AsObject,
+    aggregation?: Aggregation.AsObject,
+    pb_function: string,
+    sampleLabels: string,
+  }
+}
+
+export class Aggregation extends jspb.Message {
+  getFunction(): Aggregation.FunctionMap[keyof Aggregation.FunctionMap];
+  setFunction(value: Aggregation.FunctionMap[keyof Aggregation.FunctionMap]): void;
+
+  clearByList(): void;
+  getByList(): Array<string>;
+  setByList(value: Array<string>): void;
+  addBy(value: string, index?: number): string;
+
+  getK(): number;
+  setK(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Aggregation.AsObject;
+  static toObject(includeInstance: boolean, msg: Aggregation): Aggregation.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Aggregation, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Aggregation;
+  static deserializeBinaryFromReader(message: Aggregation, reader: jspb.BinaryReader): Aggregation;
+}
+
+export namespace Aggregation {
+  export type AsObject = {
+    pb_function: Aggregation.FunctionMap[keyof Aggregation.FunctionMap],
+    byList: Array<string>,
+    k: number,
+  }
+
+  export interface FunctionMap {
+    FUNCTION_UNSPECIFIED: 0;
+    FUNCTION_SUM: 1;
+    FUNCTION_AVG: 2;
+    FUNCTION_MAX: 3;
+    FUNCTION_TOPK: 4;
   }
+
+  export const Function: FunctionMap;
 }
 
 export class QueryRangeResponse extends jspb.Message {
@@ -101,6 +162,9 @@ export class MetricsSample extends jspb.Message {
   getValue(): number;
   setValue(value: number): void;
 
+  getFlat(): number;
+  setFlat(value: number): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MetricsSample.AsObject;
   static toObject(includeInstance: boolean, msg: MetricsSample): MetricsSample.AsObject;
@@ -115,6 +179,7 @@ export namespace MetricsSample {
   export type AsObject = {
     timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     value: number,
+    flat: number,
   }
 }
 
@@ -132,6 +197,14 @@ export class MergeProfile extends jspb.Message {
   getEnd(): google_protobuf_timestamp_pb.Timestamp | undefined;
   setEnd(value?: google_protobuf_timestamp_pb.Timestamp): void;
 
+  clearGroupByList(): void;
+  getGroupByList(): Array<string>;
+  setGroupByList(value: Array<string>): void;
+  addGroupBy(value: string, index?: number): string;
+
+  getSampleLabels(): string;
+  setSampleLabels(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): MergeProfile.AsObject;
   static toObject(includeInstance: boolean, msg: MergeProfile): MergeProfile.AsObject;
@@ -147,6 +220,8 @@ export namespace MergeProfile {
     query: string,
     start?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     end?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    groupByList: Array<string>,
+    sampleLabels: string,
   }
 }
 
@@ -159,6 +234,9 @@ export class SingleProfile extends jspb.Message {
   getQuery(): string;
   setQuery(value: string): void;
 
+  getSampleLabels(): string;
+  setSampleLabels(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SingleProfile.AsObject;
   static toObject(includeInstance: boolean, msg: SingleProfile): SingleProfile.AsObject;
@@ -173,6 +251,7 @@ export namespace SingleProfile {
   export type AsObject = {
     time?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     query: string,
+    sampleLabels: string,
   }
 }
 
@@ -272,6 +351,36 @@ export class QueryRequest extends jspb.Message {
   getReportType(): QueryRequest.ReportTypeMap[keyof QueryRequest.ReportTypeMap];
   setReportType(value: QueryRequest.ReportTypeMap[keyof QueryRequest.ReportTypeMap]): void;
 
+  hasTopOptions(): boolean;
+  clearTopOptions(): void;
+  getTopOptions(): TopOptions | undefined;
+  setTopOptions(value?: TopOptions): void;
+
+  hasCallgraphOptions(): boolean;
+  clearCallgraphOptions(): void;
+  getCallgraphOptions(): CallgraphOptions | undefined;
+  setCallgraphOptions(value?: CallgraphOptions): void;
+
+  hasSourceOptions(): boolean;
+  clearSourceOptions(): void;
+  getSourceOptions(): SourceOptions | undefined;
+  setSourceOptions(value?: SourceOptions): void;
+
+  hasDisassemblyOptions(): boolean;
+  clearDisassemblyOptions(): void;
+  getDisassemblyOptions(): DisassemblyOptions | undefined;
+  setDisassemblyOptions(value?: DisassemblyOptions): void;
+
+  hasFilter(): boolean;
+  clearFilter(): void;
+  getFilter(): ProfileFilter | undefined;
+  setFilter(value?: ProfileFilter): void;
+
+  hasFlamegraphOptions(): boolean;
+  clearFlamegraphOptions(): void;
+  getFlamegraphOptions(): FlamegraphOptions | undefined;
+  setFlamegraphOptions(value?: FlamegraphOptions): void;
+
   getOptionsCase(): QueryRequest.OptionsCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): QueryRequest.AsObject;
@@ -290,6 +399,12 @@ export namespace QueryRequest {
     merge?: MergeProfile.AsObject,
     single?: SingleProfile.AsObject,
     reportType: QueryRequest.ReportTypeMap[keyof QueryRequest.ReportTypeMap],
+    topOptions?: TopOptions.AsObject,
+    callgraphOptions?: CallgraphOptions.AsObject,
+    sourceOptions?: SourceOptions.AsObject,
+    disassemblyOptions?: DisassemblyOptions.AsObject,
+    filter?: ProfileFilter.AsObject,
+    flamegraphOptions?: FlamegraphOptions.AsObject,
   }
 
   export interface ModeMap {
@@ -302,6 +417,11 @@ export namespace QueryRequest {
 
   export interface ReportTypeMap {
     REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED: 0;
+    REPORT_TYPE_PPROF: 1;
+    REPORT_TYPE_TOP: 2;
+    REPORT_TYPE_CALLGRAPH: 3;
+    REPORT_TYPE_SOURCE: 4;
+    REPORT_TYPE_DISASSEMBLY: 5;
   }
 
   export const ReportType: ReportTypeMap;
@@ -314,6 +434,625 @@ export namespace QueryRequest {
   }
 }
 
+export class ProfileFilter extends jspb.Message {
+  getFocus(): string;
+  setFocus(value: string): void;
+
+  getIgnore(): string;
+  setIgnore(value: string): void;
+
+  getHide(): string;
+  setHide(value: string): void;
+
+  getShow(): string;
+  setShow(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ProfileFilter.AsObject;
+  static toObject(includeInstance: boolean, msg: ProfileFilter): ProfileFilter.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ProfileFilter, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ProfileFilter;
+  static deserializeBinaryFromReader(message: ProfileFilter, reader: jspb.BinaryReader): ProfileFilter;
+}
+
+export namespace ProfileFilter {
+  export type AsObject = {
+    focus: string,
+    ignore: string,
+    hide: string,
+    show: string,
+  }
+}
+
+export class TopOptions extends jspb.Message {
+  getSortBy(): TopOptions.SortByMap[keyof TopOptions.SortByMap];
+  setSortBy(value: TopOptions.SortByMap[keyof TopOptions.SortByMap]): void;
+
+  getLimit(): number;
+  setLimit(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TopOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: TopOptions): TopOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TopOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TopOptions;
+  static deserializeBinaryFromReader(message: TopOptions, reader: jspb.BinaryReader): TopOptions;
+}
+
+export namespace TopOptions {
+  export type AsObject = {
+    sortBy: TopOptions.SortByMap[keyof TopOptions.SortByMap],
+    limit: number,
+  }
+
+  export interface SortByMap {
+    SORT_BY_FLAT_UNSPECIFIED: 0;
+    SORT_BY_CUMULATIVE: 1;
+    SORT_BY_FLAT_DIFF: 2;
+    SORT_BY_CUMULATIVE_DIFF: 3;
+    SORT_BY_NAME: 4;
+  }
+
+  export const SortBy: SortByMap;
+}
+
+export class CallgraphOptions extends jspb.Message {
+  getNodeFraction(): number;
+  setNodeFraction(value: number): void;
+
+  getEdgeFraction(): number;
+  setEdgeFraction(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CallgraphOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: CallgraphOptions): CallgraphOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CallgraphOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CallgraphOptions;
+  static deserializeBinaryFromReader(message: CallgraphOptions, reader: jspb.BinaryReader): CallgraphOptions;
+}
+
+export namespace CallgraphOptions {
+  export type AsObject = {
+    nodeFraction: number,
+    edgeFraction: number,
+  }
+}
+
+export class FlamegraphOptions extends jspb.Message {
+  getMaxNodes(): number;
+  setMaxNodes(value: number): void;
+
+  getMinFraction(): number;
+  setMinFraction(value: number): void;
+
+  getInverted(): boolean;
+  setInverted(value: boolean): void;
+
+  getAggregateBy(): FlamegraphOptions.AggregateByMap[keyof FlamegraphOptions.AggregateByMap];
+  setAggregateBy(value: FlamegraphOptions.AggregateByMap[keyof FlamegraphOptions.AggregateByMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FlamegraphOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: FlamegraphOptions): FlamegraphOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: FlamegraphOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FlamegraphOptions;
+  static deserializeBinaryFromReader(message: FlamegraphOptions, reader: jspb.BinaryReader): FlamegraphOptions;
+}
+
+export namespace FlamegraphOptions {
+  export type AsObject = {
+    maxNodes: number,
+    minFraction: number,
+    inverted: boolean,
+    aggregateBy: FlamegraphOptions.AggregateByMap[keyof FlamegraphOptions.AggregateByMap],
+  }
+
+  export interface AggregateByMap {
+    AGGREGATE_BY_FUNCTION_UNSPECIFIED: 0;
+    AGGREGATE_BY_PACKAGE: 1;
+    AGGREGATE_BY_FILENAME: 2;
+    AGGREGATE_BY_MAPPING: 3;
+  }
+
+  export const AggregateBy: AggregateByMap;
+}
+
+export class SourceOptions extends jspb.Message {
+  getFunction(): string;
+  setFunction(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SourceOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: SourceOptions): SourceOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SourceOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SourceOptions;
+  static deserializeBinaryFromReader(message: SourceOptions, reader: jspb.BinaryReader): SourceOptions;
+}
+
+export namespace SourceOptions {
+  export type AsObject = {
+    pb_function: string,
+  }
+}
+
+export class DisassemblyOptions extends jspb.Message {
+  getFunction(): string;
+  setFunction(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DisassemblyOptions.AsObject;
+  static toObject(includeInstance: boolean, msg: DisassemblyOptions): DisassemblyOptions.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: DisassemblyOptions, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DisassemblyOptions;
+  static deserializeBinaryFromReader(message: DisassemblyOptions, reader: jspb.BinaryReader): DisassemblyOptions;
+}
+
+export namespace DisassemblyOptions {
+  export type AsObject = {
+    pb_function: string,
+  }
+}
+
+export class Top extends jspb.Message {
+  clearListList(): void;
+  getListList(): Array<TopNode>;
+  setListList(value: Array<TopNode>): void;
+  addList(value?: TopNode, index?: number): TopNode;
+
+  getReported(): number;
+  setReported(value: number): void;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  getUnit(): string;
+  setUnit(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Top.AsObject;
+  static toObject(includeInstance: boolean, msg: Top): Top.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Top, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Top;
+  static deserializeBinaryFromReader(message: Top, reader: jspb.BinaryReader): Top;
+}
+
+export namespace Top {
+  export type AsObject = {
+    listList: Array<TopNode.AsObject>,
+    reported: number,
+    total: number,
+    unit: string,
+  }
+}
+
+export class TopNode extends jspb.Message {
+  hasMeta(): boolean;
+  clearMeta(): void;
+  getMeta(): TopNodeMeta | undefined;
+  setMeta(value?: TopNodeMeta): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  getFlatDiff(): number;
+  setFlatDiff(value: number): void;
+
+  getCumulativeDiff(): number;
+  setCumulativeDiff(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TopNode.AsObject;
+  static toObject(includeInstance: boolean, msg: TopNode): TopNode.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TopNode, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TopNode;
+  static deserializeBinaryFromReader(message: TopNode, reader: jspb.BinaryReader): TopNode;
+}
+
+export namespace TopNode {
+  export type AsObject = {
+    meta?: TopNodeMeta.AsObject,
+    flat: number,
+    cumulative: number,
+    flatDiff: number,
+    cumulativeDiff: number,
+  }
+}
+
+export class TopNodeMeta extends jspb.Message {
+  hasLocation(): boolean;
+  clearLocation(): void;
+  getLocation(): Location | undefined;
+  setLocation(value?: Location): void;
+
+  hasMapping(): boolean;
+  clearMapping(): void;
+  getMapping(): Mapping | undefined;
+  setMapping(value?: Mapping): void;
+
+  hasFunction(): boolean;
+  clearFunction(): void;
+  getFunction(): Function | undefined;
+  setFunction(value?: Function): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TopNodeMeta.AsObject;
+  static toObject(includeInstance: boolean, msg: TopNodeMeta): TopNodeMeta.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TopNodeMeta, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TopNodeMeta;
+  static deserializeBinaryFromReader(message: TopNodeMeta, reader: jspb.BinaryReader): TopNodeMeta;
+}
+
+export namespace TopNodeMeta {
+  export type AsObject = {
+    location?: Location.AsObject,
+    mapping?: Mapping.AsObject,
+    pb_function?: Function.AsObject,
+  }
+}
+
+export class Callgraph extends jspb.Message {
+  clearNodesList(): void;
+  getNodesList(): Array<CallgraphNode>;
+  setNodesList(value: Array<CallgraphNode>): void;
+  addNodes(value?: CallgraphNode, index?: number): CallgraphNode;
+
+  clearEdgesList(): void;
+  getEdgesList(): Array<CallgraphEdge>;
+  setEdgesList(value: Array<CallgraphEdge>): void;
+  addEdges(value?: CallgraphEdge, index?: number): CallgraphEdge;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  getUnit(): string;
+  setUnit(value: string): void;
+
+  getDot(): string;
+  setDot(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Callgraph.AsObject;
+  static toObject(includeInstance: boolean, msg: Callgraph): Callgraph.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Callgraph, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Callgraph;
+  static deserializeBinaryFromReader(message: Callgraph, reader: jspb.BinaryReader): Callgraph;
+}
+
+export namespace Callgraph {
+  export type AsObject = {
+    nodesList: Array<CallgraphNode.AsObject>,
+    edgesList: Array<CallgraphEdge.AsObject>,
+    total: number,
+    unit: string,
+    dot: string,
+  }
+}
+
+export class CallgraphNode extends jspb.Message {
+  getId(): number;
+  setId(value: number): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getFilename(): string;
+  setFilename(value: string): void;
+
+  getAddress(): number;
+  setAddress(value: number): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CallgraphNode.AsObject;
+  static toObject(includeInstance: boolean, msg: CallgraphNode): CallgraphNode.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CallgraphNode, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CallgraphNode;
+  static deserializeBinaryFromReader(message: CallgraphNode, reader: jspb.BinaryReader): CallgraphNode;
+}
+
+export namespace CallgraphNode {
+  export type AsObject = {
+    id: number,
+    name: string,
+    filename: string,
+    address: number,
+    flat: number,
+    cumulative: number,
+  }
+}
+
+export class CallgraphEdge extends jspb.Message {
+  getSource(): number;
+  setSource(value: number): void;
+
+  getTarget(): number;
+  setTarget(value: number): void;
+
+  getWeight(): number;
+  setWeight(value: number): void;
+
+  getResidual(): boolean;
+  setResidual(value: boolean): void;
+
+  getInline(): boolean;
+  setInline(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CallgraphEdge.AsObject;
+  static toObject(includeInstance: boolean, msg: CallgraphEdge): CallgraphEdge.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CallgraphEdge, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CallgraphEdge;
+  static deserializeBinaryFromReader(message: CallgraphEdge, reader: jspb.BinaryReader): CallgraphEdge;
+}
+
+export namespace CallgraphEdge {
+  export type AsObject = {
+    source: number,
+    target: number,
+    weight: number,
+    residual: boolean,
+    inline: boolean,
+  }
+}
+
+export class Source extends jspb.Message {
+  clearFilesList(): void;
+  getFilesList(): Array<SourceFile>;
+  setFilesList(value: Array<SourceFile>): void;
+  addFiles(value?: SourceFile, index?: number): SourceFile;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  getUnit(): string;
+  setUnit(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Source.AsObject;
+  static toObject(includeInstance: boolean, msg: Source): Source.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Source, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Source;
+  static deserializeBinaryFromReader(message: Source, reader: jspb.BinaryReader): Source;
+}
+
+export namespace Source {
+  export type AsObject = {
+    filesList: Array<SourceFile.AsObject>,
+    total: number,
+    unit: string,
+  }
+}
+
+export class SourceFile extends jspb.Message {
+  getFilename(): string;
+  setFilename(value: string): void;
+
+  getBuildId(): string;
+  setBuildId(value: string): void;
+
+  getHasSource(): boolean;
+  setHasSource(value: boolean): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  clearLinesList(): void;
+  getLinesList(): Array<SourceLine>;
+  setLinesList(value: Array<SourceLine>): void;
+  addLines(value?: SourceLine, index?: number): SourceLine;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SourceFile.AsObject;
+  static toObject(includeInstance: boolean, msg: SourceFile): SourceFile.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SourceFile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SourceFile;
+  static deserializeBinaryFromReader(message: SourceFile, reader: jspb.BinaryReader): SourceFile;
+}
+
+export namespace SourceFile {
+  export type AsObject = {
+    filename: string,
+    buildId: string,
+    hasSource: boolean,
+    flat: number,
+    cumulative: number,
+    linesList: Array<SourceLine.AsObject>,
+  }
+}
+
+export class SourceLine extends jspb.Message {
+  getLine(): number;
+  setLine(value: number): void;
+
+  getText(): string;
+  setText(value: string): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SourceLine.AsObject;
+  static toObject(includeInstance: boolean, msg: SourceLine): SourceLine.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SourceLine, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SourceLine;
+  static deserializeBinaryFromReader(message: SourceLine, reader: jspb.BinaryReader): SourceLine;
+}
+
+export namespace SourceLine {
+  export type AsObject = {
+    line: number,
+    text: string,
+    flat: number,
+    cumulative: number,
+  }
+}
+
+export class Disassembly extends jspb.Message {
+  clearSymbolsList(): void;
+  getSymbolsList(): Array<DisassemblySymbol>;
+  setSymbolsList(value: Array<DisassemblySymbol>): void;
+  addSymbols(value?: DisassemblySymbol, index?: number): DisassemblySymbol;
+
+  getTotal(): number;
+  setTotal(value: number): void;
+
+  getUnit(): string;
+  setUnit(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Disassembly.AsObject;
+  static toObject(includeInstance: boolean, msg: Disassembly): Disassembly.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Disassembly, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Disassembly;
+  static deserializeBinaryFromReader(message: Disassembly, reader: jspb.BinaryReader): Disassembly;
+}
+
+export namespace Disassembly {
+  export type AsObject = {
+    symbolsList: Array<DisassemblySymbol.AsObject>,
+    total: number,
+    unit: string,
+  }
+}
+
+export class DisassemblySymbol extends jspb.Message {
+  clearNamesList(): void;
+  getNamesList(): Array<string>;
+  setNamesList(value: Array<string>): void;
+  addNames(value: string, index?: number): string;
+
+  getBuildId(): string;
+  setBuildId(value: string): void;
+
+  getStart(): number;
+  setStart(value: number): void;
+
+  getEnd(): number;
+  setEnd(value: number): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  clearInstructionsList(): void;
+  getInstructionsList(): Array<DisassemblyInstruction>;
+  setInstructionsList(value: Array<DisassemblyInstruction>): void;
+  addInstructions(value?: DisassemblyInstruction, index?: number): DisassemblyInstruction;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DisassemblySymbol.AsObject;
+  static toObject(includeInstance: boolean, msg: DisassemblySymbol): DisassemblySymbol.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: DisassemblySymbol, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DisassemblySymbol;
+  static deserializeBinaryFromReader(message: DisassemblySymbol, reader: jspb.BinaryReader): DisassemblySymbol;
+}
+
+export namespace DisassemblySymbol {
+  export type AsObject = {
+    namesList: Array<string>,
+    buildId: string,
+    start: number,
+    end: number,
+    flat: number,
+    cumulative: number,
+    instructionsList: Array<DisassemblyInstruction.AsObject>,
+  }
+}
+
+export class DisassemblyInstruction extends jspb.Message {
+  getAddress(): number;
+  setAddress(value: number): void;
+
+  getText(): string;
+  setText(value: string): void;
+
+  getFunction(): string;
+  setFunction(value: string): void;
+
+  getFilename(): string;
+  setFilename(value: string): void;
+
+  getLine(): number;
+  setLine(value: number): void;
+
+  getFlat(): number;
+  setFlat(value: number): void;
+
+  getCumulative(): number;
+  setCumulative(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DisassemblyInstruction.AsObject;
+  static toObject(includeInstance: boolean, msg: DisassemblyInstruction): DisassemblyInstruction.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: DisassemblyInstruction, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DisassemblyInstruction;
+  static deserializeBinaryFromReader(message: DisassemblyInstruction, reader: jspb.BinaryReader): DisassemblyInstruction;
+}
+
+export namespace DisassemblyInstruction {
+  export type AsObject = {
+    address: number,
+    text: string,
+    pb_function: string,
+    filename: string,
+    line: number,
+    flat: number,
+    cumulative: number,
+  }
+}
+
 export class Flamegraph extends jspb.Message {
   hasRoot(): boolean;
   clearRoot(): void;
@@ -395,6 +1134,9 @@ export class FlamegraphNode extends jspb.Message {
   setChildrenList(value: Array<FlamegraphNode>): void;
   addChildren(value?: FlamegraphNode, index?: number): FlamegraphNode;
 
+  getOther(): boolean;
+  setOther(value: boolean): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): FlamegraphNode.AsObject;
   static toObject(includeInstance: boolean, msg: FlamegraphNode): FlamegraphNode.AsObject;
@@ -411,6 +1153,7 @@ export namespace FlamegraphNode {
     cumulative: number,
     diff: number,
     childrenList: Array<FlamegraphNode.AsObject>,
+    other: boolean,
   }
 }
 
@@ -596,6 +1339,38 @@ export class QueryResponse extends jspb.Message {
   getFlamegraph(): Flamegraph | undefined;
   setFlamegraph(value?: Flamegraph): void;
 
+  hasPprof(): boolean;
+  clearPprof(): void;
+  getPprof(): Uint8Array | string;
+  getPprof_asU8(): Uint8Array;
+  getPprof_asB64(): string;
+  setPprof(value: Uint8Array | string): void;
+
+  hasTop(): boolean;
+  clearTop(): void;
+  getTop(): Top | undefined;
+  setTop(value?: Top): void;
+
+  hasCallgraph(): boolean;
+  clearCallgraph(): void;
+  getCallgraph(): Callgraph | undefined;
+  setCallgraph(value?: Callgraph): void;
+
+  hasSource(): boolean;
+  clearSource(): void;
+  getSource(): Source | undefined;
+  setSource(value?: Source): void;
+
+  hasDisassembly(): boolean;
+  clearDisassembly(): void;
+  getDisassembly(): Disassembly | undefined;
+  setDisassembly(value?: Disassembly): void;
+
+  hasGroups(): boolean;
+  clearGroups(): void;
+  getGroups(): ReportGroups | undefined;
+  setGroups(value?: ReportGroups): void;
+
   getReportCase(): QueryResponse.ReportCase;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): QueryResponse.AsObject;
@@ -610,11 +1385,73 @@ export class QueryResponse extends jspb.Message {
 export namespace QueryResponse {
   export type AsObject = {
     flamegraph?: Flamegraph.AsObject,
+    pprof: Uint8Array | string,
+    top?: Top.AsObject,
+    callgraph?: Callgraph.AsObject,
+    source?: Source.AsObject,
+    disassembly?: Disassembly.AsObject,
+    groups?: ReportGroups.AsObject,
   }
 
   export enum ReportCase {
     REPORT_NOT_SET = 0,
     FLAMEGRAPH = 5,
+    PPROF = 6,
+    TOP = 7,
+    CALLGRAPH = 8,
+    SOURCE = 9,
+    DISASSEMBLY = 10,
+    GROUPS = 11,
+  }
+}
+
+export class ReportGroups extends jspb.Message {
+  clearGroupsList(): void;
+  getGroupsList(): Array<ReportGroup>;
+  setGroupsList(value: Array<ReportGroup>): void;
+  addGroups(value?: ReportGroup, index?: number): ReportGroup;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReportGroups.AsObject;
+  static toObject(includeInstance: boolean, msg: ReportGroups): ReportGroups.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ReportGroups, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReportGroups;
+  static deserializeBinaryFromReader(message: ReportGroups, reader: jspb.BinaryReader): ReportGroups;
+}
+
+export namespace ReportGroups {
+  export type AsObject = {
+    groupsList: Array<ReportGroup.AsObject>,
+  }
+}
+
+export class ReportGroup extends jspb.Message {
+  hasLabelset(): boolean;
+  clearLabelset(): void;
+  getLabelset(): parca_profilestore_v1alpha1_profilestore_pb.LabelSet | undefined;
+  setLabelset(value?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet): void;
+
+  hasReport(): boolean;
+  clearReport(): void;
+  getReport(): QueryResponse | undefined;
+  setReport(value?: QueryResponse): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReportGroup.AsObject;
+  static toObject(includeInstance: boolean, msg: ReportGroup): ReportGroup.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ReportGroup, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReportGroup;
+  static deserializeBinaryFromReader(message: ReportGroup, reader: jspb.BinaryReader): ReportGroup;
+}
+
+export namespace ReportGroup {
+  export type AsObject = {
+    labelset?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet.AsObject,
+    report?: QueryResponse.AsObject,
   }
 }
 
@@ -653,6 +1490,16 @@ export namespace SeriesRequest {
 }
 
 export class SeriesResponse extends jspb.Message {
+  clearSeriesList(): void;
+  getSeriesList(): Array<SeriesMetadata>;
+  setSeriesList(value: Array<SeriesMetadata>): void;
+  addSeries(value?: SeriesMetadata, index?: number): SeriesMetadata;
+
+  clearWarningsList(): void;
+  getWarningsList(): Array<string>;
+  setWarningsList(value: Array<string>): void;
+  addWarnings(value: string, index?: number): string;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SeriesResponse.AsObject;
   static toObject(includeInstance: boolean, msg: SeriesResponse): SeriesResponse.AsObject;
@@ -665,6 +1512,82 @@ export class SeriesResponse extends jspb.Message {
 
 export namespace SeriesResponse {
   export type AsObject = {
+    seriesList: Array<SeriesMetadata.AsObject>,
+    warningsList: Array<string>,
+  }
+}
+
+export class SeriesMetadata extends jspb.Message {
+  hasLabelset(): boolean;
+  clearLabelset(): void;
+  getLabelset(): parca_profilestore_v1alpha1_profilestore_pb.LabelSet | undefined;
+  setLabelset(value?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet): void;
+
+  hasMinTime(): boolean;
+  clearMinTime(): void;
+  getMinTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setMinTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasMaxTime(): boolean;
+  clearMaxTime(): void;
+  getMaxTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setMaxTime(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getNumSamples(): number;
+  setNumSamples(value: number): void;
+
+  hasSampleType(): boolean;
+  clearSampleType(): void;
+  getSampleType(): ValueType | undefined;
+  setSampleType(value?: ValueType): void;
+
+  hasPeriodType(): boolean;
+  clearPeriodType(): void;
+  getPeriodType(): ValueType | undefined;
+  setPeriodType(value?: ValueType): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SeriesMetadata.AsObject;
+  static toObject(includeInstance: boolean, msg: SeriesMetadata): SeriesMetadata.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SeriesMetadata, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SeriesMetadata;
+  static deserializeBinaryFromReader(message: SeriesMetadata, reader: jspb.BinaryReader): SeriesMetadata;
+}
+
+export namespace SeriesMetadata {
+  export type AsObject = {
+    labelset?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet.AsObject,
+    minTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    maxTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    numSamples: number,
+    sampleType?: ValueType.AsObject,
+    periodType?: ValueType.AsObject,
+  }
+}
+
+export class ValueType extends jspb.Message {
+  getType(): string;
+  setType(value: string): void;
+
+  getUnit(): string;
+  setUnit(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ValueType.AsObject;
+  static toObject(includeInstance: boolean, msg: ValueType): ValueType.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ValueType, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ValueType;
+  static deserializeBinaryFromReader(message: ValueType, reader: jspb.BinaryReader): ValueType;
+}
+
+export namespace ValueType {
+  export type AsObject = {
+    type: string,
+    unit: string,
   }
 }
 
@@ -17,14 +17,28 @@ var global = Function('return this')();
 
 var google_api_annotations_pb = require('../../../google/api/annotations_pb.js');
 goog.object.extend(proto, google_api_annotations_pb);
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+goog.object.extend(proto, google_protobuf_duration_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
 var parca_profilestore_v1alpha1_profilestore_pb = require('../../../parca/profilestore/v1alpha1/profilestore_pb.js');
 goog.object.extend(proto, parca_profilestore_v1alpha1_profilestore_pb);
+goog.exportSymbol('proto.parca.query.v1alpha1.Aggregation', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.Aggregation.Function', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.Callgraph', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.CallgraphEdge', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.CallgraphNode', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.CallgraphOptions', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.DiffProfile', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.Disassembly', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.DisassemblyInstruction', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.DisassemblyOptions', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.DisassemblySymbol', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.Flamegraph', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.FlamegraphNode', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.FlamegraphNodeMeta', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.FlamegraphOptions', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.FlamegraphRootNode', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.Function', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.LabelsRequest', null, global);
@@ -38,6 +52,7 @@ goog.exportSymbol('proto.parca.query.v1alpha1.MetricsSeries', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.ProfileDiffSelection', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.ProfileDiffSelection.Mode', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.ProfileFilter', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryRangeRequest', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryRangeResponse', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryRequest', null, global);
@@ -46,9 +61,22 @@ goog.exportSymbol('proto.parca.query.v1alpha1.QueryRequest.OptionsCase', null, g
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryRequest.ReportType', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryResponse', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.QueryResponse.ReportCase', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.ReportGroup', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.ReportGroups', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.SeriesMetadata', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.SeriesRequest', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.SeriesResponse', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.SingleProfile', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.Source', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.SourceFile', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.SourceLine', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.SourceOptions', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.Top', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.TopNode', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.TopNodeMeta', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.TopOptions', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.TopOptions.SortBy', null, global);
+goog.exportSymbol('proto.parca.query.v1alpha1.ValueType', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.ValuesRequest', null, global);
 goog.exportSymbol('proto.parca.query.v1alpha1.ValuesResponse', null, global);
 /**
@@ -72,6 +100,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.parca.query.v1alpha1.QueryRangeRequest.displayName = 'proto.parca.query.v1alpha1.QueryRangeRequest';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.Aggregation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.Aggregation.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.Aggregation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Aggregation.displayName = 'proto.parca.query.v1alpha1.Aggregation';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -146,7 +195,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.parca.query.v1alpha1.MergeProfile = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.MergeProfile.repeatedFields_, null);
 };
 goog.inherits(proto.parca.query.v1alpha1.MergeProfile, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -250,16 +299,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.Flamegraph = function(opt_data) {
+proto.parca.query.v1alpha1.ProfileFilter = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.Flamegraph, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.ProfileFilter, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.Flamegraph.displayName = 'proto.parca.query.v1alpha1.Flamegraph';
+  proto.parca.query.v1alpha1.ProfileFilter.displayName = 'proto.parca.query.v1alpha1.ProfileFilter';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -271,16 +320,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.FlamegraphRootNode.repeatedFields_, null);
+proto.parca.query.v1alpha1.TopOptions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.FlamegraphRootNode, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.TopOptions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.FlamegraphRootNode.displayName = 'proto.parca.query.v1alpha1.FlamegraphRootNode';
+  proto.parca.query.v1alpha1.TopOptions.displayName = 'proto.parca.query.v1alpha1.TopOptions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -292,16 +341,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.FlamegraphNode = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.FlamegraphNode.repeatedFields_, null);
+proto.parca.query.v1alpha1.CallgraphOptions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.FlamegraphNode, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.CallgraphOptions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.FlamegraphNode.displayName = 'proto.parca.query.v1alpha1.FlamegraphNode';
+  proto.parca.query.v1alpha1.CallgraphOptions.displayName = 'proto.parca.query.v1alpha1.CallgraphOptions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -313,16 +362,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta = function(opt_data) {
+proto.parca.query.v1alpha1.FlamegraphOptions = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.FlamegraphNodeMeta, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.FlamegraphOptions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.FlamegraphNodeMeta.displayName = 'proto.parca.query.v1alpha1.FlamegraphNodeMeta';
+  proto.parca.query.v1alpha1.FlamegraphOptions.displayName = 'proto.parca.query.v1alpha1.FlamegraphOptions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -334,16 +383,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.Location = function(opt_data) {
+proto.parca.query.v1alpha1.SourceOptions = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.Location, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.SourceOptions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.Location.displayName = 'proto.parca.query.v1alpha1.Location';
+  proto.parca.query.v1alpha1.SourceOptions.displayName = 'proto.parca.query.v1alpha1.SourceOptions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -355,16 +404,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.Line = function(opt_data) {
+proto.parca.query.v1alpha1.DisassemblyOptions = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.Line, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.DisassemblyOptions, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.Line.displayName = 'proto.parca.query.v1alpha1.Line';
+  proto.parca.query.v1alpha1.DisassemblyOptions.displayName = 'proto.parca.query.v1alpha1.DisassemblyOptions';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -376,16 +425,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.Mapping = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.parca.query.v1alpha1.Top = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.Top.repeatedFields_, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.Mapping, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.Top, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.Mapping.displayName = 'proto.parca.query.v1alpha1.Mapping';
+  proto.parca.query.v1alpha1.Top.displayName = 'proto.parca.query.v1alpha1.Top';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -397,16 +446,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.Function = function(opt_data) {
+proto.parca.query.v1alpha1.TopNode = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.Function, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.TopNode, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.Function.displayName = 'proto.parca.query.v1alpha1.Function';
+  proto.parca.query.v1alpha1.TopNode.displayName = 'proto.parca.query.v1alpha1.TopNode';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -418,16 +467,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.QueryResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_);
+proto.parca.query.v1alpha1.TopNodeMeta = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.QueryResponse, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.TopNodeMeta, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.QueryResponse.displayName = 'proto.parca.query.v1alpha1.QueryResponse';
+  proto.parca.query.v1alpha1.TopNodeMeta.displayName = 'proto.parca.query.v1alpha1.TopNodeMeta';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -439,16 +488,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.SeriesRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.SeriesRequest.repeatedFields_, null);
+proto.parca.query.v1alpha1.Callgraph = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.Callgraph.repeatedFields_, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.SeriesRequest, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.Callgraph, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.SeriesRequest.displayName = 'proto.parca.query.v1alpha1.SeriesRequest';
+  proto.parca.query.v1alpha1.Callgraph.displayName = 'proto.parca.query.v1alpha1.Callgraph';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -460,16 +509,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.SeriesResponse = function(opt_data) {
+proto.parca.query.v1alpha1.CallgraphNode = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.SeriesResponse, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.CallgraphNode, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.SeriesResponse.displayName = 'proto.parca.query.v1alpha1.SeriesResponse';
+  proto.parca.query.v1alpha1.CallgraphNode.displayName = 'proto.parca.query.v1alpha1.CallgraphNode';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -481,16 +530,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.LabelsRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.LabelsRequest.repeatedFields_, null);
+proto.parca.query.v1alpha1.CallgraphEdge = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.LabelsRequest, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.CallgraphEdge, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.LabelsRequest.displayName = 'proto.parca.query.v1alpha1.LabelsRequest';
+  proto.parca.query.v1alpha1.CallgraphEdge.displayName = 'proto.parca.query.v1alpha1.CallgraphEdge';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -502,16 +551,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.LabelsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.LabelsResponse.repeatedFields_, null);
+proto.parca.query.v1alpha1.Source = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.Source.repeatedFields_, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.LabelsResponse, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.Source, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.LabelsResponse.displayName = 'proto.parca.query.v1alpha1.LabelsResponse';
+  proto.parca.query.v1alpha1.Source.displayName = 'proto.parca.query.v1alpha1.Source';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -523,16 +572,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.ValuesRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.ValuesRequest.repeatedFields_, null);
+proto.parca.query.v1alpha1.SourceFile = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.SourceFile.repeatedFields_, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.ValuesRequest, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.SourceFile, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.ValuesRequest.displayName = 'proto.parca.query.v1alpha1.ValuesRequest';
+  proto.parca.query.v1alpha1.SourceFile.displayName = 'proto.parca.query.v1alpha1.SourceFile';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -544,162 +593,6695 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.parca.query.v1alpha1.ValuesResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.ValuesResponse.repeatedFields_, null);
+proto.parca.query.v1alpha1.SourceLine = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.parca.query.v1alpha1.ValuesResponse, jspb.Message);
+goog.inherits(proto.parca.query.v1alpha1.SourceLine, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.parca.query.v1alpha1.ValuesResponse.displayName = 'proto.parca.query.v1alpha1.ValuesResponse';
+  proto.parca.query.v1alpha1.SourceLine.displayName = 'proto.parca.query.v1alpha1.SourceLine';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.QueryRangeRequest.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Disassembly = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.Disassembly.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.parca.query.v1alpha1.Disassembly, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Disassembly.displayName = 'proto.parca.query.v1alpha1.Disassembly';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    limit: jspb.Message.getFieldWithDefault(msg, 4, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.parca.query.v1alpha1.DisassemblySymbol = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.DisassemblySymbol.repeatedFields_, null);
 };
+goog.inherits(proto.parca.query.v1alpha1.DisassemblySymbol, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.DisassemblySymbol.displayName = 'proto.parca.query.v1alpha1.DisassemblySymbol';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.QueryRangeRequest;
-  return proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinaryFromReader(msg, reader);
+proto.parca.query.v1alpha1.DisassemblyInstruction = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.parca.query.v1alpha1.DisassemblyInstruction, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.DisassemblyInstruction.displayName = 'proto.parca.query.v1alpha1.DisassemblyInstruction';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setQuery(value);
-      break;
-    case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStart(value);
-      break;
-    case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setEnd(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setLimit(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.parca.query.v1alpha1.Flamegraph = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.parca.query.v1alpha1.Flamegraph, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Flamegraph.displayName = 'proto.parca.query.v1alpha1.Flamegraph';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.QueryRangeRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.parca.query.v1alpha1.FlamegraphRootNode = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.FlamegraphRootNode.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.parca.query.v1alpha1.FlamegraphRootNode, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.FlamegraphRootNode.displayName = 'proto.parca.query.v1alpha1.FlamegraphRootNode';
+}
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.parca.query.v1alpha1.QueryRangeRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.FlamegraphNode = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.FlamegraphNode.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.FlamegraphNode, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.FlamegraphNode.displayName = 'proto.parca.query.v1alpha1.FlamegraphNode';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.FlamegraphNodeMeta = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.FlamegraphNodeMeta, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.FlamegraphNodeMeta.displayName = 'proto.parca.query.v1alpha1.FlamegraphNodeMeta';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.Location = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.Location, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Location.displayName = 'proto.parca.query.v1alpha1.Location';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.Line = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.Line, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Line.displayName = 'proto.parca.query.v1alpha1.Line';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.Mapping = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.Mapping, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Mapping.displayName = 'proto.parca.query.v1alpha1.Mapping';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.Function = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.Function, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.Function.displayName = 'proto.parca.query.v1alpha1.Function';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.QueryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_);
+};
+goog.inherits(proto.parca.query.v1alpha1.QueryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.QueryResponse.displayName = 'proto.parca.query.v1alpha1.QueryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.ReportGroups = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.ReportGroups.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.ReportGroups, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.ReportGroups.displayName = 'proto.parca.query.v1alpha1.ReportGroups';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.ReportGroup = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.ReportGroup, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.ReportGroup.displayName = 'proto.parca.query.v1alpha1.ReportGroup';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.SeriesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.SeriesRequest.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.SeriesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.SeriesRequest.displayName = 'proto.parca.query.v1alpha1.SeriesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.SeriesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.SeriesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.SeriesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.SeriesResponse.displayName = 'proto.parca.query.v1alpha1.SeriesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.SeriesMetadata = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.SeriesMetadata, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.SeriesMetadata.displayName = 'proto.parca.query.v1alpha1.SeriesMetadata';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.ValueType = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.ValueType, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.ValueType.displayName = 'proto.parca.query.v1alpha1.ValueType';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.LabelsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.LabelsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.LabelsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.LabelsRequest.displayName = 'proto.parca.query.v1alpha1.LabelsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.LabelsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.LabelsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.LabelsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.LabelsResponse.displayName = 'proto.parca.query.v1alpha1.LabelsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.ValuesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.ValuesRequest.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.ValuesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.ValuesRequest.displayName = 'proto.parca.query.v1alpha1.ValuesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.query.v1alpha1.ValuesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.query.v1alpha1.ValuesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.query.v1alpha1.ValuesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.query.v1alpha1.ValuesResponse.displayName = 'proto.parca.query.v1alpha1.ValuesResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.QueryRangeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    limit: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    step: (f = msg.getStep()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    aggregation: (f = msg.getAggregation()) && proto.parca.query.v1alpha1.Aggregation.toObject(includeInstance, f),
+    pb_function: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    sampleLabels: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.QueryRangeRequest;
+  return proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStart(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEnd(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLimit(value);
+      break;
+    case 5:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setStep(value);
+      break;
+    case 6:
+      var value = new proto.parca.query.v1alpha1.Aggregation;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Aggregation.deserializeBinaryFromReader);
+      msg.setAggregation(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFunction(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSampleLabels(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.QueryRangeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.QueryRangeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnd();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getStep();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getAggregation();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.parca.query.v1alpha1.Aggregation.serializeBinaryToWriter
+    );
+  }
+  f = message.getFunction();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getSampleLabels();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string query = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setStart = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearStart = function() {
+  return this.setStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasStart = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp end = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getEnd = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setEnd = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearEnd = function() {
+  return this.setEnd(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasEnd = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional uint32 limit = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Duration step = 5;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getStep = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setStep = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearStep = function() {
+  return this.setStep(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasStep = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional Aggregation aggregation = 6;
+ * @return {?proto.parca.query.v1alpha1.Aggregation}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getAggregation = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Aggregation} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Aggregation, 6));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.Aggregation|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setAggregation = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearAggregation = function() {
+  return this.setAggregation(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasAggregation = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional string function = 7;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getFunction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setFunction = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string sample_labels = 8;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getSampleLabels = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setSampleLabels = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.Aggregation.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Aggregation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.Aggregation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Aggregation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    byList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    k: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.Aggregation}
+ */
+proto.parca.query.v1alpha1.Aggregation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.Aggregation;
+  return proto.parca.query.v1alpha1.Aggregation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.Aggregation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.Aggregation}
+ */
+proto.parca.query.v1alpha1.Aggregation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.parca.query.v1alpha1.Aggregation.Function} */ (reader.readEnum());
+      msg.setFunction(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addBy(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setK(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.Aggregation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.Aggregation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Aggregation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getByList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getK();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.Aggregation.Function = {
+  FUNCTION_UNSPECIFIED: 0,
+  FUNCTION_SUM: 1,
+  FUNCTION_AVG: 2,
+  FUNCTION_MAX: 3,
+  FUNCTION_TOPK: 4
+};
+
+/**
+ * optional Function function = 1;
+ * @return {!proto.parca.query.v1alpha1.Aggregation.Function}
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.getFunction = function() {
+  return /** @type {!proto.parca.query.v1alpha1.Aggregation.Function} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.Aggregation.Function} value
+ * @return {!proto.parca.query.v1alpha1.Aggregation} returns this
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.setFunction = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * repeated string by = 2;
+ * @return {!Array<string>}
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.getByList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.parca.query.v1alpha1.Aggregation} returns this
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.setByList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.Aggregation} returns this
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.addBy = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Aggregation} returns this
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.clearByList = function() {
+  return this.setByList([]);
+};
+
+
+/**
+ * optional uint32 k = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.getK = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Aggregation} returns this
+ */
+proto.parca.query.v1alpha1.Aggregation.prototype.setK = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.QueryRangeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    seriesList: jspb.Message.toObjectList(msg.getSeriesList(),
+    proto.parca.query.v1alpha1.MetricsSeries.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeResponse}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.QueryRangeResponse;
+  return proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeResponse}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.MetricsSeries;
+      reader.readMessage(value,proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader);
+      msg.addSeries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.QueryRangeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSeriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated MetricsSeries series = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.MetricsSeries>}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.getSeriesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.MetricsSeries>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.MetricsSeries, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.MetricsSeries>} value
+ * @return {!proto.parca.query.v1alpha1.QueryRangeResponse} returns this
+*/
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.setSeriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.MetricsSeries=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries}
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.addSeries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.MetricsSeries, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.QueryRangeResponse} returns this
+ */
+proto.parca.query.v1alpha1.QueryRangeResponse.prototype.clearSeriesList = function() {
+  return this.setSeriesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.MetricsSeries.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.MetricsSeries.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.MetricsSeries} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MetricsSeries.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    labelset: (f = msg.getLabelset()) && parca_profilestore_v1alpha1_profilestore_pb.LabelSet.toObject(includeInstance, f),
+    samplesList: jspb.Message.toObjectList(msg.getSamplesList(),
+    proto.parca.query.v1alpha1.MetricsSample.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.MetricsSeries;
+  return proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.MetricsSeries} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new parca_profilestore_v1alpha1_profilestore_pb.LabelSet;
+      reader.readMessage(value,parca_profilestore_v1alpha1_profilestore_pb.LabelSet.deserializeBinaryFromReader);
+      msg.setLabelset(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.MetricsSample;
+      reader.readMessage(value,proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader);
+      msg.addSamples(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.MetricsSeries} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLabelset();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      parca_profilestore_v1alpha1_profilestore_pb.LabelSet.serializeBinaryToWriter
+    );
+  }
+  f = message.getSamplesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional parca.profilestore.v1alpha1.LabelSet labelset = 1;
+ * @return {?proto.parca.profilestore.v1alpha1.LabelSet}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.getLabelset = function() {
+  return /** @type{?proto.parca.profilestore.v1alpha1.LabelSet} */ (
+    jspb.Message.getWrapperField(this, parca_profilestore_v1alpha1_profilestore_pb.LabelSet, 1));
+};
+
+
+/**
+ * @param {?proto.parca.profilestore.v1alpha1.LabelSet|undefined} value
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+*/
+proto.parca.query.v1alpha1.MetricsSeries.prototype.setLabelset = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.clearLabelset = function() {
+  return this.setLabelset(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.hasLabelset = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated MetricsSample samples = 2;
+ * @return {!Array<!proto.parca.query.v1alpha1.MetricsSample>}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.getSamplesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.MetricsSample>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.MetricsSample, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.MetricsSample>} value
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+*/
+proto.parca.query.v1alpha1.MetricsSeries.prototype.setSamplesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.MetricsSample=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.addSamples = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.parca.query.v1alpha1.MetricsSample, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+ */
+proto.parca.query.v1alpha1.MetricsSeries.prototype.clearSamplesList = function() {
+  return this.setSamplesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.MetricsSample.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.MetricsSample} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MetricsSample.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    value: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    flat: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ */
+proto.parca.query.v1alpha1.MetricsSample.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.MetricsSample;
+  return proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.MetricsSample} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ */
+proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setValue(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.MetricsSample} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getValue();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+*/
+proto.parca.query.v1alpha1.MetricsSample.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int64 value = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.getValue = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.setValue = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 flat = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+ */
+proto.parca.query.v1alpha1.MetricsSample.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.MergeProfile.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.MergeProfile.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.MergeProfile} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MergeProfile.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    groupByList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    sampleLabels: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.MergeProfile}
+ */
+proto.parca.query.v1alpha1.MergeProfile.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.MergeProfile;
+  return proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.MergeProfile} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.MergeProfile}
+ */
+proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStart(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEnd(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addGroupBy(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSampleLabels(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.MergeProfile} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnd();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroupByList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getSampleLabels();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string query = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.getStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+*/
+proto.parca.query.v1alpha1.MergeProfile.prototype.setStart = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.clearStart = function() {
+  return this.setStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.hasStart = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp end = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.getEnd = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+*/
+proto.parca.query.v1alpha1.MergeProfile.prototype.setEnd = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.clearEnd = function() {
+  return this.setEnd(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.hasEnd = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated string group_by = 4;
+ * @return {!Array<string>}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.getGroupByList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.setGroupByList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.addGroupBy = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.clearGroupByList = function() {
+  return this.setGroupByList([]);
+};
+
+
+/**
+ * optional string sample_labels = 5;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.getSampleLabels = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ */
+proto.parca.query.v1alpha1.MergeProfile.prototype.setSampleLabels = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SingleProfile.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.SingleProfile} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SingleProfile.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    query: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sampleLabels: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.SingleProfile}
+ */
+proto.parca.query.v1alpha1.SingleProfile.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.SingleProfile;
+  return proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.SingleProfile} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.SingleProfile}
+ */
+proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTime(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSampleLabels(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.SingleProfile} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTime();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
   f = message.getQuery();
   if (f.length > 0) {
     writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSampleLabels();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.getTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+*/
+proto.parca.query.v1alpha1.SingleProfile.prototype.setTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.clearTime = function() {
+  return this.setTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.hasTime = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string query = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string sample_labels = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.getSampleLabels = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+ */
+proto.parca.query.v1alpha1.SingleProfile.prototype.setSampleLabels = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.DiffProfile.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.DiffProfile} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.DiffProfile.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    a: (f = msg.getA()) && proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(includeInstance, f),
+    b: (f = msg.getB()) && proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.DiffProfile}
+ */
+proto.parca.query.v1alpha1.DiffProfile.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.DiffProfile;
+  return proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.DiffProfile} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.DiffProfile}
+ */
+proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.ProfileDiffSelection;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader);
+      msg.setA(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.ProfileDiffSelection;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader);
+      msg.setB(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.DiffProfile} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getA();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter
+    );
+  }
+  f = message.getB();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ProfileDiffSelection a = 1;
+ * @return {?proto.parca.query.v1alpha1.ProfileDiffSelection}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.getA = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ProfileDiffSelection} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ProfileDiffSelection, 1));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.ProfileDiffSelection|undefined} value
+ * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+*/
+proto.parca.query.v1alpha1.DiffProfile.prototype.setA = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.clearA = function() {
+  return this.setA(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.hasA = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ProfileDiffSelection b = 2;
+ * @return {?proto.parca.query.v1alpha1.ProfileDiffSelection}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.getB = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ProfileDiffSelection} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ProfileDiffSelection, 2));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.ProfileDiffSelection|undefined} value
+ * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+*/
+proto.parca.query.v1alpha1.DiffProfile.prototype.setB = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.clearB = function() {
+  return this.setB(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.DiffProfile.prototype.hasB = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_ = [[2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase = {
+  OPTIONS_NOT_SET: 0,
+  MERGE: 2,
+  SINGLE: 3
+};
+
+/**
+ * @return {proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getOptionsCase = function() {
+  return /** @type {proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    merge: (f = msg.getMerge()) && proto.parca.query.v1alpha1.MergeProfile.toObject(includeInstance, f),
+    single: (f = msg.getSingle()) && proto.parca.query.v1alpha1.SingleProfile.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.ProfileDiffSelection;
+  return proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.MergeProfile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader);
+      msg.setMerge(value);
+      break;
+    case 3:
+      var value = new proto.parca.query.v1alpha1.SingleProfile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader);
+      msg.setSingle(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getMerge();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter
+    );
+  }
+  f = message.getSingle();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.Mode = {
+  MODE_SINGLE_UNSPECIFIED: 0,
+  MODE_MERGE: 1
+};
+
+/**
+ * optional Mode mode = 1;
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getMode = function() {
+  return /** @type {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} value
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional MergeProfile merge = 2;
+ * @return {?proto.parca.query.v1alpha1.MergeProfile}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getMerge = function() {
+  return /** @type{?proto.parca.query.v1alpha1.MergeProfile} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.MergeProfile, 2));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.MergeProfile|undefined} value
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+*/
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setMerge = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearMerge = function() {
+  return this.setMerge(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.hasMerge = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional SingleProfile single = 3;
+ * @return {?proto.parca.query.v1alpha1.SingleProfile}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getSingle = function() {
+  return /** @type{?proto.parca.query.v1alpha1.SingleProfile} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.SingleProfile, 3));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.SingleProfile|undefined} value
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+*/
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setSingle = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearSingle = function() {
+  return this.setSingle(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.hasSingle = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.parca.query.v1alpha1.QueryRequest.oneofGroups_ = [[2,3,4]];
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.QueryRequest.OptionsCase = {
+  OPTIONS_NOT_SET: 0,
+  DIFF: 2,
+  MERGE: 3,
+  SINGLE: 4
+};
+
+/**
+ * @return {proto.parca.query.v1alpha1.QueryRequest.OptionsCase}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getOptionsCase = function() {
+  return /** @type {proto.parca.query.v1alpha1.QueryRequest.OptionsCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.QueryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.QueryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    diff: (f = msg.getDiff()) && proto.parca.query.v1alpha1.DiffProfile.toObject(includeInstance, f),
+    merge: (f = msg.getMerge()) && proto.parca.query.v1alpha1.MergeProfile.toObject(includeInstance, f),
+    single: (f = msg.getSingle()) && proto.parca.query.v1alpha1.SingleProfile.toObject(includeInstance, f),
+    reportType: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    topOptions: (f = msg.getTopOptions()) && proto.parca.query.v1alpha1.TopOptions.toObject(includeInstance, f),
+    callgraphOptions: (f = msg.getCallgraphOptions()) && proto.parca.query.v1alpha1.CallgraphOptions.toObject(includeInstance, f),
+    sourceOptions: (f = msg.getSourceOptions()) && proto.parca.query.v1alpha1.SourceOptions.toObject(includeInstance, f),
+    disassemblyOptions: (f = msg.getDisassemblyOptions()) && proto.parca.query.v1alpha1.DisassemblyOptions.toObject(includeInstance, f),
+    filter: (f = msg.getFilter()) && proto.parca.query.v1alpha1.ProfileFilter.toObject(includeInstance, f),
+    flamegraphOptions: (f = msg.getFlamegraphOptions()) && proto.parca.query.v1alpha1.FlamegraphOptions.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest}
+ */
+proto.parca.query.v1alpha1.QueryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.QueryRequest;
+  return proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.QueryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest}
+ */
+proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.parca.query.v1alpha1.QueryRequest.Mode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.DiffProfile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader);
+      msg.setDiff(value);
+      break;
+    case 3:
+      var value = new proto.parca.query.v1alpha1.MergeProfile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader);
+      msg.setMerge(value);
+      break;
+    case 4:
+      var value = new proto.parca.query.v1alpha1.SingleProfile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader);
+      msg.setSingle(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.parca.query.v1alpha1.QueryRequest.ReportType} */ (reader.readEnum());
+      msg.setReportType(value);
+      break;
+    case 6:
+      var value = new proto.parca.query.v1alpha1.TopOptions;
+      reader.readMessage(value,proto.parca.query.v1alpha1.TopOptions.deserializeBinaryFromReader);
+      msg.setTopOptions(value);
+      break;
+    case 7:
+      var value = new proto.parca.query.v1alpha1.CallgraphOptions;
+      reader.readMessage(value,proto.parca.query.v1alpha1.CallgraphOptions.deserializeBinaryFromReader);
+      msg.setCallgraphOptions(value);
+      break;
+    case 8:
+      var value = new proto.parca.query.v1alpha1.SourceOptions;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SourceOptions.deserializeBinaryFromReader);
+      msg.setSourceOptions(value);
+      break;
+    case 9:
+      var value = new proto.parca.query.v1alpha1.DisassemblyOptions;
+      reader.readMessage(value,proto.parca.query.v1alpha1.DisassemblyOptions.deserializeBinaryFromReader);
+      msg.setDisassemblyOptions(value);
+      break;
+    case 10:
+      var value = new proto.parca.query.v1alpha1.ProfileFilter;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ProfileFilter.deserializeBinaryFromReader);
+      msg.setFilter(value);
+      break;
+    case 11:
+      var value = new proto.parca.query.v1alpha1.FlamegraphOptions;
+      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphOptions.deserializeBinaryFromReader);
+      msg.setFlamegraphOptions(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.QueryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.QueryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.QueryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter
+    );
+  }
+  f = message.getMerge();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter
+    );
+  }
+  f = message.getSingle();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter
+    );
+  }
+  f = message.getReportType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getTopOptions();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.parca.query.v1alpha1.TopOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getCallgraphOptions();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.parca.query.v1alpha1.CallgraphOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getSourceOptions();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.parca.query.v1alpha1.SourceOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getDisassemblyOptions();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.parca.query.v1alpha1.DisassemblyOptions.serializeBinaryToWriter
+    );
+  }
+  f = message.getFilter();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.parca.query.v1alpha1.ProfileFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getFlamegraphOptions();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.parca.query.v1alpha1.FlamegraphOptions.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.QueryRequest.Mode = {
+  MODE_SINGLE_UNSPECIFIED: 0,
+  MODE_DIFF: 1,
+  MODE_MERGE: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.QueryRequest.ReportType = {
+  REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED: 0,
+  REPORT_TYPE_PPROF: 1,
+  REPORT_TYPE_TOP: 2,
+  REPORT_TYPE_CALLGRAPH: 3,
+  REPORT_TYPE_SOURCE: 4,
+  REPORT_TYPE_DISASSEMBLY: 5
+};
+
+/**
+ * optional Mode mode = 1;
+ * @return {!proto.parca.query.v1alpha1.QueryRequest.Mode}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getMode = function() {
+  return /** @type {!proto.parca.query.v1alpha1.QueryRequest.Mode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.QueryRequest.Mode} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional DiffProfile diff = 2;
+ * @return {?proto.parca.query.v1alpha1.DiffProfile}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getDiff = function() {
+  return /** @type{?proto.parca.query.v1alpha1.DiffProfile} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.DiffProfile, 2));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.DiffProfile|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setDiff = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearDiff = function() {
+  return this.setDiff(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional MergeProfile merge = 3;
+ * @return {?proto.parca.query.v1alpha1.MergeProfile}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getMerge = function() {
+  return /** @type{?proto.parca.query.v1alpha1.MergeProfile} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.MergeProfile, 3));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.MergeProfile|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setMerge = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearMerge = function() {
+  return this.setMerge(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasMerge = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional SingleProfile single = 4;
+ * @return {?proto.parca.query.v1alpha1.SingleProfile}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getSingle = function() {
+  return /** @type{?proto.parca.query.v1alpha1.SingleProfile} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.SingleProfile, 4));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.SingleProfile|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setSingle = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearSingle = function() {
+  return this.setSingle(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasSingle = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional ReportType report_type = 5;
+ * @return {!proto.parca.query.v1alpha1.QueryRequest.ReportType}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getReportType = function() {
+  return /** @type {!proto.parca.query.v1alpha1.QueryRequest.ReportType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.QueryRequest.ReportType} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.setReportType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional TopOptions top_options = 6;
+ * @return {?proto.parca.query.v1alpha1.TopOptions}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getTopOptions = function() {
+  return /** @type{?proto.parca.query.v1alpha1.TopOptions} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.TopOptions, 6));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.TopOptions|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setTopOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearTopOptions = function() {
+  return this.setTopOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasTopOptions = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional CallgraphOptions callgraph_options = 7;
+ * @return {?proto.parca.query.v1alpha1.CallgraphOptions}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getCallgraphOptions = function() {
+  return /** @type{?proto.parca.query.v1alpha1.CallgraphOptions} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.CallgraphOptions, 7));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.CallgraphOptions|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setCallgraphOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearCallgraphOptions = function() {
+  return this.setCallgraphOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasCallgraphOptions = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional SourceOptions source_options = 8;
+ * @return {?proto.parca.query.v1alpha1.SourceOptions}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getSourceOptions = function() {
+  return /** @type{?proto.parca.query.v1alpha1.SourceOptions} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.SourceOptions, 8));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.SourceOptions|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setSourceOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearSourceOptions = function() {
+  return this.setSourceOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasSourceOptions = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional DisassemblyOptions disassembly_options = 9;
+ * @return {?proto.parca.query.v1alpha1.DisassemblyOptions}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getDisassemblyOptions = function() {
+  return /** @type{?proto.parca.query.v1alpha1.DisassemblyOptions} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.DisassemblyOptions, 9));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.DisassemblyOptions|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setDisassemblyOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearDisassemblyOptions = function() {
+  return this.setDisassemblyOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasDisassemblyOptions = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional ProfileFilter filter = 10;
+ * @return {?proto.parca.query.v1alpha1.ProfileFilter}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getFilter = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ProfileFilter} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ProfileFilter, 10));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.ProfileFilter|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setFilter = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearFilter = function() {
+  return this.setFilter(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasFilter = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional FlamegraphOptions flamegraph_options = 11;
+ * @return {?proto.parca.query.v1alpha1.FlamegraphOptions}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.getFlamegraphOptions = function() {
+  return /** @type{?proto.parca.query.v1alpha1.FlamegraphOptions} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.FlamegraphOptions, 11));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.FlamegraphOptions|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+*/
+proto.parca.query.v1alpha1.QueryRequest.prototype.setFlamegraphOptions = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.clearFlamegraphOptions = function() {
+  return this.setFlamegraphOptions(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.QueryRequest.prototype.hasFlamegraphOptions = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.ProfileFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.ProfileFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.ProfileFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    focus: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ignore: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    hide: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    show: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.ProfileFilter;
+  return proto.parca.query.v1alpha1.ProfileFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.ProfileFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFocus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIgnore(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHide(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShow(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.ProfileFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.ProfileFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.ProfileFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFocus();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIgnore();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getHide();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getShow();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string focus = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.getFocus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter} returns this
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.setFocus = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string ignore = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.getIgnore = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter} returns this
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.setIgnore = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string hide = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.getHide = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter} returns this
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.setHide = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string show = 4;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.getShow = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ProfileFilter} returns this
+ */
+proto.parca.query.v1alpha1.ProfileFilter.prototype.setShow = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.TopOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.TopOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    sortBy: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.TopOptions}
+ */
+proto.parca.query.v1alpha1.TopOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.TopOptions;
+  return proto.parca.query.v1alpha1.TopOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.TopOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.TopOptions}
+ */
+proto.parca.query.v1alpha1.TopOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.parca.query.v1alpha1.TopOptions.SortBy} */ (reader.readEnum());
+      msg.setSortBy(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLimit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.TopOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.TopOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSortBy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.TopOptions.SortBy = {
+  SORT_BY_FLAT_UNSPECIFIED: 0,
+  SORT_BY_CUMULATIVE: 1,
+  SORT_BY_FLAT_DIFF: 2,
+  SORT_BY_CUMULATIVE_DIFF: 3,
+  SORT_BY_NAME: 4
+};
+
+/**
+ * optional SortBy sort_by = 1;
+ * @return {!proto.parca.query.v1alpha1.TopOptions.SortBy}
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.getSortBy = function() {
+  return /** @type {!proto.parca.query.v1alpha1.TopOptions.SortBy} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.TopOptions.SortBy} value
+ * @return {!proto.parca.query.v1alpha1.TopOptions} returns this
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.setSortBy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 limit = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.TopOptions} returns this
+ */
+proto.parca.query.v1alpha1.TopOptions.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.CallgraphOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.CallgraphOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    nodeFraction: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    edgeFraction: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.CallgraphOptions}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.CallgraphOptions;
+  return proto.parca.query.v1alpha1.CallgraphOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.CallgraphOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.CallgraphOptions}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setNodeFraction(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setEdgeFraction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.CallgraphOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.CallgraphOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodeFraction();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      1,
+      f
+    );
+  }
+  f = message.getEdgeFraction();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional double node_fraction = 1;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.getNodeFraction = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.setNodeFraction = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional double edge_fraction = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.getEdgeFraction = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphOptions.prototype.setEdgeFraction = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.FlamegraphOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.FlamegraphOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    maxNodes: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    minFraction: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    inverted: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    aggregateBy: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.FlamegraphOptions;
+  return proto.parca.query.v1alpha1.FlamegraphOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxNodes(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setMinFraction(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setInverted(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy} */ (reader.readEnum());
+      msg.setAggregateBy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.FlamegraphOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMaxNodes();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getMinFraction();
+  if (f !== 0.0) {
+    writer.writeDouble(
+      2,
+      f
+    );
+  }
+  f = message.getInverted();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getAggregateBy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy = {
+  AGGREGATE_BY_FUNCTION_UNSPECIFIED: 0,
+  AGGREGATE_BY_PACKAGE: 1,
+  AGGREGATE_BY_FILENAME: 2,
+  AGGREGATE_BY_MAPPING: 3
+};
+
+/**
+ * optional uint32 max_nodes = 1;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.getMaxNodes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.setMaxNodes = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional double min_fraction = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.getMinFraction = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.setMinFraction = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
+};
+
+
+/**
+ * optional bool inverted = 3;
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.getInverted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.setInverted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional AggregateBy aggregate_by = 4;
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy}
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.getAggregateBy = function() {
+  return /** @type {!proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.FlamegraphOptions.AggregateBy} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphOptions} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphOptions.prototype.setAggregateBy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.SourceOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SourceOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.SourceOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.SourceOptions}
+ */
+proto.parca.query.v1alpha1.SourceOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.SourceOptions;
+  return proto.parca.query.v1alpha1.SourceOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.SourceOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.SourceOptions}
+ */
+proto.parca.query.v1alpha1.SourceOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFunction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.SourceOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.SourceOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.SourceOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string function = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.SourceOptions.prototype.getFunction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.SourceOptions} returns this
+ */
+proto.parca.query.v1alpha1.SourceOptions.prototype.setFunction = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.DisassemblyOptions.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.DisassemblyOptions} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pb_function: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.DisassemblyOptions}
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.DisassemblyOptions;
+  return proto.parca.query.v1alpha1.DisassemblyOptions.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.DisassemblyOptions} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.DisassemblyOptions}
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFunction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.DisassemblyOptions.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.DisassemblyOptions} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFunction();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string function = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.prototype.getFunction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyOptions} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyOptions.prototype.setFunction = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.Top.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.Top.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Top.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.Top} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Top.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    listList: jspb.Message.toObjectList(msg.getListList(),
+    proto.parca.query.v1alpha1.TopNode.toObject, includeInstance),
+    reported: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    total: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    unit: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.Top}
+ */
+proto.parca.query.v1alpha1.Top.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.Top;
+  return proto.parca.query.v1alpha1.Top.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.Top} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.Top}
+ */
+proto.parca.query.v1alpha1.Top.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.TopNode;
+      reader.readMessage(value,proto.parca.query.v1alpha1.TopNode.deserializeBinaryFromReader);
+      msg.addList(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setReported(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.Top.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.Top.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.Top} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Top.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getListList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.TopNode.serializeBinaryToWriter
+    );
+  }
+  f = message.getReported();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated TopNode list = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.TopNode>}
+ */
+proto.parca.query.v1alpha1.Top.prototype.getListList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.TopNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.TopNode, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.TopNode>} value
+ * @return {!proto.parca.query.v1alpha1.Top} returns this
+*/
+proto.parca.query.v1alpha1.Top.prototype.setListList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.TopNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.TopNode}
+ */
+proto.parca.query.v1alpha1.Top.prototype.addList = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.TopNode, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Top} returns this
+ */
+proto.parca.query.v1alpha1.Top.prototype.clearListList = function() {
+  return this.setListList([]);
+};
+
+
+/**
+ * optional int32 reported = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.Top.prototype.getReported = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Top} returns this
+ */
+proto.parca.query.v1alpha1.Top.prototype.setReported = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 total = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.Top.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Top} returns this
+ */
+proto.parca.query.v1alpha1.Top.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string unit = 4;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Top.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Top} returns this
+ */
+proto.parca.query.v1alpha1.Top.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.TopNode.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.TopNode} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopNode.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    meta: (f = msg.getMeta()) && proto.parca.query.v1alpha1.TopNodeMeta.toObject(includeInstance, f),
+    flat: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    flatDiff: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    cumulativeDiff: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.TopNode}
+ */
+proto.parca.query.v1alpha1.TopNode.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.TopNode;
+  return proto.parca.query.v1alpha1.TopNode.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.TopNode} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.TopNode}
+ */
+proto.parca.query.v1alpha1.TopNode.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.TopNodeMeta;
+      reader.readMessage(value,proto.parca.query.v1alpha1.TopNodeMeta.deserializeBinaryFromReader);
+      msg.setMeta(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlatDiff(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulativeDiff(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.TopNode.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.TopNode} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopNode.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMeta();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.TopNodeMeta.serializeBinaryToWriter
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getFlatDiff();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getCumulativeDiff();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional TopNodeMeta meta = 1;
+ * @return {?proto.parca.query.v1alpha1.TopNodeMeta}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.getMeta = function() {
+  return /** @type{?proto.parca.query.v1alpha1.TopNodeMeta} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.TopNodeMeta, 1));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.TopNodeMeta|undefined} value
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+*/
+proto.parca.query.v1alpha1.TopNode.prototype.setMeta = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.clearMeta = function() {
+  return this.setMeta(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.hasMeta = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int64 flat = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 cumulative = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 flat_diff = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.getFlatDiff = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.setFlatDiff = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 cumulative_diff = 5;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.getCumulativeDiff = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.TopNode} returns this
+ */
+proto.parca.query.v1alpha1.TopNode.prototype.setCumulativeDiff = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.TopNodeMeta.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.TopNodeMeta} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    location: (f = msg.getLocation()) && proto.parca.query.v1alpha1.Location.toObject(includeInstance, f),
+    mapping: (f = msg.getMapping()) && proto.parca.query.v1alpha1.Mapping.toObject(includeInstance, f),
+    pb_function: (f = msg.getFunction()) && proto.parca.query.v1alpha1.Function.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.TopNodeMeta;
+  return proto.parca.query.v1alpha1.TopNodeMeta.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.TopNodeMeta} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.setLocation(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.Mapping;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader);
+      msg.setMapping(value);
+      break;
+    case 3:
+      var value = new proto.parca.query.v1alpha1.Function;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader);
+      msg.setFunction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.TopNodeMeta.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.TopNodeMeta} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLocation();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.Location.serializeBinaryToWriter
+    );
+  }
+  f = message.getMapping();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter
+    );
+  }
+  f = message.getFunction();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.parca.query.v1alpha1.Function.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Location location = 1;
+ * @return {?proto.parca.query.v1alpha1.Location}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.getLocation = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Location} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Location, 1));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.Location|undefined} value
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+*/
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.setLocation = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.clearLocation = function() {
+  return this.setLocation(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.hasLocation = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional Mapping mapping = 2;
+ * @return {?proto.parca.query.v1alpha1.Mapping}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.getMapping = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Mapping} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Mapping, 2));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.Mapping|undefined} value
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+*/
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.setMapping = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.clearMapping = function() {
+  return this.setMapping(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.hasMapping = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional Function function = 3;
+ * @return {?proto.parca.query.v1alpha1.Function}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.getFunction = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Function} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Function, 3));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.Function|undefined} value
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+*/
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.setFunction = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.TopNodeMeta} returns this
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.clearFunction = function() {
+  return this.setFunction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.TopNodeMeta.prototype.hasFunction = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.Callgraph.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Callgraph.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.Callgraph} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Callgraph.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    nodesList: jspb.Message.toObjectList(msg.getNodesList(),
+    proto.parca.query.v1alpha1.CallgraphNode.toObject, includeInstance),
+    edgesList: jspb.Message.toObjectList(msg.getEdgesList(),
+    proto.parca.query.v1alpha1.CallgraphEdge.toObject, includeInstance),
+    total: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    unit: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    dot: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.Callgraph}
+ */
+proto.parca.query.v1alpha1.Callgraph.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.Callgraph;
+  return proto.parca.query.v1alpha1.Callgraph.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.Callgraph} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.Callgraph}
+ */
+proto.parca.query.v1alpha1.Callgraph.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.CallgraphNode;
+      reader.readMessage(value,proto.parca.query.v1alpha1.CallgraphNode.deserializeBinaryFromReader);
+      msg.addNodes(value);
+      break;
+    case 2:
+      var value = new proto.parca.query.v1alpha1.CallgraphEdge;
+      reader.readMessage(value,proto.parca.query.v1alpha1.CallgraphEdge.deserializeBinaryFromReader);
+      msg.addEdges(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDot(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.Callgraph.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.Callgraph} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Callgraph.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.CallgraphNode.serializeBinaryToWriter
+    );
+  }
+  f = message.getEdgesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.parca.query.v1alpha1.CallgraphEdge.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDot();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated CallgraphNode nodes = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.CallgraphNode>}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.getNodesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.CallgraphNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.CallgraphNode, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.CallgraphNode>} value
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+*/
+proto.parca.query.v1alpha1.Callgraph.prototype.setNodesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.CallgraphNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.addNodes = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.CallgraphNode, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.clearNodesList = function() {
+  return this.setNodesList([]);
+};
+
+
+/**
+ * repeated CallgraphEdge edges = 2;
+ * @return {!Array<!proto.parca.query.v1alpha1.CallgraphEdge>}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.getEdgesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.CallgraphEdge>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.CallgraphEdge, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.CallgraphEdge>} value
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+*/
+proto.parca.query.v1alpha1.Callgraph.prototype.setEdgesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.CallgraphEdge=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.addEdges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.parca.query.v1alpha1.CallgraphEdge, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.clearEdgesList = function() {
+  return this.setEdgesList([]);
+};
+
+
+/**
+ * optional int64 total = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string unit = 4;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string dot = 5;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.getDot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Callgraph} returns this
+ */
+proto.parca.query.v1alpha1.Callgraph.prototype.setDot = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.CallgraphNode.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.CallgraphNode} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphNode.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    filename: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    address: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    flat: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.CallgraphNode;
+  return proto.parca.query.v1alpha1.CallgraphNode.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.CallgraphNode} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAddress(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.CallgraphNode.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.CallgraphNode} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphNode.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAddress();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 id = 1;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string filename = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 address = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 flat = 5;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 cumulative = 6;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphNode} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphNode.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.CallgraphEdge.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.CallgraphEdge} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    source: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    target: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    weight: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    residual: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    inline: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.CallgraphEdge;
+  return proto.parca.query.v1alpha1.CallgraphEdge.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.CallgraphEdge} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setSource(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTarget(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setWeight(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setResidual(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setInline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.CallgraphEdge.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.CallgraphEdge} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSource();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getTarget();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getWeight();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getResidual();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getInline();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 source = 1;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.getSource = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.setSource = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 target = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.getTarget = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.setTarget = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 weight = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.getWeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.setWeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional bool residual = 4;
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.getResidual = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.setResidual = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
+/**
+ * optional bool inline = 5;
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.getInline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.CallgraphEdge} returns this
+ */
+proto.parca.query.v1alpha1.CallgraphEdge.prototype.setInline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.Source.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.Source.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Source.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.Source} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Source.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filesList: jspb.Message.toObjectList(msg.getFilesList(),
+    proto.parca.query.v1alpha1.SourceFile.toObject, includeInstance),
+    total: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    unit: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.Source}
+ */
+proto.parca.query.v1alpha1.Source.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.Source;
+  return proto.parca.query.v1alpha1.Source.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.Source} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.Source}
+ */
+proto.parca.query.v1alpha1.Source.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.SourceFile;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SourceFile.deserializeBinaryFromReader);
+      msg.addFiles(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.Source.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.Source.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.Source} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Source.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.SourceFile.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated SourceFile files = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.SourceFile>}
+ */
+proto.parca.query.v1alpha1.Source.prototype.getFilesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.SourceFile>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.SourceFile, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.SourceFile>} value
+ * @return {!proto.parca.query.v1alpha1.Source} returns this
+*/
+proto.parca.query.v1alpha1.Source.prototype.setFilesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.SourceFile=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.SourceFile}
+ */
+proto.parca.query.v1alpha1.Source.prototype.addFiles = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.SourceFile, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Source} returns this
+ */
+proto.parca.query.v1alpha1.Source.prototype.clearFilesList = function() {
+  return this.setFilesList([]);
+};
+
+
+/**
+ * optional int64 total = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.Source.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Source} returns this
+ */
+proto.parca.query.v1alpha1.Source.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string unit = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Source.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Source} returns this
+ */
+proto.parca.query.v1alpha1.Source.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.SourceFile.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SourceFile.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.SourceFile} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceFile.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    buildId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    hasSource: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    flat: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    linesList: jspb.Message.toObjectList(msg.getLinesList(),
+    proto.parca.query.v1alpha1.SourceLine.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.SourceFile}
+ */
+proto.parca.query.v1alpha1.SourceFile.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.SourceFile;
+  return proto.parca.query.v1alpha1.SourceFile.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.SourceFile} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.SourceFile}
+ */
+proto.parca.query.v1alpha1.SourceFile.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHasSource(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    case 6:
+      var value = new proto.parca.query.v1alpha1.SourceLine;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SourceLine.deserializeBinaryFromReader);
+      msg.addLines(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.SourceFile.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.SourceFile} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceFile.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getHasSource();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getLinesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.parca.query.v1alpha1.SourceLine.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string filename = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string build_id = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool has_source = 3;
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getHasSource = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.setHasSource = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional int64 flat = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 cumulative = 5;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * repeated SourceLine lines = 6;
+ * @return {!Array<!proto.parca.query.v1alpha1.SourceLine>}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.getLinesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.SourceLine>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.SourceLine, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.query.v1alpha1.SourceLine>} value
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+*/
+proto.parca.query.v1alpha1.SourceFile.prototype.setLinesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.SourceLine=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.SourceLine}
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.addLines = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.parca.query.v1alpha1.SourceLine, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.SourceFile} returns this
+ */
+proto.parca.query.v1alpha1.SourceFile.prototype.clearLinesList = function() {
+  return this.setLinesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SourceLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.SourceLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    line: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    text: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    flat: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.SourceLine}
+ */
+proto.parca.query.v1alpha1.SourceLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.SourceLine;
+  return proto.parca.query.v1alpha1.SourceLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.SourceLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.SourceLine}
+ */
+proto.parca.query.v1alpha1.SourceLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLine(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.SourceLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.SourceLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.SourceLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getStart();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnd();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
       3,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getLimit();
+  f = message.getCumulative();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeInt64(
       4,
       f
     );
@@ -708,112 +7290,294 @@ proto.parca.query.v1alpha1.QueryRangeRequest.serializeBinaryToWriter = function(
 
 
 /**
- * optional string query = 1;
+ * optional int64 line = 1;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SourceLine} returns this
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string text = 2;
  * @return {string}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getQuery = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.parca.query.v1alpha1.SourceLine.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ * @return {!proto.parca.query.v1alpha1.SourceLine} returns this
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setQuery = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.parca.query.v1alpha1.SourceLine.prototype.setText = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int64 flat = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SourceLine} returns this
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 cumulative = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SourceLine} returns this
+ */
+proto.parca.query.v1alpha1.SourceLine.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.Disassembly.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.query.v1alpha1.Disassembly.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Disassembly.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.query.v1alpha1.Disassembly} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Disassembly.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    symbolsList: jspb.Message.toObjectList(msg.getSymbolsList(),
+    proto.parca.query.v1alpha1.DisassemblySymbol.toObject, includeInstance),
+    total: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    unit: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.query.v1alpha1.Disassembly}
+ */
+proto.parca.query.v1alpha1.Disassembly.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.query.v1alpha1.Disassembly;
+  return proto.parca.query.v1alpha1.Disassembly.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.query.v1alpha1.Disassembly} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.query.v1alpha1.Disassembly}
+ */
+proto.parca.query.v1alpha1.Disassembly.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.query.v1alpha1.DisassemblySymbol;
+      reader.readMessage(value,proto.parca.query.v1alpha1.DisassemblySymbol.deserializeBinaryFromReader);
+      msg.addSymbols(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional google.protobuf.Timestamp start = 2;
- * @return {?proto.google.protobuf.Timestamp}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getStart = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+proto.parca.query.v1alpha1.Disassembly.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.query.v1alpha1.Disassembly.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
-*/
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setStart = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.query.v1alpha1.Disassembly} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.query.v1alpha1.Disassembly.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSymbolsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.DisassemblySymbol.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ * repeated DisassemblySymbol symbols = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.DisassemblySymbol>}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearStart = function() {
-  return this.setStart(undefined);
+proto.parca.query.v1alpha1.Disassembly.prototype.getSymbolsList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.DisassemblySymbol>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.DisassemblySymbol, 1));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasStart = function() {
-  return jspb.Message.getField(this, 2) != null;
+ * @param {!Array<!proto.parca.query.v1alpha1.DisassemblySymbol>} value
+ * @return {!proto.parca.query.v1alpha1.Disassembly} returns this
+*/
+proto.parca.query.v1alpha1.Disassembly.prototype.setSymbolsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp end = 3;
- * @return {?proto.google.protobuf.Timestamp}
+ * @param {!proto.parca.query.v1alpha1.DisassemblySymbol=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getEnd = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+proto.parca.query.v1alpha1.Disassembly.prototype.addSymbols = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.DisassemblySymbol, opt_index);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
-*/
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setEnd = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.Disassembly} returns this
+ */
+proto.parca.query.v1alpha1.Disassembly.prototype.clearSymbolsList = function() {
+  return this.setSymbolsList([]);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ * optional int64 total = 2;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.clearEnd = function() {
-  return this.setEnd(undefined);
+proto.parca.query.v1alpha1.Disassembly.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Disassembly} returns this
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.hasEnd = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.parca.query.v1alpha1.Disassembly.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional uint32 limit = 4;
- * @return {number}
+ * optional string unit = 3;
+ * @return {string}
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.getLimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.parca.query.v1alpha1.Disassembly.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.QueryRangeRequest} returns this
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Disassembly} returns this
  */
-proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setLimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.parca.query.v1alpha1.Disassembly.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -823,7 +7587,7 @@ proto.parca.query.v1alpha1.QueryRangeRequest.prototype.setLimit = function(value
  * @private {!Array<number>}
  * @const
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.repeatedFields_ = [1];
+proto.parca.query.v1alpha1.DisassemblySymbol.repeatedFields_ = [1,7];
 
 
 
@@ -840,8 +7604,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.QueryRangeResponse.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.DisassemblySymbol.toObject(opt_includeInstance, this);
 };
 
 
@@ -850,14 +7614,20 @@ proto.parca.query.v1alpha1.QueryRangeResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.DisassemblySymbol} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.DisassemblySymbol.toObject = function(includeInstance, msg) {
   var f, obj = {
-    seriesList: jspb.Message.toObjectList(msg.getSeriesList(),
-    proto.parca.query.v1alpha1.MetricsSeries.toObject, includeInstance)
+    namesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    buildId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    start: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    end: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    flat: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    instructionsList: jspb.Message.toObjectList(msg.getInstructionsList(),
+    proto.parca.query.v1alpha1.DisassemblyInstruction.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -871,23 +7641,23 @@ proto.parca.query.v1alpha1.QueryRangeResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.QueryRangeResponse}
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol}
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.DisassemblySymbol.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.QueryRangeResponse;
-  return proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.DisassemblySymbol;
+  return proto.parca.query.v1alpha1.DisassemblySymbol.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.DisassemblySymbol} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.QueryRangeResponse}
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol}
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.DisassemblySymbol.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -895,9 +7665,33 @@ proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.parca.query.v1alpha1.MetricsSeries;
-      reader.readMessage(value,proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader);
-      msg.addSeries(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addNames(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStart(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setEnd(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    case 7:
+      var value = new proto.parca.query.v1alpha1.DisassemblyInstruction;
+      reader.readMessage(value,proto.parca.query.v1alpha1.DisassemblyInstruction.deserializeBinaryFromReader);
+      msg.addInstructions(value);
       break;
     default:
       reader.skipField();
@@ -912,9 +7706,9 @@ proto.parca.query.v1alpha1.QueryRangeResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.QueryRangeResponse.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.DisassemblySymbol.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -922,269 +7716,227 @@ proto.parca.query.v1alpha1.QueryRangeResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.QueryRangeResponse} message
+ * @param {!proto.parca.query.v1alpha1.DisassemblySymbol} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.DisassemblySymbol.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSeriesList();
+  f = message.getNamesList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       1,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getEnd();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getInstructionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
       f,
-      proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.DisassemblyInstruction.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated MetricsSeries series = 1;
- * @return {!Array<!proto.parca.query.v1alpha1.MetricsSeries>}
+ * repeated string names = 1;
+ * @return {!Array<string>}
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.getSeriesList = function() {
-  return /** @type{!Array<!proto.parca.query.v1alpha1.MetricsSeries>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.MetricsSeries, 1));
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<!proto.parca.query.v1alpha1.MetricsSeries>} value
- * @return {!proto.parca.query.v1alpha1.QueryRangeResponse} returns this
-*/
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.setSeriesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.parca.query.v1alpha1.MetricsSeries=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.parca.query.v1alpha1.MetricsSeries}
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.addSeries = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.MetricsSeries, opt_index);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.addNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.parca.query.v1alpha1.QueryRangeResponse} returns this
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.QueryRangeResponse.prototype.clearSeriesList = function() {
-  return this.setSeriesList([]);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.clearNamesList = function() {
+  return this.setNamesList([]);
 };
 
 
-
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.parca.query.v1alpha1.MetricsSeries.repeatedFields_ = [2];
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string build_id = 2;
+ * @return {string}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.MetricsSeries.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.MetricsSeries} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.MetricsSeries.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    labelset: (f = msg.getLabelset()) && parca_profilestore_v1alpha1_profilestore_pb.LabelSet.toObject(includeInstance, f),
-    samplesList: jspb.Message.toObjectList(msg.getSamplesList(),
-    proto.parca.query.v1alpha1.MetricsSample.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.MetricsSeries}
- */
-proto.parca.query.v1alpha1.MetricsSeries.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.MetricsSeries;
-  return proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader(msg, reader);
+ * optional uint64 start = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getStart = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.MetricsSeries} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.MetricsSeries}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.MetricsSeries.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new parca_profilestore_v1alpha1_profilestore_pb.LabelSet;
-      reader.readMessage(value,parca_profilestore_v1alpha1_profilestore_pb.LabelSet.deserializeBinaryFromReader);
-      msg.setLabelset(value);
-      break;
-    case 2:
-      var value = new proto.parca.query.v1alpha1.MetricsSample;
-      reader.readMessage(value,proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader);
-      msg.addSamples(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setStart = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint64 end = 4;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getEnd = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.MetricsSeries} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.MetricsSeries.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getLabelset();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      parca_profilestore_v1alpha1_profilestore_pb.LabelSet.serializeBinaryToWriter
-    );
-  }
-  f = message.getSamplesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      2,
-      f,
-      proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter
-    );
-  }
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setEnd = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional parca.profilestore.v1alpha1.LabelSet labelset = 1;
- * @return {?proto.parca.profilestore.v1alpha1.LabelSet}
+ * optional int64 flat = 5;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.getLabelset = function() {
-  return /** @type{?proto.parca.profilestore.v1alpha1.LabelSet} */ (
-    jspb.Message.getWrapperField(this, parca_profilestore_v1alpha1_profilestore_pb.LabelSet, 1));
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {?proto.parca.profilestore.v1alpha1.LabelSet|undefined} value
- * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
-*/
-proto.parca.query.v1alpha1.MetricsSeries.prototype.setLabelset = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+ * optional int64 cumulative = 6;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.clearLabelset = function() {
-  return this.setLabelset(undefined);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.hasLabelset = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * repeated MetricsSample samples = 2;
- * @return {!Array<!proto.parca.query.v1alpha1.MetricsSample>}
+ * repeated DisassemblyInstruction instructions = 7;
+ * @return {!Array<!proto.parca.query.v1alpha1.DisassemblyInstruction>}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.getSamplesList = function() {
-  return /** @type{!Array<!proto.parca.query.v1alpha1.MetricsSample>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.MetricsSample, 2));
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.getInstructionsList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.DisassemblyInstruction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.DisassemblyInstruction, 7));
 };
 
 
 /**
- * @param {!Array<!proto.parca.query.v1alpha1.MetricsSample>} value
- * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+ * @param {!Array<!proto.parca.query.v1alpha1.DisassemblyInstruction>} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
 */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.setSamplesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.setInstructionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
 };
 
 
 /**
- * @param {!proto.parca.query.v1alpha1.MetricsSample=} opt_value
+ * @param {!proto.parca.query.v1alpha1.DisassemblyInstruction=} opt_value
  * @param {number=} opt_index
- * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction}
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.addSamples = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.parca.query.v1alpha1.MetricsSample, opt_index);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.addInstructions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.parca.query.v1alpha1.DisassemblyInstruction, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.parca.query.v1alpha1.MetricsSeries} returns this
+ * @return {!proto.parca.query.v1alpha1.DisassemblySymbol} returns this
  */
-proto.parca.query.v1alpha1.MetricsSeries.prototype.clearSamplesList = function() {
-  return this.setSamplesList([]);
+proto.parca.query.v1alpha1.DisassemblySymbol.prototype.clearInstructionsList = function() {
+  return this.setInstructionsList([]);
 };
 
 
@@ -1204,8 +7956,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.MetricsSample.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.DisassemblyInstruction.toObject(opt_includeInstance, this);
 };
 
 
@@ -1214,14 +7966,19 @@ proto.parca.query.v1alpha1.MetricsSample.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.MetricsSample} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.DisassemblyInstruction} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.MetricsSample.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.DisassemblyInstruction.toObject = function(includeInstance, msg) {
   var f, obj = {
-    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    value: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    address: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    text: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pb_function: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    filename: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    flat: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -1235,23 +7992,23 @@ proto.parca.query.v1alpha1.MetricsSample.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction}
  */
-proto.parca.query.v1alpha1.MetricsSample.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.DisassemblyInstruction.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.MetricsSample;
-  return proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.DisassemblyInstruction;
+  return proto.parca.query.v1alpha1.DisassemblyInstruction.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.MetricsSample} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.DisassemblyInstruction} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.MetricsSample}
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction}
  */
-proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.DisassemblyInstruction.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1259,13 +8016,32 @@ proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTimestamp(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAddress(value);
       break;
     case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setText(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFunction(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 5:
       var value = /** @type {number} */ (reader.readInt64());
-      msg.setValue(value);
+      msg.setLine(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setFlat(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
       break;
     default:
       reader.skipField();
@@ -1280,9 +8056,9 @@ proto.parca.query.v1alpha1.MetricsSample.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.DisassemblyInstruction.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1290,24 +8066,58 @@ proto.parca.query.v1alpha1.MetricsSample.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.MetricsSample} message
+ * @param {!proto.parca.query.v1alpha1.DisassemblyInstruction} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.DisassemblyInstruction.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTimestamp();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAddress();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getValue();
+  f = message.getText();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFunction();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getLine();
   if (f !== 0) {
     writer.writeInt64(
-      2,
+      5,
+      f
+    );
+  }
+  f = message.getFlat();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
       f
     );
   }
@@ -1315,57 +8125,128 @@ proto.parca.query.v1alpha1.MetricsSample.serializeBinaryToWriter = function(mess
 
 
 /**
- * optional google.protobuf.Timestamp timestamp = 1;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional uint64 address = 1;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.getTimestamp = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string text = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setText = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string function = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getFunction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setFunction = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string filename = 4;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 line = 5;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
-*/
-proto.parca.query.v1alpha1.MetricsSample.prototype.setTimestamp = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
+ */
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+ * optional int64 flat = 6;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.clearTimestamp = function() {
-  return this.setTimestamp(undefined);
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getFlat = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.hasTimestamp = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setFlat = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional int64 value = 2;
+ * optional int64 cumulative = 7;
  * @return {number}
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.getValue = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.MetricsSample} returns this
+ * @return {!proto.parca.query.v1alpha1.DisassemblyInstruction} returns this
  */
-proto.parca.query.v1alpha1.MetricsSample.prototype.setValue = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.parca.query.v1alpha1.DisassemblyInstruction.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
@@ -1385,8 +8266,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.MergeProfile.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Flamegraph.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Flamegraph.toObject(opt_includeInstance, this);
 };
 
 
@@ -1395,15 +8276,16 @@ proto.parca.query.v1alpha1.MergeProfile.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.MergeProfile} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.Flamegraph} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.MergeProfile.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.Flamegraph.toObject = function(includeInstance, msg) {
   var f, obj = {
-    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    root: (f = msg.getRoot()) && proto.parca.query.v1alpha1.FlamegraphRootNode.toObject(includeInstance, f),
+    total: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    unit: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    height: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -1417,23 +8299,23 @@ proto.parca.query.v1alpha1.MergeProfile.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.MergeProfile}
+ * @return {!proto.parca.query.v1alpha1.Flamegraph}
  */
-proto.parca.query.v1alpha1.MergeProfile.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.Flamegraph.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.MergeProfile;
-  return proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.Flamegraph;
+  return proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.MergeProfile} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.Flamegraph} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.MergeProfile}
+ * @return {!proto.parca.query.v1alpha1.Flamegraph}
  */
-proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1441,18 +8323,21 @@ proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setQuery(value);
+      var value = new proto.parca.query.v1alpha1.FlamegraphRootNode;
+      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader);
+      msg.setRoot(value);
       break;
     case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStart(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotal(value);
       break;
     case 3:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setEnd(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setHeight(value);
       break;
     default:
       reader.skipField();
@@ -1467,9 +8352,9 @@ proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.Flamegraph.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1477,131 +8362,143 @@ proto.parca.query.v1alpha1.MergeProfile.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.MergeProfile} message
+ * @param {!proto.parca.query.v1alpha1.Flamegraph} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getQuery();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getRoot();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter
     );
   }
-  f = message.getStart();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getEnd();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
       3,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * optional string query = 1;
- * @return {string}
+ * optional FlamegraphRootNode root = 1;
+ * @return {?proto.parca.query.v1alpha1.FlamegraphRootNode}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.getQuery = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.parca.query.v1alpha1.Flamegraph.prototype.getRoot = function() {
+  return /** @type{?proto.parca.query.v1alpha1.FlamegraphRootNode} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.FlamegraphRootNode, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
- */
-proto.parca.query.v1alpha1.MergeProfile.prototype.setQuery = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.parca.query.v1alpha1.FlamegraphRootNode|undefined} value
+ * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
+*/
+proto.parca.query.v1alpha1.Flamegraph.prototype.setRoot = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp start = 2;
- * @return {?proto.google.protobuf.Timestamp}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.getStart = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+proto.parca.query.v1alpha1.Flamegraph.prototype.clearRoot = function() {
+  return this.setRoot(undefined);
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
-*/
-proto.parca.query.v1alpha1.MergeProfile.prototype.setStart = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.Flamegraph.prototype.hasRoot = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ * optional int64 total = 2;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.clearStart = function() {
-  return this.setStart(undefined);
+proto.parca.query.v1alpha1.Flamegraph.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.hasStart = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.parca.query.v1alpha1.Flamegraph.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp end = 3;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional string unit = 3;
+ * @return {string}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.getEnd = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+proto.parca.query.v1alpha1.Flamegraph.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
-*/
-proto.parca.query.v1alpha1.MergeProfile.prototype.setEnd = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
+ */
+proto.parca.query.v1alpha1.Flamegraph.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.MergeProfile} returns this
+ * optional int32 height = 4;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.clearEnd = function() {
-  return this.setEnd(undefined);
+proto.parca.query.v1alpha1.Flamegraph.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
  */
-proto.parca.query.v1alpha1.MergeProfile.prototype.hasEnd = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.parca.query.v1alpha1.Flamegraph.prototype.setHeight = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.FlamegraphRootNode.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1617,8 +8514,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.SingleProfile.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.FlamegraphRootNode.toObject(opt_includeInstance, this);
 };
 
 
@@ -1627,14 +8524,16 @@ proto.parca.query.v1alpha1.SingleProfile.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.SingleProfile} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SingleProfile.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.FlamegraphRootNode.toObject = function(includeInstance, msg) {
   var f, obj = {
-    time: (f = msg.getTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    query: jspb.Message.getFieldWithDefault(msg, 2, "")
+    cumulative: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    diff: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
+    proto.parca.query.v1alpha1.FlamegraphNode.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1648,23 +8547,23 @@ proto.parca.query.v1alpha1.SingleProfile.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.SingleProfile}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode}
  */
-proto.parca.query.v1alpha1.SingleProfile.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.SingleProfile;
-  return proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.FlamegraphRootNode;
+  return proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.SingleProfile} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.SingleProfile}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode}
  */
-proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1672,13 +8571,17 @@ proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTime(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setQuery(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDiff(value);
+      break;
+    case 3:
+      var value = new proto.parca.query.v1alpha1.FlamegraphNode;
+      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader);
+      msg.addChildren(value);
       break;
     default:
       reader.skipField();
@@ -1693,9 +8596,9 @@ proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1703,86 +8606,119 @@ proto.parca.query.v1alpha1.SingleProfile.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.SingleProfile} message
+ * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTime();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getQuery();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDiff();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
       f
     );
   }
+  f = message.getChildrenList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional google.protobuf.Timestamp time = 1;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional int64 cumulative = 1;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.getTime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
-*/
-proto.parca.query.v1alpha1.SingleProfile.prototype.setTime = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+ * optional int64 diff = 2;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.clearTime = function() {
-  return this.setTime(undefined);
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getDiff = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.hasTime = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setDiff = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string query = 2;
- * @return {string}
+ * repeated FlamegraphNode children = 3;
+ * @return {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.getQuery = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getChildrenList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNode, 3));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.SingleProfile} returns this
+ * @param {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+*/
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setChildrenList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
  */
-proto.parca.query.v1alpha1.SingleProfile.prototype.setQuery = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.addChildren = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.parca.query.v1alpha1.FlamegraphNode, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.clearChildrenList = function() {
+  return this.setChildrenList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.FlamegraphNode.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1798,8 +8734,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.DiffProfile.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.FlamegraphNode.toObject(opt_includeInstance, this);
 };
 
 
@@ -1808,14 +8744,18 @@ proto.parca.query.v1alpha1.DiffProfile.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.DiffProfile} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNode} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.DiffProfile.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.FlamegraphNode.toObject = function(includeInstance, msg) {
   var f, obj = {
-    a: (f = msg.getA()) && proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(includeInstance, f),
-    b: (f = msg.getB()) && proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(includeInstance, f)
+    meta: (f = msg.getMeta()) && proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject(includeInstance, f),
+    cumulative: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    diff: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
+    proto.parca.query.v1alpha1.FlamegraphNode.toObject, includeInstance),
+    other: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -1829,23 +8769,23 @@ proto.parca.query.v1alpha1.DiffProfile.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.DiffProfile}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
  */
-proto.parca.query.v1alpha1.DiffProfile.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.DiffProfile;
-  return proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.FlamegraphNode;
+  return proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.DiffProfile} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNode} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.DiffProfile}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
  */
-proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1853,14 +8793,26 @@ proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.parca.query.v1alpha1.ProfileDiffSelection;
-      reader.readMessage(value,proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader);
-      msg.setA(value);
+      var value = new proto.parca.query.v1alpha1.FlamegraphNodeMeta;
+      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader);
+      msg.setMeta(value);
       break;
     case 2:
-      var value = new proto.parca.query.v1alpha1.ProfileDiffSelection;
-      reader.readMessage(value,proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader);
-      msg.setB(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCumulative(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDiff(value);
+      break;
+    case 4:
+      var value = new proto.parca.query.v1alpha1.FlamegraphNode;
+      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader);
+      msg.addChildren(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOther(value);
       break;
     default:
       reader.skipField();
@@ -1875,9 +8827,9 @@ proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1885,56 +8837,77 @@ proto.parca.query.v1alpha1.DiffProfile.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.DiffProfile} message
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNode} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getA();
+  f = message.getMeta();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter
     );
   }
-  f = message.getB();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getCumulative();
+  if (f !== 0) {
+    writer.writeInt64(
       2,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getChildrenList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
       f,
-      proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter
+    );
+  }
+  f = message.getOther();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * optional ProfileDiffSelection a = 1;
- * @return {?proto.parca.query.v1alpha1.ProfileDiffSelection}
+ * optional FlamegraphNodeMeta meta = 1;
+ * @return {?proto.parca.query.v1alpha1.FlamegraphNodeMeta}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.getA = function() {
-  return /** @type{?proto.parca.query.v1alpha1.ProfileDiffSelection} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ProfileDiffSelection, 1));
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.getMeta = function() {
+  return /** @type{?proto.parca.query.v1alpha1.FlamegraphNodeMeta} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNodeMeta, 1));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.ProfileDiffSelection|undefined} value
- * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ * @param {?proto.parca.query.v1alpha1.FlamegraphNodeMeta|undefined} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
 */
-proto.parca.query.v1alpha1.DiffProfile.prototype.setA = function(value) {
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.setMeta = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.clearA = function() {
-  return this.setA(undefined);
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.clearMeta = function() {
+  return this.setMeta(undefined);
 };
 
 
@@ -1942,77 +8915,106 @@ proto.parca.query.v1alpha1.DiffProfile.prototype.clearA = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.hasA = function() {
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.hasMeta = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional ProfileDiffSelection b = 2;
- * @return {?proto.parca.query.v1alpha1.ProfileDiffSelection}
+ * optional int64 cumulative = 2;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.getCumulative = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.setCumulative = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 diff = 3;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.getDiff = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ */
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.setDiff = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated FlamegraphNode children = 4;
+ * @return {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.getB = function() {
-  return /** @type{?proto.parca.query.v1alpha1.ProfileDiffSelection} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ProfileDiffSelection, 2));
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.getChildrenList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNode, 4));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.ProfileDiffSelection|undefined} value
- * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ * @param {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
 */
-proto.parca.query.v1alpha1.DiffProfile.prototype.setB = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.setChildrenList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.DiffProfile} returns this
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNode=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.clearB = function() {
-  return this.setB(undefined);
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.addChildren = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.parca.query.v1alpha1.FlamegraphNode, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
  */
-proto.parca.query.v1alpha1.DiffProfile.prototype.hasB = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.clearChildrenList = function() {
+  return this.setChildrenList([]);
 };
 
 
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_ = [[2,3]];
-
 /**
- * @enum {number}
+ * optional bool other = 5;
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase = {
-  OPTIONS_NOT_SET: 0,
-  MERGE: 2,
-  SINGLE: 3
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.getOther = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
+
 /**
- * @return {proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase}
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getOptionsCase = function() {
-  return /** @type {proto.parca.query.v1alpha1.ProfileDiffSelection.OptionsCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0]));
+proto.parca.query.v1alpha1.FlamegraphNode.prototype.setOther = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2026,8 +9028,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.ProfileDiffSelection.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject(opt_includeInstance, this);
 };
 
 
@@ -2036,15 +9038,16 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject = function(includeInstance, msg) {
   var f, obj = {
-    mode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    merge: (f = msg.getMerge()) && proto.parca.query.v1alpha1.MergeProfile.toObject(includeInstance, f),
-    single: (f = msg.getSingle()) && proto.parca.query.v1alpha1.SingleProfile.toObject(includeInstance, f)
+    location: (f = msg.getLocation()) && proto.parca.query.v1alpha1.Location.toObject(includeInstance, f),
+    mapping: (f = msg.getMapping()) && proto.parca.query.v1alpha1.Mapping.toObject(includeInstance, f),
+    pb_function: (f = msg.getFunction()) && proto.parca.query.v1alpha1.Function.toObject(includeInstance, f),
+    line: (f = msg.getLine()) && proto.parca.query.v1alpha1.Line.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2058,23 +9061,23 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.ProfileDiffSelection;
-  return proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.FlamegraphNodeMeta;
+  return proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection}
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2082,18 +9085,24 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = new proto.parca.query.v1alpha1.Location;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader);
+      msg.setLocation(value);
       break;
     case 2:
-      var value = new proto.parca.query.v1alpha1.MergeProfile;
-      reader.readMessage(value,proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader);
-      msg.setMerge(value);
+      var value = new proto.parca.query.v1alpha1.Mapping;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader);
+      msg.setMapping(value);
       break;
     case 3:
-      var value = new proto.parca.query.v1alpha1.SingleProfile;
-      reader.readMessage(value,proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader);
-      msg.setSingle(value);
+      var value = new proto.parca.query.v1alpha1.Function;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader);
+      msg.setFunction(value);
+      break;
+    case 4:
+      var value = new proto.parca.query.v1alpha1.Line;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader);
+      msg.setLine(value);
       break;
     default:
       reader.skipField();
@@ -2108,9 +9117,9 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2118,89 +9127,109 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection} message
+ * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getLocation();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.parca.query.v1alpha1.Location.serializeBinaryToWriter
     );
   }
-  f = message.getMerge();
+  f = message.getMapping();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter
     );
   }
-  f = message.getSingle();
+  f = message.getFunction();
   if (f != null) {
     writer.writeMessage(
       3,
       f,
-      proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Function.serializeBinaryToWriter
+    );
+  }
+  f = message.getLine();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.parca.query.v1alpha1.Line.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
+ * optional Location location = 1;
+ * @return {?proto.parca.query.v1alpha1.Location}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.Mode = {
-  MODE_SINGLE_UNSPECIFIED: 0,
-  MODE_MERGE: 1
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getLocation = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Location} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Location, 1));
 };
 
+
 /**
- * optional Mode mode = 1;
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode}
+ * @param {?proto.parca.query.v1alpha1.Location|undefined} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+*/
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setLocation = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getMode = function() {
-  return /** @type {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLocation = function() {
+  return this.setLocation(undefined);
 };
 
 
 /**
- * @param {!proto.parca.query.v1alpha1.ProfileDiffSelection.Mode} value
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasLocation = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional MergeProfile merge = 2;
- * @return {?proto.parca.query.v1alpha1.MergeProfile}
+ * optional Mapping mapping = 2;
+ * @return {?proto.parca.query.v1alpha1.Mapping}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getMerge = function() {
-  return /** @type{?proto.parca.query.v1alpha1.MergeProfile} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.MergeProfile, 2));
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getMapping = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Mapping} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Mapping, 2));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.MergeProfile|undefined} value
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ * @param {?proto.parca.query.v1alpha1.Mapping|undefined} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
 */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setMerge = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0], value);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setMapping = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearMerge = function() {
-  return this.setMerge(undefined);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearMapping = function() {
+  return this.setMapping(undefined);
 };
 
 
@@ -2208,36 +9237,36 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearMerge = function(
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.hasMerge = function() {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasMapping = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional SingleProfile single = 3;
- * @return {?proto.parca.query.v1alpha1.SingleProfile}
+ * optional Function function = 3;
+ * @return {?proto.parca.query.v1alpha1.Function}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.getSingle = function() {
-  return /** @type{?proto.parca.query.v1alpha1.SingleProfile} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.SingleProfile, 3));
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getFunction = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Function} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Function, 3));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.SingleProfile|undefined} value
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ * @param {?proto.parca.query.v1alpha1.Function|undefined} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
 */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.setSingle = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.parca.query.v1alpha1.ProfileDiffSelection.oneofGroups_[0], value);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setFunction = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.ProfileDiffSelection} returns this
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearSingle = function() {
-  return this.setSingle(undefined);
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearFunction = function() {
+  return this.setFunction(undefined);
 };
 
 
@@ -2245,41 +9274,51 @@ proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.clearSingle = function
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.ProfileDiffSelection.prototype.hasSingle = function() {
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasFunction = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * optional Line line = 4;
+ * @return {?proto.parca.query.v1alpha1.Line}
  */
-proto.parca.query.v1alpha1.QueryRequest.oneofGroups_ = [[2,3,4]];
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getLine = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Line} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Line, 4));
+};
+
 
 /**
- * @enum {number}
+ * @param {?proto.parca.query.v1alpha1.Line|undefined} value
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+*/
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setLine = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
  */
-proto.parca.query.v1alpha1.QueryRequest.OptionsCase = {
-  OPTIONS_NOT_SET: 0,
-  DIFF: 2,
-  MERGE: 3,
-  SINGLE: 4
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLine = function() {
+  return this.setLine(undefined);
 };
 
+
 /**
- * @return {proto.parca.query.v1alpha1.QueryRequest.OptionsCase}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getOptionsCase = function() {
-  return /** @type {proto.parca.query.v1alpha1.QueryRequest.OptionsCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0]));
+proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasLine = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2293,8 +9332,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.QueryRequest.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Location.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Location.toObject(opt_includeInstance, this);
 };
 
 
@@ -2303,17 +9342,16 @@ proto.parca.query.v1alpha1.QueryRequest.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.QueryRequest} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.Location} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryRequest.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.Location.toObject = function(includeInstance, msg) {
   var f, obj = {
-    mode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    diff: (f = msg.getDiff()) && proto.parca.query.v1alpha1.DiffProfile.toObject(includeInstance, f),
-    merge: (f = msg.getMerge()) && proto.parca.query.v1alpha1.MergeProfile.toObject(includeInstance, f),
-    single: (f = msg.getSingle()) && proto.parca.query.v1alpha1.SingleProfile.toObject(includeInstance, f),
-    reportType: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    mappingId: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    address: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    isFolded: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -2327,23 +9365,23 @@ proto.parca.query.v1alpha1.QueryRequest.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.QueryRequest}
+ * @return {!proto.parca.query.v1alpha1.Location}
  */
-proto.parca.query.v1alpha1.QueryRequest.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.Location.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.QueryRequest;
-  return proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.Location;
+  return proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.QueryRequest} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.Location} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.QueryRequest}
+ * @return {!proto.parca.query.v1alpha1.Location}
  */
-proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2351,27 +9389,20 @@ proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.parca.query.v1alpha1.QueryRequest.Mode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
-      var value = new proto.parca.query.v1alpha1.DiffProfile;
-      reader.readMessage(value,proto.parca.query.v1alpha1.DiffProfile.deserializeBinaryFromReader);
-      msg.setDiff(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMappingId(value);
       break;
     case 3:
-      var value = new proto.parca.query.v1alpha1.MergeProfile;
-      reader.readMessage(value,proto.parca.query.v1alpha1.MergeProfile.deserializeBinaryFromReader);
-      msg.setMerge(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAddress(value);
       break;
     case 4:
-      var value = new proto.parca.query.v1alpha1.SingleProfile;
-      reader.readMessage(value,proto.parca.query.v1alpha1.SingleProfile.deserializeBinaryFromReader);
-      msg.setSingle(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.parca.query.v1alpha1.QueryRequest.ReportType} */ (reader.readEnum());
-      msg.setReportType(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsFolded(value);
       break;
     default:
       reader.skipField();
@@ -2386,9 +9417,9 @@ proto.parca.query.v1alpha1.QueryRequest.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.Location.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.QueryRequest.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.Location.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2396,47 +9427,37 @@ proto.parca.query.v1alpha1.QueryRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.QueryRequest} message
+ * @param {!proto.parca.query.v1alpha1.Location} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryRequest.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.Location.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getDiff();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMappingId();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
-      f,
-      proto.parca.query.v1alpha1.DiffProfile.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getMerge();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAddress();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
-      f,
-      proto.parca.query.v1alpha1.MergeProfile.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSingle();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getIsFolded();
+  if (f) {
+    writer.writeBool(
       4,
-      f,
-      proto.parca.query.v1alpha1.SingleProfile.serializeBinaryToWriter
-    );
-  }
-  f = message.getReportType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
       f
     );
   }
@@ -2444,165 +9465,74 @@ proto.parca.query.v1alpha1.QueryRequest.serializeBinaryToWriter = function(messa
 
 
 /**
- * @enum {number}
- */
-proto.parca.query.v1alpha1.QueryRequest.Mode = {
-  MODE_SINGLE_UNSPECIFIED: 0,
-  MODE_DIFF: 1,
-  MODE_MERGE: 2
-};
-
-/**
- * @enum {number}
- */
-proto.parca.query.v1alpha1.QueryRequest.ReportType = {
-  REPORT_TYPE_FLAMEGRAPH_UNSPECIFIED: 0
-};
-
-/**
- * optional Mode mode = 1;
- * @return {!proto.parca.query.v1alpha1.QueryRequest.Mode}
- */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getMode = function() {
-  return /** @type {!proto.parca.query.v1alpha1.QueryRequest.Mode} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.parca.query.v1alpha1.QueryRequest.Mode} value
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
- */
-proto.parca.query.v1alpha1.QueryRequest.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
-};
-
-
-/**
- * optional DiffProfile diff = 2;
- * @return {?proto.parca.query.v1alpha1.DiffProfile}
- */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getDiff = function() {
-  return /** @type{?proto.parca.query.v1alpha1.DiffProfile} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.DiffProfile, 2));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.DiffProfile|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
-*/
-proto.parca.query.v1alpha1.QueryRequest.prototype.setDiff = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
- */
-proto.parca.query.v1alpha1.QueryRequest.prototype.clearDiff = function() {
-  return this.setDiff(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.parca.query.v1alpha1.QueryRequest.prototype.hasDiff = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional MergeProfile merge = 3;
- * @return {?proto.parca.query.v1alpha1.MergeProfile}
+ * optional uint64 id = 1;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getMerge = function() {
-  return /** @type{?proto.parca.query.v1alpha1.MergeProfile} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.MergeProfile, 3));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.MergeProfile|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
-*/
-proto.parca.query.v1alpha1.QueryRequest.prototype.setMerge = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
+proto.parca.query.v1alpha1.Location.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Location} returns this
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.clearMerge = function() {
-  return this.setMerge(undefined);
+proto.parca.query.v1alpha1.Location.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 mapping_id = 2;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.hasMerge = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.parca.query.v1alpha1.Location.prototype.getMappingId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional SingleProfile single = 4;
- * @return {?proto.parca.query.v1alpha1.SingleProfile}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Location} returns this
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getSingle = function() {
-  return /** @type{?proto.parca.query.v1alpha1.SingleProfile} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.SingleProfile, 4));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.SingleProfile|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
-*/
-proto.parca.query.v1alpha1.QueryRequest.prototype.setSingle = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.parca.query.v1alpha1.QueryRequest.oneofGroups_[0], value);
+proto.parca.query.v1alpha1.Location.prototype.setMappingId = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ * optional uint64 address = 3;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.clearSingle = function() {
-  return this.setSingle(undefined);
+proto.parca.query.v1alpha1.Location.prototype.getAddress = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Location} returns this
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.hasSingle = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.parca.query.v1alpha1.Location.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional ReportType report_type = 5;
- * @return {!proto.parca.query.v1alpha1.QueryRequest.ReportType}
+ * optional bool is_folded = 4;
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.getReportType = function() {
-  return /** @type {!proto.parca.query.v1alpha1.QueryRequest.ReportType} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.parca.query.v1alpha1.Location.prototype.getIsFolded = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {!proto.parca.query.v1alpha1.QueryRequest.ReportType} value
- * @return {!proto.parca.query.v1alpha1.QueryRequest} returns this
+ * @param {boolean} value
+ * @return {!proto.parca.query.v1alpha1.Location} returns this
  */
-proto.parca.query.v1alpha1.QueryRequest.prototype.setReportType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.parca.query.v1alpha1.Location.prototype.setIsFolded = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -2622,8 +9552,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.Flamegraph.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Line.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Line.toObject(opt_includeInstance, this);
 };
 
 
@@ -2632,16 +9562,15 @@ proto.parca.query.v1alpha1.Flamegraph.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.Flamegraph} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.Line} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Flamegraph.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.Line.toObject = function(includeInstance, msg) {
   var f, obj = {
-    root: (f = msg.getRoot()) && proto.parca.query.v1alpha1.FlamegraphRootNode.toObject(includeInstance, f),
-    total: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    unit: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    height: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    locationId: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    functionId: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    line: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -2655,23 +9584,23 @@ proto.parca.query.v1alpha1.Flamegraph.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.Flamegraph}
+ * @return {!proto.parca.query.v1alpha1.Line}
  */
-proto.parca.query.v1alpha1.Flamegraph.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.Line.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.Flamegraph;
-  return proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.Line;
+  return proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.Flamegraph} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.Line} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.Flamegraph}
+ * @return {!proto.parca.query.v1alpha1.Line}
  */
-proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2679,21 +9608,16 @@ proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.parca.query.v1alpha1.FlamegraphRootNode;
-      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader);
-      msg.setRoot(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setLocationId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTotal(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFunctionId(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUnit(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setHeight(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLine(value);
       break;
     default:
       reader.skipField();
@@ -2708,9 +9632,9 @@ proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.Line.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.Line.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2718,38 +9642,30 @@ proto.parca.query.v1alpha1.Flamegraph.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.Flamegraph} message
+ * @param {!proto.parca.query.v1alpha1.Line} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.Line.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRoot();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getLocationId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getTotal();
+  f = message.getFunctionId();
   if (f !== 0) {
-    writer.writeInt64(
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getUnit();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getHeight();
+  f = message.getLine();
   if (f !== 0) {
-    writer.writeInt32(
-      4,
+    writer.writeInt64(
+      3,
       f
     );
   }
@@ -2757,104 +9673,60 @@ proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter = function(message
 
 
 /**
- * optional FlamegraphRootNode root = 1;
- * @return {?proto.parca.query.v1alpha1.FlamegraphRootNode}
- */
-proto.parca.query.v1alpha1.Flamegraph.prototype.getRoot = function() {
-  return /** @type{?proto.parca.query.v1alpha1.FlamegraphRootNode} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.FlamegraphRootNode, 1));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.FlamegraphRootNode|undefined} value
- * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
-*/
-proto.parca.query.v1alpha1.Flamegraph.prototype.setRoot = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
+ * optional uint64 location_id = 1;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.clearRoot = function() {
-  return this.setRoot(undefined);
+proto.parca.query.v1alpha1.Line.prototype.getLocationId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Line} returns this
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.hasRoot = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.Line.prototype.setLocationId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional int64 total = 2;
+ * optional uint64 function_id = 2;
  * @return {number}
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.getTotal = function() {
+proto.parca.query.v1alpha1.Line.prototype.getFunctionId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
+ * @return {!proto.parca.query.v1alpha1.Line} returns this
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.setTotal = function(value) {
+proto.parca.query.v1alpha1.Line.prototype.setFunctionId = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string unit = 3;
- * @return {string}
- */
-proto.parca.query.v1alpha1.Flamegraph.prototype.getUnit = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
- */
-proto.parca.query.v1alpha1.Flamegraph.prototype.setUnit = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional int32 height = 4;
+ * optional int64 line = 3;
  * @return {number}
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.getHeight = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.parca.query.v1alpha1.Line.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Flamegraph} returns this
+ * @return {!proto.parca.query.v1alpha1.Line} returns this
  */
-proto.parca.query.v1alpha1.Flamegraph.prototype.setHeight = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.parca.query.v1alpha1.Line.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.parca.query.v1alpha1.FlamegraphRootNode.repeatedFields_ = [3];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2870,8 +9742,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.FlamegraphRootNode.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Mapping.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Mapping.toObject(opt_includeInstance, this);
 };
 
 
@@ -2880,16 +9752,18 @@ proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.Mapping} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.Mapping.toObject = function(includeInstance, msg) {
   var f, obj = {
-    cumulative: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    diff: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
-    proto.parca.query.v1alpha1.FlamegraphNode.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    start: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    offset: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    file: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    buildId: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -2903,23 +9777,23 @@ proto.parca.query.v1alpha1.FlamegraphRootNode.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode}
+ * @return {!proto.parca.query.v1alpha1.Mapping}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.Mapping.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.FlamegraphRootNode;
-  return proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.Mapping;
+  return proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.Mapping} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode}
+ * @return {!proto.parca.query.v1alpha1.Mapping}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2927,17 +9801,28 @@ proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCumulative(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setDiff(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStart(value);
       break;
     case 3:
-      var value = new proto.parca.query.v1alpha1.FlamegraphNode;
-      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader);
-      msg.addChildren(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setLimit(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setOffset(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFile(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBuildId(value);
       break;
     default:
       reader.skipField();
@@ -2952,9 +9837,9 @@ proto.parca.query.v1alpha1.FlamegraphRootNode.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.Mapping.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2962,118 +9847,165 @@ proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.FlamegraphRootNode} message
+ * @param {!proto.parca.query.v1alpha1.Mapping} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCumulative();
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLimit();
   if (f !== 0) {
-    writer.writeInt64(
-      1,
+    writer.writeUint64(
+      3,
       f
     );
   }
-  f = message.getDiff();
+  f = message.getOffset();
   if (f !== 0) {
-    writer.writeInt64(
-      2,
+    writer.writeUint64(
+      4,
       f
     );
   }
-  f = message.getChildrenList();
+  f = message.getFile();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getBuildId();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * optional int64 cumulative = 1;
+ * optional uint64 id = 1;
  * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getCumulative = function() {
+proto.parca.query.v1alpha1.Mapping.prototype.getId = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setCumulative = function(value) {
+proto.parca.query.v1alpha1.Mapping.prototype.setId = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional int64 diff = 2;
+ * optional uint64 start = 2;
  * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getDiff = function() {
+proto.parca.query.v1alpha1.Mapping.prototype.getStart = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setDiff = function(value) {
+proto.parca.query.v1alpha1.Mapping.prototype.setStart = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * repeated FlamegraphNode children = 3;
- * @return {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>}
+ * optional uint64 limit = 3;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.getChildrenList = function() {
-  return /** @type{!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNode, 3));
+proto.parca.query.v1alpha1.Mapping.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
-*/
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.setChildrenList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
+ */
+proto.parca.query.v1alpha1.Mapping.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.parca.query.v1alpha1.FlamegraphNode=} opt_value
- * @param {number=} opt_index
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
+ * optional uint64 offset = 4;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.addChildren = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.parca.query.v1alpha1.FlamegraphNode, opt_index);
+proto.parca.query.v1alpha1.Mapping.prototype.getOffset = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.parca.query.v1alpha1.FlamegraphRootNode} returns this
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphRootNode.prototype.clearChildrenList = function() {
-  return this.setChildrenList([]);
+proto.parca.query.v1alpha1.Mapping.prototype.setOffset = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
+/**
+ * optional string file = 5;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Mapping.prototype.getFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNode.repeatedFields_ = [4];
+proto.parca.query.v1alpha1.Mapping.prototype.setFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string build_id = 6;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Mapping.prototype.getBuildId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Mapping} returns this
+ */
+proto.parca.query.v1alpha1.Mapping.prototype.setBuildId = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
 
 
 
@@ -3090,8 +10022,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.FlamegraphNode.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.Function.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.Function.toObject(opt_includeInstance, this);
 };
 
 
@@ -3100,17 +10032,17 @@ proto.parca.query.v1alpha1.FlamegraphNode.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.FlamegraphNode} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.Function} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphNode.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.Function.toObject = function(includeInstance, msg) {
   var f, obj = {
-    meta: (f = msg.getMeta()) && proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject(includeInstance, f),
-    cumulative: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    diff: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    childrenList: jspb.Message.toObjectList(msg.getChildrenList(),
-    proto.parca.query.v1alpha1.FlamegraphNode.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    systemName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    filename: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    startLine: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -3124,23 +10056,23 @@ proto.parca.query.v1alpha1.FlamegraphNode.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
+ * @return {!proto.parca.query.v1alpha1.Function}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.Function.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.FlamegraphNode;
-  return proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.Function;
+  return proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.FlamegraphNode} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.Function} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
+ * @return {!proto.parca.query.v1alpha1.Function}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3148,22 +10080,24 @@ proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.parca.query.v1alpha1.FlamegraphNodeMeta;
-      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader);
-      msg.setMeta(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setCumulative(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setDiff(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSystemName(value);
       break;
     case 4:
-      var value = new proto.parca.query.v1alpha1.FlamegraphNode;
-      reader.readMessage(value,proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader);
-      msg.addChildren(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartLine(value);
       break;
     default:
       reader.skipField();
@@ -3178,9 +10112,9 @@ proto.parca.query.v1alpha1.FlamegraphNode.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.Function.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.Function.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3188,159 +10122,174 @@ proto.parca.query.v1alpha1.FlamegraphNode.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.FlamegraphNode} message
+ * @param {!proto.parca.query.v1alpha1.Function} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.Function.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMeta();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
-      f,
-      proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getCumulative();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getDiff();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getSystemName();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getChildrenList();
+  f = message.getFilename();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       4,
-      f,
-      proto.parca.query.v1alpha1.FlamegraphNode.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getStartLine();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
     );
   }
 };
 
 
 /**
- * optional FlamegraphNodeMeta meta = 1;
- * @return {?proto.parca.query.v1alpha1.FlamegraphNodeMeta}
+ * optional uint64 id = 1;
+ * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.getMeta = function() {
-  return /** @type{?proto.parca.query.v1alpha1.FlamegraphNodeMeta} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNodeMeta, 1));
+proto.parca.query.v1alpha1.Function.prototype.getId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.FlamegraphNodeMeta|undefined} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
-*/
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.setMeta = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.Function} returns this
+ */
+proto.parca.query.v1alpha1.Function.prototype.setId = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ * optional string name = 2;
+ * @return {string}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.clearMeta = function() {
-  return this.setMeta(undefined);
+proto.parca.query.v1alpha1.Function.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Function} returns this
+ */
+proto.parca.query.v1alpha1.Function.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string system_name = 3;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.Function.prototype.getSystemName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Function} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.hasMeta = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.Function.prototype.setSystemName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional int64 cumulative = 2;
- * @return {number}
+ * optional string filename = 4;
+ * @return {string}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.getCumulative = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.parca.query.v1alpha1.Function.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.Function} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.setCumulative = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.parca.query.v1alpha1.Function.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional int64 diff = 3;
+ * optional int64 start_line = 5;
  * @return {number}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.getDiff = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.parca.query.v1alpha1.Function.prototype.getStartLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ * @return {!proto.parca.query.v1alpha1.Function} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.setDiff = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.parca.query.v1alpha1.Function.prototype.setStartLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
-/**
- * repeated FlamegraphNode children = 4;
- * @return {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>}
- */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.getChildrenList = function() {
-  return /** @type{!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.FlamegraphNode, 4));
-};
-
 
 /**
- * @param {!Array<!proto.parca.query.v1alpha1.FlamegraphNode>} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
-*/
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.setChildrenList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
-};
-
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.parca.query.v1alpha1.QueryResponse.oneofGroups_ = [[5,6,7,8,9,10,11]];
 
 /**
- * @param {!proto.parca.query.v1alpha1.FlamegraphNode=} opt_value
- * @param {number=} opt_index
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode}
+ * @enum {number}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.addChildren = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.parca.query.v1alpha1.FlamegraphNode, opt_index);
+proto.parca.query.v1alpha1.QueryResponse.ReportCase = {
+  REPORT_NOT_SET: 0,
+  FLAMEGRAPH: 5,
+  PPROF: 6,
+  TOP: 7,
+  CALLGRAPH: 8,
+  SOURCE: 9,
+  DISASSEMBLY: 10,
+  GROUPS: 11
 };
 
-
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNode} returns this
+ * @return {proto.parca.query.v1alpha1.QueryResponse.ReportCase}
  */
-proto.parca.query.v1alpha1.FlamegraphNode.prototype.clearChildrenList = function() {
-  return this.setChildrenList([]);
+proto.parca.query.v1alpha1.QueryResponse.prototype.getReportCase = function() {
+  return /** @type {proto.parca.query.v1alpha1.QueryResponse.ReportCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0]));
 };
 
 
 
-
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3354,8 +10303,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.QueryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.QueryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3364,16 +10313,19 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.QueryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.QueryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    location: (f = msg.getLocation()) && proto.parca.query.v1alpha1.Location.toObject(includeInstance, f),
-    mapping: (f = msg.getMapping()) && proto.parca.query.v1alpha1.Mapping.toObject(includeInstance, f),
-    pb_function: (f = msg.getFunction()) && proto.parca.query.v1alpha1.Function.toObject(includeInstance, f),
-    line: (f = msg.getLine()) && proto.parca.query.v1alpha1.Line.toObject(includeInstance, f)
+    flamegraph: (f = msg.getFlamegraph()) && proto.parca.query.v1alpha1.Flamegraph.toObject(includeInstance, f),
+    pprof: msg.getPprof_asB64(),
+    top: (f = msg.getTop()) && proto.parca.query.v1alpha1.Top.toObject(includeInstance, f),
+    callgraph: (f = msg.getCallgraph()) && proto.parca.query.v1alpha1.Callgraph.toObject(includeInstance, f),
+    source: (f = msg.getSource()) && proto.parca.query.v1alpha1.Source.toObject(includeInstance, f),
+    disassembly: (f = msg.getDisassembly()) && proto.parca.query.v1alpha1.Disassembly.toObject(includeInstance, f),
+    groups: (f = msg.getGroups()) && proto.parca.query.v1alpha1.ReportGroups.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3387,48 +10339,62 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta}
+ * @return {!proto.parca.query.v1alpha1.QueryResponse}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.QueryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.FlamegraphNodeMeta;
-  return proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.QueryResponse;
+  return proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.QueryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta}
+ * @return {!proto.parca.query.v1alpha1.QueryResponse}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
-      var value = new proto.parca.query.v1alpha1.Location;
-      reader.readMessage(value,proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader);
-      msg.setLocation(value);
+    case 5:
+      var value = new proto.parca.query.v1alpha1.Flamegraph;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader);
+      msg.setFlamegraph(value);
       break;
-    case 2:
-      var value = new proto.parca.query.v1alpha1.Mapping;
-      reader.readMessage(value,proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader);
-      msg.setMapping(value);
+    case 6:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setPprof(value);
       break;
-    case 3:
-      var value = new proto.parca.query.v1alpha1.Function;
-      reader.readMessage(value,proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader);
-      msg.setFunction(value);
+    case 7:
+      var value = new proto.parca.query.v1alpha1.Top;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Top.deserializeBinaryFromReader);
+      msg.setTop(value);
       break;
-    case 4:
-      var value = new proto.parca.query.v1alpha1.Line;
-      reader.readMessage(value,proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader);
-      msg.setLine(value);
+    case 8:
+      var value = new proto.parca.query.v1alpha1.Callgraph;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Callgraph.deserializeBinaryFromReader);
+      msg.setCallgraph(value);
+      break;
+    case 9:
+      var value = new proto.parca.query.v1alpha1.Source;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Source.deserializeBinaryFromReader);
+      msg.setSource(value);
+      break;
+    case 10:
+      var value = new proto.parca.query.v1alpha1.Disassembly;
+      reader.readMessage(value,proto.parca.query.v1alpha1.Disassembly.deserializeBinaryFromReader);
+      msg.setDisassembly(value);
+      break;
+    case 11:
+      var value = new proto.parca.query.v1alpha1.ReportGroups;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ReportGroups.deserializeBinaryFromReader);
+      msg.setGroups(value);
       break;
     default:
       reader.skipField();
@@ -3443,9 +10409,9 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.QueryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.QueryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3453,72 +10419,95 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} message
+ * @param {!proto.parca.query.v1alpha1.QueryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.QueryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLocation();
+  f = message.getFlamegraph();
   if (f != null) {
     writer.writeMessage(
-      1,
+      5,
       f,
-      proto.parca.query.v1alpha1.Location.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter
     );
   }
-  f = message.getMapping();
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeBytes(
+      6,
+      f
+    );
+  }
+  f = message.getTop();
   if (f != null) {
     writer.writeMessage(
-      2,
+      7,
       f,
-      proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Top.serializeBinaryToWriter
     );
   }
-  f = message.getFunction();
+  f = message.getCallgraph();
   if (f != null) {
     writer.writeMessage(
-      3,
+      8,
       f,
-      proto.parca.query.v1alpha1.Function.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Callgraph.serializeBinaryToWriter
     );
   }
-  f = message.getLine();
+  f = message.getSource();
   if (f != null) {
     writer.writeMessage(
-      4,
+      9,
       f,
-      proto.parca.query.v1alpha1.Line.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.Source.serializeBinaryToWriter
+    );
+  }
+  f = message.getDisassembly();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.parca.query.v1alpha1.Disassembly.serializeBinaryToWriter
+    );
+  }
+  f = message.getGroups();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.parca.query.v1alpha1.ReportGroups.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional Location location = 1;
- * @return {?proto.parca.query.v1alpha1.Location}
+ * optional Flamegraph flamegraph = 5;
+ * @return {?proto.parca.query.v1alpha1.Flamegraph}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getLocation = function() {
-  return /** @type{?proto.parca.query.v1alpha1.Location} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Location, 1));
+proto.parca.query.v1alpha1.QueryResponse.prototype.getFlamegraph = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Flamegraph} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Flamegraph, 5));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.Location|undefined} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+ * @param {?proto.parca.query.v1alpha1.Flamegraph|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
 */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setLocation = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.setFlamegraph = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLocation = function() {
-  return this.setLocation(undefined);
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearFlamegraph = function() {
+  return this.setFlamegraph(undefined);
 };
 
 
@@ -3526,73 +10515,59 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLocation = function
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasLocation = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasFlamegraph = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional Mapping mapping = 2;
- * @return {?proto.parca.query.v1alpha1.Mapping}
+ * optional bytes pprof = 6;
+ * @return {!(string|Uint8Array)}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getMapping = function() {
-  return /** @type{?proto.parca.query.v1alpha1.Mapping} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Mapping, 2));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.Mapping|undefined} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
-*/
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setMapping = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.getPprof = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+ * optional bytes pprof = 6;
+ * This is a type-conversion wrapper around `getPprof()`
+ * @return {string}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearMapping = function() {
-  return this.setMapping(undefined);
+proto.parca.query.v1alpha1.QueryResponse.prototype.getPprof_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getPprof()));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes pprof = 6;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getPprof()`
+ * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasMapping = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.parca.query.v1alpha1.QueryResponse.prototype.getPprof_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getPprof()));
 };
 
 
 /**
- * optional Function function = 3;
- * @return {?proto.parca.query.v1alpha1.Function}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getFunction = function() {
-  return /** @type{?proto.parca.query.v1alpha1.Function} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Function, 3));
-};
-
-
-/**
- * @param {?proto.parca.query.v1alpha1.Function|undefined} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
-*/
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setFunction = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.setPprof = function(value) {
+  return jspb.Message.setOneofField(this, 6, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearFunction = function() {
-  return this.setFunction(undefined);
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearPprof = function() {
+  return jspb.Message.setOneofField(this, 6, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], undefined);
 };
 
 
@@ -3600,36 +10575,36 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearFunction = function
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasFunction = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasPprof = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional Line line = 4;
- * @return {?proto.parca.query.v1alpha1.Line}
+ * optional Top top = 7;
+ * @return {?proto.parca.query.v1alpha1.Top}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.getLine = function() {
-  return /** @type{?proto.parca.query.v1alpha1.Line} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Line, 4));
+proto.parca.query.v1alpha1.QueryResponse.prototype.getTop = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Top} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Top, 7));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.Line|undefined} value
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
+ * @param {?proto.parca.query.v1alpha1.Top|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
 */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.setLine = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.setTop = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.FlamegraphNodeMeta} returns this
- */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLine = function() {
-  return this.setLine(undefined);
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+ */
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearTop = function() {
+  return this.setTop(undefined);
 };
 
 
@@ -3637,232 +10612,167 @@ proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.clearLine = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.FlamegraphNodeMeta.prototype.hasLine = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasTop = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
+/**
+ * optional Callgraph callgraph = 8;
+ * @return {?proto.parca.query.v1alpha1.Callgraph}
+ */
+proto.parca.query.v1alpha1.QueryResponse.prototype.getCallgraph = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Callgraph} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Callgraph, 8));
+};
+
 
+/**
+ * @param {?proto.parca.query.v1alpha1.Callgraph|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+*/
+proto.parca.query.v1alpha1.QueryResponse.prototype.setCallgraph = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.Location.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.Location.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearCallgraph = function() {
+  return this.setCallgraph(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.Location} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Location.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    mappingId: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    address: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    isFolded: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasCallgraph = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.Location}
+ * optional Source source = 9;
+ * @return {?proto.parca.query.v1alpha1.Source}
  */
-proto.parca.query.v1alpha1.Location.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.Location;
-  return proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader(msg, reader);
+proto.parca.query.v1alpha1.QueryResponse.prototype.getSource = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Source} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Source, 9));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.Location} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.Location}
- */
-proto.parca.query.v1alpha1.Location.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setMappingId(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setAddress(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsFolded(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.parca.query.v1alpha1.Source|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+*/
+proto.parca.query.v1alpha1.QueryResponse.prototype.setSource = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.Location.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.Location.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearSource = function() {
+  return this.setSource(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.Location} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Location.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getMappingId();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getAddress();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getIsFolded();
-  if (f) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasSource = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * optional uint64 id = 1;
- * @return {number}
+ * optional Disassembly disassembly = 10;
+ * @return {?proto.parca.query.v1alpha1.Disassembly}
  */
-proto.parca.query.v1alpha1.Location.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.parca.query.v1alpha1.QueryResponse.prototype.getDisassembly = function() {
+  return /** @type{?proto.parca.query.v1alpha1.Disassembly} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Disassembly, 10));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Location} returns this
- */
-proto.parca.query.v1alpha1.Location.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+ * @param {?proto.parca.query.v1alpha1.Disassembly|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+*/
+proto.parca.query.v1alpha1.QueryResponse.prototype.setDisassembly = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 10, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * optional uint64 mapping_id = 2;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.Location.prototype.getMappingId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearDisassembly = function() {
+  return this.setDisassembly(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Location} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Location.prototype.setMappingId = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasDisassembly = function() {
+  return jspb.Message.getField(this, 10) != null;
 };
 
 
 /**
- * optional uint64 address = 3;
- * @return {number}
+ * optional ReportGroups groups = 11;
+ * @return {?proto.parca.query.v1alpha1.ReportGroups}
  */
-proto.parca.query.v1alpha1.Location.prototype.getAddress = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.parca.query.v1alpha1.QueryResponse.prototype.getGroups = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ReportGroups} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ReportGroups, 11));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Location} returns this
- */
-proto.parca.query.v1alpha1.Location.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+ * @param {?proto.parca.query.v1alpha1.ReportGroups|undefined} value
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+*/
+proto.parca.query.v1alpha1.QueryResponse.prototype.setGroups = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 11, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
 };
 
 
 /**
- * optional bool is_folded = 4;
- * @return {boolean}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
  */
-proto.parca.query.v1alpha1.Location.prototype.getIsFolded = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.parca.query.v1alpha1.QueryResponse.prototype.clearGroups = function() {
+  return this.setGroups(undefined);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.parca.query.v1alpha1.Location} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Location.prototype.setIsFolded = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 4, value);
+proto.parca.query.v1alpha1.QueryResponse.prototype.hasGroups = function() {
+  return jspb.Message.getField(this, 11) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.query.v1alpha1.ReportGroups.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3878,8 +10788,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.Line.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.Line.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.ReportGroups.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.ReportGroups.toObject(opt_includeInstance, this);
 };
 
 
@@ -3888,15 +10798,14 @@ proto.parca.query.v1alpha1.Line.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.Line} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.ReportGroups} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Line.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.ReportGroups.toObject = function(includeInstance, msg) {
   var f, obj = {
-    locationId: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    functionId: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    line: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    groupsList: jspb.Message.toObjectList(msg.getGroupsList(),
+    proto.parca.query.v1alpha1.ReportGroup.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3910,23 +10819,23 @@ proto.parca.query.v1alpha1.Line.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.Line}
+ * @return {!proto.parca.query.v1alpha1.ReportGroups}
  */
-proto.parca.query.v1alpha1.Line.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.ReportGroups.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.Line;
-  return proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.ReportGroups;
+  return proto.parca.query.v1alpha1.ReportGroups.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.Line} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.ReportGroups} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.Line}
+ * @return {!proto.parca.query.v1alpha1.ReportGroups}
  */
-proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.ReportGroups.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3934,16 +10843,9 @@ proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setLocationId(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setFunctionId(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setLine(value);
+      var value = new proto.parca.query.v1alpha1.ReportGroup;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ReportGroup.deserializeBinaryFromReader);
+      msg.addGroups(value);
       break;
     default:
       reader.skipField();
@@ -3958,9 +10860,9 @@ proto.parca.query.v1alpha1.Line.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.Line.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.ReportGroups.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.Line.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.ReportGroups.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3968,87 +10870,58 @@ proto.parca.query.v1alpha1.Line.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.Line} message
+ * @param {!proto.parca.query.v1alpha1.ReportGroups} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Line.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.ReportGroups.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLocationId();
-  if (f !== 0) {
-    writer.writeUint64(
-      1,
-      f
-    );
-  }
-  f = message.getFunctionId();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = message.getLine();
-  if (f !== 0) {
-    writer.writeInt64(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * optional uint64 location_id = 1;
- * @return {number}
- */
-proto.parca.query.v1alpha1.Line.prototype.getLocationId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Line} returns this
- */
-proto.parca.query.v1alpha1.Line.prototype.setLocationId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+  f = message.getGroupsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.query.v1alpha1.ReportGroup.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional uint64 function_id = 2;
- * @return {number}
+ * repeated ReportGroup groups = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.ReportGroup>}
  */
-proto.parca.query.v1alpha1.Line.prototype.getFunctionId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.parca.query.v1alpha1.ReportGroups.prototype.getGroupsList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.ReportGroup>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.ReportGroup, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Line} returns this
- */
-proto.parca.query.v1alpha1.Line.prototype.setFunctionId = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+ * @param {!Array<!proto.parca.query.v1alpha1.ReportGroup>} value
+ * @return {!proto.parca.query.v1alpha1.ReportGroups} returns this
+*/
+proto.parca.query.v1alpha1.ReportGroups.prototype.setGroupsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional int64 line = 3;
- * @return {number}
+ * @param {!proto.parca.query.v1alpha1.ReportGroup=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.ReportGroup}
  */
-proto.parca.query.v1alpha1.Line.prototype.getLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.parca.query.v1alpha1.ReportGroups.prototype.addGroups = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.ReportGroup, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Line} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.ReportGroups} returns this
  */
-proto.parca.query.v1alpha1.Line.prototype.setLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.parca.query.v1alpha1.ReportGroups.prototype.clearGroupsList = function() {
+  return this.setGroupsList([]);
 };
 
 
@@ -4068,8 +10941,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.Mapping.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.ReportGroup.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.ReportGroup.toObject(opt_includeInstance, this);
 };
 
 
@@ -4078,18 +10951,14 @@ proto.parca.query.v1alpha1.Mapping.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.Mapping} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.ReportGroup} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Mapping.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.ReportGroup.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    start: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    limit: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    offset: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    file: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    buildId: jspb.Message.getFieldWithDefault(msg, 6, "")
+    labelset: (f = msg.getLabelset()) && parca_profilestore_v1alpha1_profilestore_pb.LabelSet.toObject(includeInstance, f),
+    report: (f = msg.getReport()) && proto.parca.query.v1alpha1.QueryResponse.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4103,23 +10972,23 @@ proto.parca.query.v1alpha1.Mapping.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.Mapping}
+ * @return {!proto.parca.query.v1alpha1.ReportGroup}
  */
-proto.parca.query.v1alpha1.Mapping.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.ReportGroup.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.Mapping;
-  return proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.ReportGroup;
+  return proto.parca.query.v1alpha1.ReportGroup.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.Mapping} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.ReportGroup} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.Mapping}
+ * @return {!proto.parca.query.v1alpha1.ReportGroup}
  */
-proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.ReportGroup.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4127,28 +10996,14 @@ proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
+      var value = new parca_profilestore_v1alpha1_profilestore_pb.LabelSet;
+      reader.readMessage(value,parca_profilestore_v1alpha1_profilestore_pb.LabelSet.deserializeBinaryFromReader);
+      msg.setLabelset(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setStart(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setLimit(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setOffset(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFile(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBuildId(value);
+      var value = new proto.parca.query.v1alpha1.QueryResponse;
+      reader.readMessage(value,proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader);
+      msg.setReport(value);
       break;
     default:
       reader.skipField();
@@ -4163,9 +11018,9 @@ proto.parca.query.v1alpha1.Mapping.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.ReportGroup.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.ReportGroup.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4173,165 +11028,112 @@ proto.parca.query.v1alpha1.Mapping.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.Mapping} message
+ * @param {!proto.parca.query.v1alpha1.ReportGroup} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Mapping.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.ReportGroup.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getLabelset();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      parca_profilestore_v1alpha1_profilestore_pb.LabelSet.serializeBinaryToWriter
     );
   }
-  f = message.getStart();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getReport();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
-    );
-  }
-  f = message.getLimit();
-  if (f !== 0) {
-    writer.writeUint64(
-      3,
-      f
-    );
-  }
-  f = message.getOffset();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getFile();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getBuildId();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
+      f,
+      proto.parca.query.v1alpha1.QueryResponse.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 id = 1;
- * @return {number}
- */
-proto.parca.query.v1alpha1.Mapping.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
- */
-proto.parca.query.v1alpha1.Mapping.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional uint64 start = 2;
- * @return {number}
+ * optional parca.profilestore.v1alpha1.LabelSet labelset = 1;
+ * @return {?proto.parca.profilestore.v1alpha1.LabelSet}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.getStart = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.parca.query.v1alpha1.ReportGroup.prototype.getLabelset = function() {
+  return /** @type{?proto.parca.profilestore.v1alpha1.LabelSet} */ (
+    jspb.Message.getWrapperField(this, parca_profilestore_v1alpha1_profilestore_pb.LabelSet, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
- */
-proto.parca.query.v1alpha1.Mapping.prototype.setStart = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+ * @param {?proto.parca.profilestore.v1alpha1.LabelSet|undefined} value
+ * @return {!proto.parca.query.v1alpha1.ReportGroup} returns this
+*/
+proto.parca.query.v1alpha1.ReportGroup.prototype.setLabelset = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint64 limit = 3;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.ReportGroup} returns this
  */
-proto.parca.query.v1alpha1.Mapping.prototype.getLimit = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.parca.query.v1alpha1.ReportGroup.prototype.clearLabelset = function() {
+  return this.setLabelset(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.setLimit = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.parca.query.v1alpha1.ReportGroup.prototype.hasLabelset = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional uint64 offset = 4;
- * @return {number}
+ * optional QueryResponse report = 2;
+ * @return {?proto.parca.query.v1alpha1.QueryResponse}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.getOffset = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.parca.query.v1alpha1.ReportGroup.prototype.getReport = function() {
+  return /** @type{?proto.parca.query.v1alpha1.QueryResponse} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.QueryResponse, 2));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
- */
-proto.parca.query.v1alpha1.Mapping.prototype.setOffset = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+ * @param {?proto.parca.query.v1alpha1.QueryResponse|undefined} value
+ * @return {!proto.parca.query.v1alpha1.ReportGroup} returns this
+*/
+proto.parca.query.v1alpha1.ReportGroup.prototype.setReport = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string file = 5;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.ReportGroup} returns this
  */
-proto.parca.query.v1alpha1.Mapping.prototype.getFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.parca.query.v1alpha1.ReportGroup.prototype.clearReport = function() {
+  return this.setReport(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Mapping.prototype.setFile = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.parca.query.v1alpha1.ReportGroup.prototype.hasReport = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * optional string build_id = 6;
- * @return {string}
- */
-proto.parca.query.v1alpha1.Mapping.prototype.getBuildId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Mapping} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.parca.query.v1alpha1.Mapping.prototype.setBuildId = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
+proto.parca.query.v1alpha1.SeriesRequest.repeatedFields_ = [1];
 
 
 
@@ -4348,8 +11150,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.Function.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.Function.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.SeriesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SeriesRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -4358,17 +11160,15 @@ proto.parca.query.v1alpha1.Function.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.Function} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.SeriesRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Function.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.SeriesRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    systemName: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    filename: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    startLine: jspb.Message.getFieldWithDefault(msg, 5, 0)
+    matchList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4382,23 +11182,23 @@ proto.parca.query.v1alpha1.Function.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.Function}
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest}
  */
-proto.parca.query.v1alpha1.Function.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.SeriesRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.Function;
-  return proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.SeriesRequest;
+  return proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.Function} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.SeriesRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.Function}
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest}
  */
-proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4406,24 +11206,18 @@ proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSystemName(value);
-      break;
-    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.addMatch(value);
       break;
-    case 5:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setStartLine(value);
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStart(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEnd(value);
       break;
     default:
       reader.skipField();
@@ -4438,9 +11232,9 @@ proto.parca.query.v1alpha1.Function.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.Function.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.SeriesRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.Function.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4448,165 +11242,156 @@ proto.parca.query.v1alpha1.Function.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.Function} message
+ * @param {!proto.parca.query.v1alpha1.SeriesRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.Function.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getMatchList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStart();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getSystemName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getEnd();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
-    );
-  }
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getStartLine();
-  if (f !== 0) {
-    writer.writeInt64(
-      5,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional uint64 id = 1;
- * @return {number}
+ * repeated string match = 1;
+ * @return {!Array<string>}
  */
-proto.parca.query.v1alpha1.Function.prototype.getId = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.parca.query.v1alpha1.SeriesRequest.prototype.getMatchList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Function} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
  */
-proto.parca.query.v1alpha1.Function.prototype.setId = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.parca.query.v1alpha1.SeriesRequest.prototype.setMatchList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
  */
-proto.parca.query.v1alpha1.Function.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.parca.query.v1alpha1.SeriesRequest.prototype.addMatch = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Function} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
  */
-proto.parca.query.v1alpha1.Function.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.parca.query.v1alpha1.SeriesRequest.prototype.clearMatchList = function() {
+  return this.setMatchList([]);
 };
 
 
 /**
- * optional string system_name = 3;
- * @return {string}
+ * optional google.protobuf.Timestamp start = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.parca.query.v1alpha1.Function.prototype.getSystemName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.parca.query.v1alpha1.SeriesRequest.prototype.getStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Function} returns this
- */
-proto.parca.query.v1alpha1.Function.prototype.setSystemName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+*/
+proto.parca.query.v1alpha1.SeriesRequest.prototype.setStart = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string filename = 4;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
  */
-proto.parca.query.v1alpha1.Function.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.parca.query.v1alpha1.SeriesRequest.prototype.clearStart = function() {
+  return this.setStart(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.parca.query.v1alpha1.Function} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.Function.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.parca.query.v1alpha1.SeriesRequest.prototype.hasStart = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional int64 start_line = 5;
- * @return {number}
+ * optional google.protobuf.Timestamp end = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.parca.query.v1alpha1.Function.prototype.getStartLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.parca.query.v1alpha1.SeriesRequest.prototype.getEnd = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.parca.query.v1alpha1.Function} returns this
- */
-proto.parca.query.v1alpha1.Function.prototype.setStartLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+*/
+proto.parca.query.v1alpha1.SeriesRequest.prototype.setEnd = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
  */
-proto.parca.query.v1alpha1.QueryResponse.oneofGroups_ = [[5]];
+proto.parca.query.v1alpha1.SeriesRequest.prototype.clearEnd = function() {
+  return this.setEnd(undefined);
+};
+
 
 /**
- * @enum {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.QueryResponse.ReportCase = {
-  REPORT_NOT_SET: 0,
-  FLAMEGRAPH: 5
+proto.parca.query.v1alpha1.SeriesRequest.prototype.hasEnd = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
+
+
 /**
- * @return {proto.parca.query.v1alpha1.QueryResponse.ReportCase}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.getReportCase = function() {
-  return /** @type {proto.parca.query.v1alpha1.QueryResponse.ReportCase} */(jspb.Message.computeOneofCase(this, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0]));
-};
+proto.parca.query.v1alpha1.SeriesResponse.repeatedFields_ = [1,2];
 
 
 
@@ -4623,8 +11408,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.QueryResponse.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.SeriesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SeriesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4633,13 +11418,15 @@ proto.parca.query.v1alpha1.QueryResponse.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.QueryResponse} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.SeriesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryResponse.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.SeriesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    flamegraph: (f = msg.getFlamegraph()) && proto.parca.query.v1alpha1.Flamegraph.toObject(includeInstance, f)
+    seriesList: jspb.Message.toObjectList(msg.getSeriesList(),
+    proto.parca.query.v1alpha1.SeriesMetadata.toObject, includeInstance),
+    warningsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -4653,33 +11440,37 @@ proto.parca.query.v1alpha1.QueryResponse.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.QueryResponse}
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse}
  */
-proto.parca.query.v1alpha1.QueryResponse.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.SeriesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.QueryResponse;
-  return proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.SeriesResponse;
+  return proto.parca.query.v1alpha1.SeriesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.QueryResponse} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.SeriesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.QueryResponse}
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse}
  */
-proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.SeriesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 5:
-      var value = new proto.parca.query.v1alpha1.Flamegraph;
-      reader.readMessage(value,proto.parca.query.v1alpha1.Flamegraph.deserializeBinaryFromReader);
-      msg.setFlamegraph(value);
+    case 1:
+      var value = new proto.parca.query.v1alpha1.SeriesMetadata;
+      reader.readMessage(value,proto.parca.query.v1alpha1.SeriesMetadata.deserializeBinaryFromReader);
+      msg.addSeries(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWarnings(value);
       break;
     default:
       reader.skipField();
@@ -4694,9 +11485,9 @@ proto.parca.query.v1alpha1.QueryResponse.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.SeriesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.QueryResponse.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.SeriesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4704,67 +11495,105 @@ proto.parca.query.v1alpha1.QueryResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.QueryResponse} message
+ * @param {!proto.parca.query.v1alpha1.SeriesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.QueryResponse.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.SeriesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFlamegraph();
-  if (f != null) {
-    writer.writeMessage(
-      5,
+  f = message.getSeriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
       f,
-      proto.parca.query.v1alpha1.Flamegraph.serializeBinaryToWriter
+      proto.parca.query.v1alpha1.SeriesMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * optional Flamegraph flamegraph = 5;
- * @return {?proto.parca.query.v1alpha1.Flamegraph}
+ * repeated SeriesMetadata series = 1;
+ * @return {!Array<!proto.parca.query.v1alpha1.SeriesMetadata>}
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.getFlamegraph = function() {
-  return /** @type{?proto.parca.query.v1alpha1.Flamegraph} */ (
-    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.Flamegraph, 5));
+proto.parca.query.v1alpha1.SeriesResponse.prototype.getSeriesList = function() {
+  return /** @type{!Array<!proto.parca.query.v1alpha1.SeriesMetadata>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.query.v1alpha1.SeriesMetadata, 1));
 };
 
 
 /**
- * @param {?proto.parca.query.v1alpha1.Flamegraph|undefined} value
- * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+ * @param {!Array<!proto.parca.query.v1alpha1.SeriesMetadata>} value
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse} returns this
 */
-proto.parca.query.v1alpha1.QueryResponse.prototype.setFlamegraph = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.parca.query.v1alpha1.QueryResponse.oneofGroups_[0], value);
+proto.parca.query.v1alpha1.SeriesResponse.prototype.setSeriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.query.v1alpha1.SeriesMetadata=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata}
+ */
+proto.parca.query.v1alpha1.SeriesResponse.prototype.addSeries = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.query.v1alpha1.SeriesMetadata, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse} returns this
+ */
+proto.parca.query.v1alpha1.SeriesResponse.prototype.clearSeriesList = function() {
+  return this.setSeriesList([]);
+};
+
+
+/**
+ * repeated string warnings = 2;
+ * @return {!Array<string>}
+ */
+proto.parca.query.v1alpha1.SeriesResponse.prototype.getWarningsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.QueryResponse} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse} returns this
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.clearFlamegraph = function() {
-  return this.setFlamegraph(undefined);
+proto.parca.query.v1alpha1.SeriesResponse.prototype.setWarningsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse} returns this
  */
-proto.parca.query.v1alpha1.QueryResponse.prototype.hasFlamegraph = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.parca.query.v1alpha1.SeriesResponse.prototype.addWarnings = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.query.v1alpha1.SeriesResponse} returns this
  */
-proto.parca.query.v1alpha1.SeriesRequest.repeatedFields_ = [1];
+proto.parca.query.v1alpha1.SeriesResponse.prototype.clearWarningsList = function() {
+  return this.setWarningsList([]);
+};
+
+
 
 
 
@@ -4781,8 +11610,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.SeriesRequest.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.SeriesMetadata.toObject(opt_includeInstance, this);
 };
 
 
@@ -4791,15 +11620,18 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.SeriesRequest} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.SeriesMetadata} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SeriesRequest.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.SeriesMetadata.toObject = function(includeInstance, msg) {
   var f, obj = {
-    matchList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    labelset: (f = msg.getLabelset()) && parca_profilestore_v1alpha1_profilestore_pb.LabelSet.toObject(includeInstance, f),
+    minTime: (f = msg.getMinTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    maxTime: (f = msg.getMaxTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    numSamples: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    sampleType: (f = msg.getSampleType()) && proto.parca.query.v1alpha1.ValueType.toObject(includeInstance, f),
+    periodType: (f = msg.getPeriodType()) && proto.parca.query.v1alpha1.ValueType.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4813,23 +11645,23 @@ proto.parca.query.v1alpha1.SeriesRequest.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.SeriesRequest}
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata}
  */
-proto.parca.query.v1alpha1.SeriesRequest.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.SeriesMetadata.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.SeriesRequest;
-  return proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.SeriesMetadata;
+  return proto.parca.query.v1alpha1.SeriesMetadata.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.SeriesRequest} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.SeriesMetadata} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.SeriesRequest}
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata}
  */
-proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.SeriesMetadata.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4837,18 +11669,33 @@ proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addMatch(value);
+      var value = new parca_profilestore_v1alpha1_profilestore_pb.LabelSet;
+      reader.readMessage(value,parca_profilestore_v1alpha1_profilestore_pb.LabelSet.deserializeBinaryFromReader);
+      msg.setLabelset(value);
       break;
     case 2:
       var value = new google_protobuf_timestamp_pb.Timestamp;
       reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setStart(value);
+      msg.setMinTime(value);
       break;
     case 3:
       var value = new google_protobuf_timestamp_pb.Timestamp;
       reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setEnd(value);
+      msg.setMaxTime(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumSamples(value);
+      break;
+    case 5:
+      var value = new proto.parca.query.v1alpha1.ValueType;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ValueType.deserializeBinaryFromReader);
+      msg.setSampleType(value);
+      break;
+    case 6:
+      var value = new proto.parca.query.v1alpha1.ValueType;
+      reader.readMessage(value,proto.parca.query.v1alpha1.ValueType.deserializeBinaryFromReader);
+      msg.setPeriodType(value);
       break;
     default:
       reader.skipField();
@@ -4863,9 +11710,9 @@ proto.parca.query.v1alpha1.SeriesRequest.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.SeriesMetadata.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4873,20 +11720,21 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.SeriesRequest} message
+ * @param {!proto.parca.query.v1alpha1.SeriesMetadata} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.SeriesMetadata.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMatchList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getLabelset();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      parca_profilestore_v1alpha1_profilestore_pb.LabelSet.serializeBinaryToWriter
     );
   }
-  f = message.getStart();
+  f = message.getMinTime();
   if (f != null) {
     writer.writeMessage(
       2,
@@ -4894,7 +11742,7 @@ proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter = function(mess
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getEnd();
+  f = message.getMaxTime();
   if (f != null) {
     writer.writeMessage(
       3,
@@ -4902,51 +11750,74 @@ proto.parca.query.v1alpha1.SeriesRequest.serializeBinaryToWriter = function(mess
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
+  f = message.getNumSamples();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getSampleType();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.parca.query.v1alpha1.ValueType.serializeBinaryToWriter
+    );
+  }
+  f = message.getPeriodType();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.parca.query.v1alpha1.ValueType.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * repeated string match = 1;
- * @return {!Array<string>}
+ * optional parca.profilestore.v1alpha1.LabelSet labelset = 1;
+ * @return {?proto.parca.profilestore.v1alpha1.LabelSet}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.getMatchList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getLabelset = function() {
+  return /** @type{?proto.parca.profilestore.v1alpha1.LabelSet} */ (
+    jspb.Message.getWrapperField(this, parca_profilestore_v1alpha1_profilestore_pb.LabelSet, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
- */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.setMatchList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+ * @param {?proto.parca.profilestore.v1alpha1.LabelSet|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+*/
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setLabelset = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.addMatch = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.clearLabelset = function() {
+  return this.setLabelset(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.clearMatchList = function() {
-  return this.setMatchList([]);
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.hasLabelset = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional google.protobuf.Timestamp start = 2;
+ * optional google.protobuf.Timestamp min_time = 2;
  * @return {?proto.google.protobuf.Timestamp}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.getStart = function() {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getMinTime = function() {
   return /** @type{?proto.google.protobuf.Timestamp} */ (
     jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
@@ -4954,19 +11825,19 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.getStart = function() {
 
 /**
  * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
 */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.setStart = function(value) {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setMinTime = function(value) {
   return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.clearStart = function() {
-  return this.setStart(undefined);
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.clearMinTime = function() {
+  return this.setMinTime(undefined);
 };
 
 
@@ -4974,16 +11845,16 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.clearStart = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.hasStart = function() {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.hasMinTime = function() {
   return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional google.protobuf.Timestamp end = 3;
+ * optional google.protobuf.Timestamp max_time = 3;
  * @return {?proto.google.protobuf.Timestamp}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.getEnd = function() {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getMaxTime = function() {
   return /** @type{?proto.google.protobuf.Timestamp} */ (
     jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
@@ -4991,19 +11862,19 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.getEnd = function() {
 
 /**
  * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
 */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.setEnd = function(value) {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setMaxTime = function(value) {
   return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.parca.query.v1alpha1.SeriesRequest} returns this
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.clearEnd = function() {
-  return this.setEnd(undefined);
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.clearMaxTime = function() {
+  return this.setMaxTime(undefined);
 };
 
 
@@ -5011,11 +11882,103 @@ proto.parca.query.v1alpha1.SeriesRequest.prototype.clearEnd = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.parca.query.v1alpha1.SeriesRequest.prototype.hasEnd = function() {
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.hasMaxTime = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
+/**
+ * optional uint64 num_samples = 4;
+ * @return {number}
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getNumSamples = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setNumSamples = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional ValueType sample_type = 5;
+ * @return {?proto.parca.query.v1alpha1.ValueType}
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getSampleType = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ValueType} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ValueType, 5));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.ValueType|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+*/
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setSampleType = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.clearSampleType = function() {
+  return this.setSampleType(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.hasSampleType = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional ValueType period_type = 6;
+ * @return {?proto.parca.query.v1alpha1.ValueType}
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.getPeriodType = function() {
+  return /** @type{?proto.parca.query.v1alpha1.ValueType} */ (
+    jspb.Message.getWrapperField(this, proto.parca.query.v1alpha1.ValueType, 6));
+};
+
+
+/**
+ * @param {?proto.parca.query.v1alpha1.ValueType|undefined} value
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+*/
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.setPeriodType = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.query.v1alpha1.SeriesMetadata} returns this
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.clearPeriodType = function() {
+  return this.setPeriodType(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.query.v1alpha1.SeriesMetadata.prototype.hasPeriodType = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
 
 
 
@@ -5032,8 +11995,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.parca.query.v1alpha1.SeriesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.parca.query.v1alpha1.SeriesResponse.toObject(opt_includeInstance, this);
+proto.parca.query.v1alpha1.ValueType.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.query.v1alpha1.ValueType.toObject(opt_includeInstance, this);
 };
 
 
@@ -5042,13 +12005,14 @@ proto.parca.query.v1alpha1.SeriesResponse.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.parca.query.v1alpha1.SeriesResponse} msg The msg instance to transform.
+ * @param {!proto.parca.query.v1alpha1.ValueType} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SeriesResponse.toObject = function(includeInstance, msg) {
+proto.parca.query.v1alpha1.ValueType.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    type: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    unit: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5062,29 +12026,37 @@ proto.parca.query.v1alpha1.SeriesResponse.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.parca.query.v1alpha1.SeriesResponse}
+ * @return {!proto.parca.query.v1alpha1.ValueType}
  */
-proto.parca.query.v1alpha1.SeriesResponse.deserializeBinary = function(bytes) {
+proto.parca.query.v1alpha1.ValueType.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.parca.query.v1alpha1.SeriesResponse;
-  return proto.parca.query.v1alpha1.SeriesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.parca.query.v1alpha1.ValueType;
+  return proto.parca.query.v1alpha1.ValueType.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.parca.query.v1alpha1.SeriesResponse} msg The message object to deserialize into.
+ * @param {!proto.parca.query.v1alpha1.ValueType} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.parca.query.v1alpha1.SeriesResponse}
+ * @return {!proto.parca.query.v1alpha1.ValueType}
  */
-proto.parca.query.v1alpha1.SeriesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.parca.query.v1alpha1.ValueType.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUnit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5098,9 +12070,9 @@ proto.parca.query.v1alpha1.SeriesResponse.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.parca.query.v1alpha1.SeriesResponse.prototype.serializeBinary = function() {
+proto.parca.query.v1alpha1.ValueType.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.parca.query.v1alpha1.SeriesResponse.serializeBinaryToWriter(this, writer);
+  proto.parca.query.v1alpha1.ValueType.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5108,12 +12080,62 @@ proto.parca.query.v1alpha1.SeriesResponse.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.parca.query.v1alpha1.SeriesResponse} message
+ * @param {!proto.parca.query.v1alpha1.ValueType} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.parca.query.v1alpha1.SeriesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.parca.query.v1alpha1.ValueType.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUnit();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string type = 1;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ValueType.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ValueType} returns this
+ */
+proto.parca.query.v1alpha1.ValueType.prototype.setType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string unit = 2;
+ * @return {string}
+ */
+proto.parca.query.v1alpha1.ValueType.prototype.getUnit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.query.v1alpha1.ValueType} returns this
+ */
+proto.parca.query.v1alpha1.ValueType.prototype.setUnit = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -0,0 +1,159 @@
+// package: parca.trace.v1alpha1
+// file: parca/trace/v1alpha1/trace.proto
+
+import * as jspb from "google-protobuf";
+import * as google_api_annotations_pb from "../../../google/api/annotations_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
+import * as google_protobuf_duration_pb from "google-protobuf/google/protobuf/duration_pb";
+import * as parca_profilestore_v1alpha1_profilestore_pb from "../../../parca/profilestore/v1alpha1/profilestore_pb";
+
+export class TracesRequest extends jspb.Message {
+  getQuery(): string;
+  setQuery(value: string): void;
+
+  hasStart(): boolean;
+  clearStart(): void;
+  getStart(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStart(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  hasEnd(): boolean;
+  clearEnd(): void;
+  getEnd(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setEnd(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TracesRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TracesRequest): TracesRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TracesRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TracesRequest;
+  static deserializeBinaryFromReader(message: TracesRequest, reader: jspb.BinaryReader): TracesRequest;
+}
+
+export namespace TracesRequest {
+  export type AsObject = {
+    query: string,
+    start?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    end?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class TracesResponse extends jspb.Message {
+  clearTracesList(): void;
+  getTracesList(): Array<Trace>;
+  setTracesList(value: Array<Trace>): void;
+  addTraces(value?: Trace, index?: number): Trace;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TracesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TracesResponse): TracesResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TracesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TracesResponse;
+  static deserializeBinaryFromReader(message: TracesResponse, reader: jspb.BinaryReader): TracesResponse;
+}
+
+export namespace TracesResponse {
+  export type AsObject = {
+    tracesList: Array<Trace.AsObject>,
+  }
+}
+
+export class Trace extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  hasLabelset(): boolean;
+  clearLabelset(): void;
+  getLabelset(): parca_profilestore_v1alpha1_profilestore_pb.LabelSet | undefined;
+  setLabelset(value?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet): void;
+
+  hasTimestamp(): boolean;
+  clearTimestamp(): void;
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getSize(): number;
+  setSize(value: number): void;
+
+  hasStats(): boolean;
+  clearStats(): void;
+  getStats(): TraceStats | undefined;
+  setStats(value?: TraceStats): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Trace.AsObject;
+  static toObject(includeInstance: boolean, msg: Trace): Trace.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: Trace, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Trace;
+  static deserializeBinaryFromReader(message: Trace, reader: jspb.BinaryReader): Trace;
+}
+
+export namespace Trace {
+  export type AsObject = {
+    id: string,
+    labelset?: parca_profilestore_v1alpha1_profilestore_pb.LabelSet.AsObject,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    size: number,
+    stats?: TraceStats.AsObject,
+  }
+}
+
+export class TraceStats extends jspb.Message {
+  getGoVersion(): string;
+  setGoVersion(value: string): void;
+
+  getParsed(): boolean;
+  setParsed(value: boolean): void;
+
+  hasDuration(): boolean;
+  clearDuration(): void;
+  getDuration(): google_protobuf_duration_pb.Duration | undefined;
+  setDuration(value?: google_protobuf_duration_pb.Duration): void;
+
+  getGcCycles(): number;
+  setGcCycles(value: number): void;
+
+  getStwPauses(): number;
+  setStwPauses(value: number): void;
+
+  hasStwPauseTotal(): boolean;
+  clearStwPauseTotal(): void;
+  getStwPauseTotal(): google_protobuf_duration_pb.Duration | undefined;
+  setStwPauseTotal(value?: google_protobuf_duration_pb.Duration): void;
+
+  hasStwPauseMax(): boolean;
+  clearStwPauseMax(): void;
+  getStwPauseMax(): google_protobuf_duration_pb.Duration | undefined;
+  setStwPauseMax(value?: google_protobuf_duration_pb.Duration): void;
+
+  getGoroutinesMax(): number;
+  setGoroutinesMax(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TraceStats.AsObject;
+  static toObject(includeInstance: boolean, msg: TraceStats): TraceStats.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TraceStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TraceStats;
+  static deserializeBinaryFromReader(message: TraceStats, reader: jspb.BinaryReader): TraceStats;
+}
+
+export namespace TraceStats {
+  export type AsObject = {
+    goVersion: string,
+    parsed: boolean,
+    duration?: google_protobuf_duration_pb.Duration.AsObject,
+    gcCycles: number,
+    stwPauses: number,
+    stwPauseTotal?: google_protobuf_duration_pb.Duration.AsObject,
+    stwPauseMax?: google_protobuf_duration_pb.Duration.AsObject,
+    goroutinesMax: number,
+  }
+}
+
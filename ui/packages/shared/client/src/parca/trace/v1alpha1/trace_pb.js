@@ -0,0 +1,1222 @@
+// source: parca/trace/v1alpha1/trace.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global = Function('return this')();
+
+var google_api_annotations_pb = require('../../../google/api/annotations_pb.js');
+goog.object.extend(proto, google_api_annotations_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+var google_protobuf_duration_pb = require('google-protobuf/google/protobuf/duration_pb.js');
+goog.object.extend(proto, google_protobuf_duration_pb);
+var parca_profilestore_v1alpha1_profilestore_pb = require('../../../parca/profilestore/v1alpha1/profilestore_pb.js');
+goog.object.extend(proto, parca_profilestore_v1alpha1_profilestore_pb);
+goog.exportSymbol('proto.parca.trace.v1alpha1.Trace', null, global);
+goog.exportSymbol('proto.parca.trace.v1alpha1.TraceStats', null, global);
+goog.exportSymbol('proto.parca.trace.v1alpha1.TracesRequest', null, global);
+goog.exportSymbol('proto.parca.trace.v1alpha1.TracesResponse', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.trace.v1alpha1.TracesRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.trace.v1alpha1.TracesRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.trace.v1alpha1.TracesRequest.displayName = 'proto.parca.trace.v1alpha1.TracesRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.trace.v1alpha1.TracesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.parca.trace.v1alpha1.TracesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.parca.trace.v1alpha1.TracesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.trace.v1alpha1.TracesResponse.displayName = 'proto.parca.trace.v1alpha1.TracesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.trace.v1alpha1.Trace = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.trace.v1alpha1.Trace, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.trace.v1alpha1.Trace.displayName = 'proto.parca.trace.v1alpha1.Trace';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.parca.trace.v1alpha1.TraceStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.parca.trace.v1alpha1.TraceStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.parca.trace.v1alpha1.TraceStats.displayName = 'proto.parca.trace.v1alpha1.TraceStats';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.trace.v1alpha1.TracesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.trace.v1alpha1.TracesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TracesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    start: (f = msg.getStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    end: (f = msg.getEnd()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.trace.v1alpha1.TracesRequest;
+  return proto.parca.trace.v1alpha1.TracesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.trace.v1alpha1.TracesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setQuery(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStart(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEnd(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.trace.v1alpha1.TracesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.trace.v1alpha1.TracesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TracesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getQuery();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getStart();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnd();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string query = 1;
+ * @return {string}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.getQuery = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest} returns this
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.setQuery = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp start = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.getStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest} returns this
+*/
+proto.parca.trace.v1alpha1.TracesRequest.prototype.setStart = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest} returns this
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.clearStart = function() {
+  return this.setStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.hasStart = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp end = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.getEnd = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest} returns this
+*/
+proto.parca.trace.v1alpha1.TracesRequest.prototype.setEnd = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.TracesRequest} returns this
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.clearEnd = function() {
+  return this.setEnd(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TracesRequest.prototype.hasEnd = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.parca.trace.v1alpha1.TracesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.trace.v1alpha1.TracesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.trace.v1alpha1.TracesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TracesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    tracesList: jspb.Message.toObjectList(msg.getTracesList(),
+    proto.parca.trace.v1alpha1.Trace.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.trace.v1alpha1.TracesResponse}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.trace.v1alpha1.TracesResponse;
+  return proto.parca.trace.v1alpha1.TracesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.trace.v1alpha1.TracesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.trace.v1alpha1.TracesResponse}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.parca.trace.v1alpha1.Trace;
+      reader.readMessage(value,proto.parca.trace.v1alpha1.Trace.deserializeBinaryFromReader);
+      msg.addTraces(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.trace.v1alpha1.TracesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.trace.v1alpha1.TracesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TracesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTracesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.parca.trace.v1alpha1.Trace.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated Trace traces = 1;
+ * @return {!Array<!proto.parca.trace.v1alpha1.Trace>}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.prototype.getTracesList = function() {
+  return /** @type{!Array<!proto.parca.trace.v1alpha1.Trace>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.parca.trace.v1alpha1.Trace, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.parca.trace.v1alpha1.Trace>} value
+ * @return {!proto.parca.trace.v1alpha1.TracesResponse} returns this
+*/
+proto.parca.trace.v1alpha1.TracesResponse.prototype.setTracesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.parca.trace.v1alpha1.Trace=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.parca.trace.v1alpha1.Trace}
+ */
+proto.parca.trace.v1alpha1.TracesResponse.prototype.addTraces = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.parca.trace.v1alpha1.Trace, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.parca.trace.v1alpha1.TracesResponse} returns this
+ */
+proto.parca.trace.v1alpha1.TracesResponse.prototype.clearTracesList = function() {
+  return this.setTracesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.trace.v1alpha1.Trace.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.trace.v1alpha1.Trace} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.Trace.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    labelset: (f = msg.getLabelset()) && parca_profilestore_v1alpha1_profilestore_pb.LabelSet.toObject(includeInstance, f),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    size: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    stats: (f = msg.getStats()) && proto.parca.trace.v1alpha1.TraceStats.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.trace.v1alpha1.Trace}
+ */
+proto.parca.trace.v1alpha1.Trace.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.trace.v1alpha1.Trace;
+  return proto.parca.trace.v1alpha1.Trace.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.trace.v1alpha1.Trace} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.trace.v1alpha1.Trace}
+ */
+proto.parca.trace.v1alpha1.Trace.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = new parca_profilestore_v1alpha1_profilestore_pb.LabelSet;
+      reader.readMessage(value,parca_profilestore_v1alpha1_profilestore_pb.LabelSet.deserializeBinaryFromReader);
+      msg.setLabelset(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
+      break;
+    case 5:
+      var value = new proto.parca.trace.v1alpha1.TraceStats;
+      reader.readMessage(value,proto.parca.trace.v1alpha1.TraceStats.deserializeBinaryFromReader);
+      msg.setStats(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.trace.v1alpha1.Trace.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.trace.v1alpha1.Trace} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.Trace.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLabelset();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      parca_profilestore_v1alpha1_profilestore_pb.LabelSet.serializeBinaryToWriter
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStats();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.parca.trace.v1alpha1.TraceStats.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional parca.profilestore.v1alpha1.LabelSet labelset = 2;
+ * @return {?proto.parca.profilestore.v1alpha1.LabelSet}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.getLabelset = function() {
+  return /** @type{?proto.parca.profilestore.v1alpha1.LabelSet} */ (
+    jspb.Message.getWrapperField(this, parca_profilestore_v1alpha1_profilestore_pb.LabelSet, 2));
+};
+
+
+/**
+ * @param {?proto.parca.profilestore.v1alpha1.LabelSet|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+*/
+proto.parca.trace.v1alpha1.Trace.prototype.setLabelset = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.clearLabelset = function() {
+  return this.setLabelset(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.hasLabelset = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+*/
+proto.parca.trace.v1alpha1.Trace.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional uint64 size = 4;
+ * @return {number}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional TraceStats stats = 5;
+ * @return {?proto.parca.trace.v1alpha1.TraceStats}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.getStats = function() {
+  return /** @type{?proto.parca.trace.v1alpha1.TraceStats} */ (
+    jspb.Message.getWrapperField(this, proto.parca.trace.v1alpha1.TraceStats, 5));
+};
+
+
+/**
+ * @param {?proto.parca.trace.v1alpha1.TraceStats|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+*/
+proto.parca.trace.v1alpha1.Trace.prototype.setStats = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.Trace} returns this
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.clearStats = function() {
+  return this.setStats(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.Trace.prototype.hasStats = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.parca.trace.v1alpha1.TraceStats.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.parca.trace.v1alpha1.TraceStats} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TraceStats.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    goVersion: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    parsed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    duration: (f = msg.getDuration()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    gcCycles: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    stwPauses: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    stwPauseTotal: (f = msg.getStwPauseTotal()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    stwPauseMax: (f = msg.getStwPauseMax()) && google_protobuf_duration_pb.Duration.toObject(includeInstance, f),
+    goroutinesMax: jspb.Message.getFieldWithDefault(msg, 8, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.parca.trace.v1alpha1.TraceStats}
+ */
+proto.parca.trace.v1alpha1.TraceStats.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.parca.trace.v1alpha1.TraceStats;
+  return proto.parca.trace.v1alpha1.TraceStats.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.parca.trace.v1alpha1.TraceStats} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.parca.trace.v1alpha1.TraceStats}
+ */
+proto.parca.trace.v1alpha1.TraceStats.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGoVersion(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setParsed(value);
+      break;
+    case 3:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setDuration(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGcCycles(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStwPauses(value);
+      break;
+    case 6:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setStwPauseTotal(value);
+      break;
+    case 7:
+      var value = new google_protobuf_duration_pb.Duration;
+      reader.readMessage(value,google_protobuf_duration_pb.Duration.deserializeBinaryFromReader);
+      msg.setStwPauseMax(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setGoroutinesMax(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.parca.trace.v1alpha1.TraceStats.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.parca.trace.v1alpha1.TraceStats} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.parca.trace.v1alpha1.TraceStats.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getGoVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getParsed();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getGcCycles();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getStwPauses();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getStwPauseTotal();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getStwPauseMax();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      google_protobuf_duration_pb.Duration.serializeBinaryToWriter
+    );
+  }
+  f = message.getGoroutinesMax();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string go_version = 1;
+ * @return {string}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getGoVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.setGoVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool parsed = 2;
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getParsed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.setParsed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Duration duration = 3;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getDuration = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+*/
+proto.parca.trace.v1alpha1.TraceStats.prototype.setDuration = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.clearDuration = function() {
+  return this.setDuration(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.hasDuration = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional uint64 gc_cycles = 4;
+ * @return {number}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getGcCycles = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.setGcCycles = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 stw_pauses = 5;
+ * @return {number}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getStwPauses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.setStwPauses = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional google.protobuf.Duration stw_pause_total = 6;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getStwPauseTotal = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+*/
+proto.parca.trace.v1alpha1.TraceStats.prototype.setStwPauseTotal = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.clearStwPauseTotal = function() {
+  return this.setStwPauseTotal(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.hasStwPauseTotal = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional google.protobuf.Duration stw_pause_max = 7;
+ * @return {?proto.google.protobuf.Duration}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getStwPauseMax = function() {
+  return /** @type{?proto.google.protobuf.Duration} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_duration_pb.Duration, 7));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Duration|undefined} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+*/
+proto.parca.trace.v1alpha1.TraceStats.prototype.setStwPauseMax = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.clearStwPauseMax = function() {
+  return this.setStwPauseMax(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.hasStwPauseMax = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional uint64 goroutines_max = 8;
+ * @return {number}
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.getGoroutinesMax = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.parca.trace.v1alpha1.TraceStats} returns this
+ */
+proto.parca.trace.v1alpha1.TraceStats.prototype.setGoroutinesMax = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+goog.object.extend(exports, proto.parca.trace.v1alpha1);
@@ -0,0 +1,63 @@
+// package: parca.trace.v1alpha1
+// file: parca/trace/v1alpha1/trace.proto
+
+import * as parca_trace_v1alpha1_trace_pb from "../../../parca/trace/v1alpha1/trace_pb";
+import {grpc} from "@improbable-eng/grpc-web";
+
+type TraceServiceTraces = {
+  readonly methodName: string;
+  readonly service: typeof TraceService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof parca_trace_v1alpha1_trace_pb.TracesRequest;
+  readonly responseType: typeof parca_trace_v1alpha1_trace_pb.TracesResponse;
+};
+
+export class TraceService {
+  static readonly serviceName: string;
+  static readonly Traces: TraceServiceTraces;
+}
+
+export type ServiceError = { message: string, code: number; metadata: grpc.Metadata }
+export type Status = { details: string, code: number; metadata: grpc.Metadata }
+
+interface UnaryResponse {
+  cancel(): void;
+}
+interface ResponseStream<T> {
+  cancel(): void;
+  on(type: 'data', handler: (message: T) => void): ResponseStream<T>;
+  on(type: 'end', handler: (status?: Status) => void): ResponseStream<T>;
+  on(type: 'status', handler: (status: Status) => void): ResponseStream<T>;
+}
+interface RequestStream<T> {
+  write(message: T): RequestStream<T>;
+  end(): void;
+  cancel(): void;
+  on(type: 'end', handler: (status?: Status) => void): RequestStream<T>;
+  on(type: 'status', handler: (status: Status) => void): RequestStream<T>;
+}
+interface BidirectionalStream<ReqT, ResT> {
+  write(message: ReqT): BidirectionalStream<ReqT, ResT>;
+  end(): void;
+  cancel(): void;
+  on(type: 'data', handler: (message: ResT) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'end', handler: (status?: Status) => void): BidirectionalStream<ReqT, ResT>;
+  on(type: 'status', handler: (status: Status) => void): BidirectionalStream<ReqT, ResT>;
+}
+
+export class TraceServiceClient {
+  readonly serviceHost: string;
+
+  constructor(serviceHost: string, options?: grpc.RpcOptions);
+  traces(
+    requestMessage: parca_trace_v1alpha1_trace_pb.TracesRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: parca_trace_v1alpha1_trace_pb.TracesResponse|null) => void
+  ): UnaryResponse;
+  traces(
+    requestMessage: parca_trace_v1alpha1_trace_pb.TracesRequest,
+    callback: (error: ServiceError|null, responseMessage: parca_trace_v1alpha1_trace_pb.TracesResponse|null) => void
+  ): UnaryResponse;
+}
+
@@ -0,0 +1,61 @@
+// package: parca.trace.v1alpha1
+// file: parca/trace/v1alpha1/trace.proto
+
+var parca_trace_v1alpha1_trace_pb = require("../../../parca/trace/v1alpha1/trace_pb");
+var grpc = require("@improbable-eng/grpc-web").grpc;
+
+var TraceService = (function () {
+  function TraceService() {}
+  TraceService.serviceName = "parca.trace.v1alpha1.TraceService";
+  return TraceService;
+}());
+
+TraceService.Traces = {
+  methodName: "Traces",
+  service: TraceService,
+  requestStream: false,
+  responseStream: false,
+  requestType: parca_trace_v1alpha1_trace_pb.TracesRequest,
+  responseType: parca_trace_v1alpha1_trace_pb.TracesResponse
+};
+
+exports.TraceService = TraceService;
+
+function TraceServiceClient(serviceHost, options) {
+  this.serviceHost = serviceHost;
+  this.options = options || {};
+}
+
+TraceServiceClient.prototype.traces = function traces(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(TraceService.Traces, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+exports.TraceServiceClient = TraceServiceClient;
+
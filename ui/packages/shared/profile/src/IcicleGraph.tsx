@@ -94,6 +94,7 @@ function getLastItem (thePath: string): string {
 }
 
 export function nodeLabel (node: FlamegraphNode.AsObject): string {
+  if (node.other) return 'other'
   if (node.meta === undefined) return '<unknown>'
   const mapping = `${(node.meta?.mapping?.file !== undefined && node.meta?.mapping?.file !== '') ? '[' + getLastItem(node.meta.mapping.file) + '] ' : ''}`
   if (node.meta.pb_function?.name !== undefined && node.meta.pb_function?.name !== '') return mapping + node.meta.pb_function.name